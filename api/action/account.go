@@ -1,11 +1,13 @@
 package action
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
-	"strings"
+	"time"
 
+	"github.com/gsabadini/go-bank-transfer/api/auth"
 	"github.com/gsabadini/go-bank-transfer/api/input"
 	"github.com/gsabadini/go-bank-transfer/api/logging"
 	"github.com/gsabadini/go-bank-transfer/api/response"
@@ -18,6 +20,7 @@ import (
 //Account armazena as dependências para as ações de Account
 type Account struct {
 	uc        usecase.AccountUseCase
+	balanceUC usecase.BalanceUseCase
 	log       logger.Logger
 	validator validator.Validator
 }
@@ -27,6 +30,16 @@ func NewAccount(uc usecase.AccountUseCase, l logger.Logger, v validator.Validato
 	return Account{uc: uc, log: l, validator: v}
 }
 
+//NewAccountBalance constrói um Account capaz de responder o saldo histórico de uma Account
+func NewAccountBalance(
+	uc usecase.AccountUseCase,
+	balanceUC usecase.BalanceUseCase,
+	l logger.Logger,
+	v validator.Validator,
+) Account {
+	return Account{uc: uc, balanceUC: balanceUC, log: l, validator: v}
+}
+
 //Store é um handler para criação de Account
 func (a Account) Store(w http.ResponseWriter, r *http.Request) {
 	const logKey = "create_account"
@@ -41,7 +54,7 @@ func (a Account) Store(w http.ResponseWriter, r *http.Request) {
 			err,
 		).Log()
 
-		response.NewError(err, http.StatusBadRequest).Send(w)
+		response.NewErrorWithCode(err, response.CodeInvalidJSON, http.StatusBadRequest).Send(w, r)
 		return
 	}
 	defer r.Body.Close()
@@ -55,26 +68,28 @@ func (a Account) Store(w http.ResponseWriter, r *http.Request) {
 			errors.New("invalid input"),
 		).Log()
 
-		response.NewErrorMessage(errs, http.StatusBadRequest).Send(w)
+		response.NewErrorFields(errs, response.CodeInvalidInput, http.StatusBadRequest).Send(w, r)
 		return
 	}
 
 	output, err := a.uc.Store(
 		r.Context(),
 		inputAccount.Name,
-		a.cleanCPF(inputAccount.CPF),
+		inputAccount.TaxID(),
+		inputAccount.HolderType().TaxIDType(),
 		domain.Money(inputAccount.Balance),
 	)
 	if err != nil {
+		var resErr = response.TranslateError(err)
 		logging.NewError(
 			a.log,
 			logKey,
 			"error when creating a new account",
-			http.StatusInternalServerError,
+			resErr.StatusCode(),
 			err,
 		).Log()
 
-		response.NewError(err, http.StatusInternalServerError).Send(w)
+		resErr.Send(w, r)
 		return
 	}
 	logging.NewInfo(a.log, logKey, "success creating account", http.StatusCreated).Log()
@@ -82,79 +97,246 @@ func (a Account) Store(w http.ResponseWriter, r *http.Request) {
 	response.NewSuccess(output, http.StatusCreated).Send(w)
 }
 
-//FindAll é um handler para retornar todas as Account
+//FindAll é um handler para retornar uma página de Account, o saldo só é informado nas Account que o Principal
+//autenticado pode operar
 func (a Account) FindAll(w http.ResponseWriter, r *http.Request) {
 	const logKey = "find_all_account"
 
-	output, err := a.uc.FindAll(r.Context())
+	pagination, errs := parsePagination(r.URL.Query())
+	if len(errs) > 0 {
+		logging.NewError(
+			a.log,
+			logKey,
+			"invalid pagination",
+			http.StatusBadRequest,
+			errors.New("invalid pagination"),
+		).Log()
+
+		response.NewErrorFields(errs, response.CodeInvalidParameter, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := a.uc.FindAll(r.Context(), pagination)
 	if err != nil {
+		var resErr = response.TranslateError(err)
 		logging.NewError(
 			a.log,
 			logKey,
 			"error when returning account list",
-			http.StatusInternalServerError,
+			resErr.StatusCode(),
 			err,
 		).Log()
 
-		response.NewError(err, http.StatusInternalServerError).Send(w)
+		resErr.Send(w, r)
 		return
 	}
 	logging.NewInfo(a.log, logKey, "success when returning account list", http.StatusOK).Log()
 
-	response.NewSuccess(output, http.StatusOK).Send(w)
+	response.NewSuccess(newAccountPage(r.Context(), output), http.StatusOK).Send(w)
 }
 
-//FindBalance é um handler para retornar o Balance de uma Account
+//FindByID é um handler para retornar uma Account, restrito ao titular da Account e ao escopo admin
+func (a Account) FindByID(w http.ResponseWriter, r *http.Request) {
+	const logKey = "find_account"
+
+	var accountID = r.URL.Query().Get("account_id")
+	if !domain.IsValidUUID(accountID) {
+		var (
+			err    = response.ErrParameterInvalid
+			resErr = response.TranslateError(err)
+		)
+
+		logging.NewError(
+			a.log,
+			logKey,
+			"parameter invalid",
+			resErr.StatusCode(),
+			err,
+		).Log()
+
+		resErr.Send(w, r)
+		return
+	}
+
+	if err := auth.AuthorizeAccount(r.Context(), domain.AccountID(accountID)); err != nil {
+		var resErr = response.TranslateError(err)
+		logging.NewError(
+			a.log,
+			logKey,
+			"account not owned by the caller",
+			resErr.StatusCode(),
+			err,
+		).Log()
+
+		resErr.Send(w, r)
+		return
+	}
+
+	output, err := a.uc.FindByID(r.Context(), domain.AccountID(accountID))
+	if err != nil {
+		var resErr = response.TranslateError(err)
+		logging.NewError(
+			a.log,
+			logKey,
+			"error when returning account",
+			resErr.StatusCode(),
+			err,
+		).Log()
+
+		resErr.Send(w, r)
+		return
+	}
+	logging.NewInfo(a.log, logKey, "success when returning account", http.StatusOK).Log()
+
+	response.NewSuccess(output, http.StatusOK).SendWithETag(w, r)
+}
+
+//FindBalance é um handler para retornar o Balance de uma Account, no instante informado em at quando presente
 func (a Account) FindBalance(w http.ResponseWriter, r *http.Request) {
 	const logKey = "find_balance"
 
 	var accountID = r.URL.Query().Get("account_id")
 	if !domain.IsValidUUID(accountID) {
-		var err = response.ErrParameterInvalid
+		var (
+			err    = response.ErrParameterInvalid
+			resErr = response.TranslateError(err)
+		)
+
 		logging.NewError(
 			a.log,
 			logKey,
 			"parameter invalid",
-			http.StatusBadRequest,
+			resErr.StatusCode(),
 			err,
 		).Log()
 
-		response.NewError(err, http.StatusBadRequest).Send(w)
+		resErr.Send(w, r)
+		return
+	}
+
+	if err := auth.AuthorizeAccount(r.Context(), domain.AccountID(accountID)); err != nil {
+		var resErr = response.TranslateError(err)
+		logging.NewError(
+			a.log,
+			logKey,
+			"account not owned by the caller",
+			resErr.StatusCode(),
+			err,
+		).Log()
+
+		resErr.Send(w, r)
+		return
+	}
+
+	if value := r.URL.Query().Get("at"); value != "" {
+		a.findBalanceAt(w, r, domain.AccountID(accountID), value)
 		return
 	}
 
 	output, err := a.uc.FindBalance(r.Context(), domain.AccountID(accountID))
 	if err != nil {
-		switch err {
-		case domain.ErrNotFound:
-			logging.NewError(
-				a.log,
-				logKey,
-				"error fetching account",
-				http.StatusBadRequest,
-				err,
-			).Log()
-
-			response.NewError(err, http.StatusBadRequest).Send(w)
-			return
-		default:
-			logging.NewError(
-				a.log,
-				logKey,
-				"error when returning account balance",
-				http.StatusInternalServerError,
-				err,
-			).Log()
-
-			response.NewError(err, http.StatusInternalServerError).Send(w)
-			return
-		}
+		var resErr = response.TranslateError(err)
+		logging.NewError(
+			a.log,
+			logKey,
+			"error when returning account balance",
+			resErr.StatusCode(),
+			err,
+		).Log()
+
+		resErr.Send(w, r)
+		return
+	}
+	logging.NewInfo(a.log, logKey, "success when returning account balance", http.StatusOK).Log()
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+//findBalanceAt responde o saldo no instante at, uma data (YYYY-MM-DD) considera o fim do dia
+func (a Account) findBalanceAt(w http.ResponseWriter, r *http.Request, ID domain.AccountID, value string) {
+	const logKey = "find_balance_at"
+
+	at, dateOnly, err := parsePeriodTime(value)
+	if err != nil {
+		logging.NewError(
+			a.log,
+			logKey,
+			"invalid at",
+			http.StatusBadRequest,
+			errors.New("invalid at"),
+		).Log()
+
+		response.NewErrorFields([]validator.FieldError{{
+			Field:   "at",
+			Message: "at must be a date (YYYY-MM-DD) or an RFC 3339 timestamp",
+		}}, response.CodeInvalidParameter, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	if dateOnly {
+		at = at.Add(24 * time.Hour)
+	}
+
+	output, err := a.balanceUC.FindAt(r.Context(), ID, at)
+	if err != nil {
+		var resErr = response.TranslateError(err)
+		logging.NewError(
+			a.log,
+			logKey,
+			"error when returning account balance",
+			resErr.StatusCode(),
+			err,
+		).Log()
+
+		resErr.Send(w, r)
+		return
 	}
 	logging.NewInfo(a.log, logKey, "success when returning account balance", http.StatusOK).Log()
 
 	response.NewSuccess(output, http.StatusOK).Send(w)
 }
 
-func (a Account) cleanCPF(cpf string) string {
-	return strings.Replace(strings.Replace(cpf, ".", "", -1), "-", "", -1)
+//accountPageOutput armazena uma página de Account com os saldos visíveis ao Principal autenticado
+type accountPageOutput struct {
+	Data       []accountSummaryOutput `json:"data"`
+	NextCursor string                 `json:"next_cursor"`
+}
+
+//accountSummaryOutput armazena uma Account da página, sem o saldo quando o Principal não é o titular nem admin
+type accountSummaryOutput struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	TaxID     string    `json:"tax_id"`
+	TaxIDType string    `json:"tax_id_type"`
+	Balance   *float64  `json:"balance,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newAccountPage(ctx context.Context, output usecase.AccountListOutput) accountPageOutput {
+	var (
+		principal, _ = auth.FromContext(ctx)
+		page         = accountPageOutput{
+			Data:       make([]accountSummaryOutput, 0, len(output.Data)),
+			NextCursor: output.NextCursor,
+		}
+	)
+
+	for _, account := range output.Data {
+		var summary = accountSummaryOutput{
+			ID:        account.ID,
+			Name:      account.Name,
+			TaxID:     account.TaxID,
+			TaxIDType: account.TaxIDType,
+			CreatedAt: account.CreatedAt,
+		}
+
+		if principal.CanAccessAccount(domain.AccountID(account.ID)) {
+			var balance = account.Balance
+			summary.Balance = &balance
+		}
+
+		page.Data = append(page.Data, summary)
+	}
+
+	return page
 }
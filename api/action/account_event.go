@@ -0,0 +1,241 @@
+package action
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/logging"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+const (
+	//accountEventHeartbeat é o intervalo entre os comentários enviados para manter a conexão aberta em proxies
+	accountEventHeartbeat = 15 * time.Second
+
+	//accountEventWriteTimeout é o prazo de cada escrita, após o qual o cliente é considerado desconectado
+	accountEventWriteTimeout = 10 * time.Second
+
+	//accountEventRetry é o intervalo de reconexão sugerido ao cliente, em milissegundos
+	accountEventRetry = 3000
+
+	//accountEventResync é o evento enviado quando a retomada não é possível e o estado deve ser recarregado
+	accountEventResync = "resync"
+)
+
+var errStreamingUnsupported = errors.New("streaming requires HTTP/1.1")
+
+//AccountEvent armazena as dependências para o acompanhamento em tempo real dos eventos de uma Account
+type AccountEvent struct {
+	uc        usecase.AccountEventUseCase
+	log       logger.Logger
+	heartbeat time.Duration
+}
+
+//NewAccountEvent constrói um AccountEvent com suas dependências
+func NewAccountEvent(uc usecase.AccountEventUseCase, log logger.Logger) AccountEvent {
+	return AccountEvent{uc: uc, log: log, heartbeat: accountEventHeartbeat}
+}
+
+//Stream é um handler que envia os eventos de uma Account como Server-Sent Events
+//
+//O status e os cabeçalhos são escritos pelo ResponseWriter, para que os middlewares registrem a resposta, e a conexão
+//é assumida em seguida para que o WriteTimeout do servidor não encerre o stream, cada escrita tem o seu próprio
+//prazo. Por depender de assumir a conexão o stream só é suportado em HTTP/1.1, as demais versões recebem 505. O
+//cabeçalho Last-Event-ID retoma o stream a partir do último evento recebido. Os eventos trazem o saldo da Account,
+//por isso o stream é restrito ao titular e ao escopo admin
+func (a AccountEvent) Stream(w http.ResponseWriter, r *http.Request) {
+	const logKey = "stream_account_events"
+
+	var accountID = r.URL.Query().Get("account_id")
+	if !domain.IsValidUUID(accountID) {
+		a.fail(w, r, logKey, "parameter invalid", response.ErrParameterInvalid)
+		return
+	}
+
+	if err := auth.AuthorizeAccount(r.Context(), domain.AccountID(accountID)); err != nil {
+		a.fail(w, r, logKey, "account not owned by the caller", err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	subscription, err := a.uc.Subscribe(ctx, domain.AccountID(accountID), r.Header.Get("Last-Event-ID"))
+	if err != nil {
+		a.fail(w, r, logKey, "error when streaming account events", err)
+		return
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok || r.ProtoMajor != 1 {
+		logging.NewError(
+			a.log,
+			logKey,
+			"streaming requires HTTP/1.1",
+			http.StatusHTTPVersionNotSupported,
+			errStreamingUnsupported,
+		).Log()
+
+		response.NewErrorWithCode(
+			errStreamingUnsupported,
+			response.CodeStreamingUnsupported,
+			http.StatusHTTPVersionNotSupported,
+		).Send(w, r)
+		return
+	}
+
+	//Transfer-Encoding identity desabilita o chunked, já que os eventos são escritos diretamente na conexão
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.Header().Set("Transfer-Encoding", "identity")
+	w.WriteHeader(http.StatusOK)
+
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		logging.NewError(
+			a.log,
+			logKey,
+			"error when streaming account events",
+			http.StatusOK,
+			err,
+		).Log()
+		return
+	}
+	defer conn.Close()
+
+	logging.NewInfo(a.log, logKey, "success streaming account events", http.StatusOK).Log()
+
+	_ = conn.SetDeadline(time.Time{})
+	go a.watch(rw.Reader, cancel)
+
+	var stream = eventStream{conn: conn, w: rw.Writer}
+	if err := stream.open(subscription); err != nil {
+		return
+	}
+
+	var ticker = time.NewTicker(a.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := stream.comment("heartbeat"); err != nil {
+				return
+			}
+		case output, ok := <-subscription.Events:
+			if !ok {
+				return
+			}
+
+			if err := stream.event(output); err != nil {
+				return
+			}
+		}
+	}
+}
+
+//watch cancela o stream quando o cliente encerra a conexão, detectado pela leitura já que nada mais é enviado por ele
+func (a AccountEvent) watch(r *bufio.Reader, cancel context.CancelFunc) {
+	defer cancel()
+
+	var buf = make([]byte, 512)
+	for {
+		if _, err := r.Read(buf); err != nil {
+			return
+		}
+	}
+}
+
+//eventStream escreve no formato text/event-stream diretamente na conexão assumida
+type eventStream struct {
+	conn net.Conn
+	w    *bufio.Writer
+}
+
+//open escreve o intervalo de reconexão e os eventos a reenviar
+func (e eventStream) open(subscription usecase.AccountEventSubscription) error {
+	if _, err := fmt.Fprintf(e.w, "retry: %d\n\n", accountEventRetry); err != nil {
+		return err
+	}
+
+	if subscription.Resync {
+		if err := e.write(usecase.AccountEventOutput{Type: accountEventResync, Data: []byte("{}")}); err != nil {
+			return err
+		}
+	}
+
+	for _, output := range subscription.Replay {
+		if err := e.write(output); err != nil {
+			return err
+		}
+	}
+
+	return e.flush()
+}
+
+func (e eventStream) event(output usecase.AccountEventOutput) error {
+	if err := e.write(output); err != nil {
+		return err
+	}
+
+	return e.flush()
+}
+
+//write escreve um evento, dividindo os dados em uma linha data por linha
+func (e eventStream) write(output usecase.AccountEventOutput) error {
+	var lines = make([]string, 0, 3)
+	if output.ID != "" {
+		lines = append(lines, "id: "+output.ID)
+	}
+
+	lines = append(lines, "event: "+output.Type)
+	for _, line := range strings.Split(string(output.Data), "\n") {
+		lines = append(lines, "data: "+line)
+	}
+
+	_, err := e.w.WriteString(strings.Join(lines, "\n") + "\n\n")
+	return err
+}
+
+func (e eventStream) comment(text string) error {
+	if _, err := fmt.Fprintf(e.w, ": %s\n\n", text); err != nil {
+		return err
+	}
+
+	return e.flush()
+}
+
+func (e eventStream) flush() error {
+	if err := e.conn.SetWriteDeadline(time.Now().Add(accountEventWriteTimeout)); err != nil {
+		return err
+	}
+
+	return e.w.Flush()
+}
+
+//fail registra o erro no log e responde conforme o catálogo de erros
+func (a AccountEvent) fail(w http.ResponseWriter, r *http.Request, logKey string, message string, err error) {
+	var resErr = response.TranslateError(err)
+	logging.NewError(
+		a.log,
+		logKey,
+		message,
+		resErr.StatusCode(),
+		err,
+	).Log()
+
+	resErr.Send(w, r)
+}
@@ -0,0 +1,230 @@
+package action
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/urfave/negroni"
+)
+
+type mockAccountEvent struct {
+	lastEventID *string
+	result      usecase.AccountEventSubscription
+	err         error
+}
+
+func (m mockAccountEvent) Subscribe(
+	_ context.Context,
+	_ domain.AccountID,
+	lastEventID string,
+) (usecase.AccountEventSubscription, error) {
+	*m.lastEventID = lastEventID
+	return m.result, m.err
+}
+
+func TestAccountEvent_Stream(t *testing.T) {
+	t.Parallel()
+
+	var (
+		lastEventID string
+		events      = make(chan usecase.AccountEventOutput, 1)
+		uc          = mockAccountEvent{
+			lastEventID: &lastEventID,
+			result: usecase.AccountEventSubscription{
+				Replay: []usecase.AccountEventOutput{
+					{ID: "2", Type: "balance.changed", Data: []byte(`{"current":100}`)},
+				},
+				Events: events,
+			},
+		}
+		action = NewAccountEvent(uc, logger.LoggerMock{})
+	)
+	action.heartbeat = 50 * time.Millisecond
+
+	var recorded = make(chan int, 1)
+	var server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		q.Add("account_id", "3c096a40-ccba-4b58-93ed-57379ab04680")
+		r.URL.RawQuery = q.Encode()
+
+		//o ResponseWriter dos middlewares deve registrar o status do stream
+		var res = negroni.NewResponseWriter(w)
+		action.Stream(res, withPrincipal(r, adminPrincipal))
+		recorded <- res.Status()
+	}))
+	server.Config.WriteTimeout = 100 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header.Set("Last-Event-ID", "1")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("[TestCase 'Stream'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK || res.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("[TestCase 'Stream'] Status: '%v' | Headers: '%v'", res.StatusCode, res.Header)
+	}
+
+	if lastEventID != "1" {
+		t.Errorf("[TestCase 'Stream'] Last-Event-ID: '%v' | Expected: '%v'", lastEventID, "1")
+	}
+
+	//o evento é enviado após o WriteTimeout do servidor, que não deve encerrar o stream
+	time.AfterFunc(300*time.Millisecond, func() {
+		events <- usecase.AccountEventOutput{ID: "3", Type: "transfer.incoming", Data: []byte(`{"amount":100}`)}
+		close(events)
+	})
+
+	var (
+		reader = bufio.NewReader(res.Body)
+		lines  []string
+	)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			break
+		}
+
+		lines = append(lines, strings.TrimSuffix(line, "\n"))
+	}
+
+	var body = strings.Join(lines, "\n")
+	for _, expected := range []string{
+		"retry: 3000",
+		"id: 2\nevent: balance.changed\ndata: {\"current\":100}",
+		": heartbeat",
+		"id: 3\nevent: transfer.incoming\ndata: {\"amount\":100}",
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("[TestCase 'Stream'] Result: '%s' | Expected to contain: '%s'", body, expected)
+		}
+	}
+
+	if status := <-recorded; status != http.StatusOK {
+		t.Errorf("[TestCase 'Stream'] Recorded status: '%v' | Expected: '%v'", status, http.StatusOK)
+	}
+}
+
+//TestAccountEvent_StreamHTTP2 garante que o stream, que assume a conexão, é recusado com 505 fora do HTTP/1.1
+func TestAccountEvent_StreamHTTP2(t *testing.T) {
+	t.Parallel()
+
+	var (
+		lastEventID string
+		uc          = mockAccountEvent{
+			lastEventID: &lastEventID,
+			result:      usecase.AccountEventSubscription{Events: make(chan usecase.AccountEventOutput)},
+		}
+		action = NewAccountEvent(uc, logger.LoggerMock{})
+	)
+
+	var server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		q.Add("account_id", "3c096a40-ccba-4b58-93ed-57379ab04680")
+		r.URL.RawQuery = q.Encode()
+
+		action.Stream(w, withPrincipal(r, adminPrincipal))
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	res, err := server.Client().Get(server.URL)
+	if err != nil {
+		t.Fatalf("[TestCase 'StreamHTTP2'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+	defer res.Body.Close()
+
+	if res.ProtoMajor != 2 {
+		t.Fatalf("[TestCase 'StreamHTTP2'] Proto: '%v' | Expected: '%v'", res.Proto, "HTTP/2.0")
+	}
+
+	body, _ := ioutil.ReadAll(res.Body)
+	if res.StatusCode != http.StatusHTTPVersionNotSupported || !strings.Contains(string(body), "streaming_unsupported") {
+		t.Errorf(
+			"[TestCase 'StreamHTTP2'] Result: '%v' '%s' | Expected: '%v'",
+			res.StatusCode,
+			body,
+			http.StatusHTTPVersionNotSupported,
+		)
+	}
+}
+
+func TestAccountEvent_StreamError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		accountID          string
+		principal          auth.Principal
+		err                error
+		expectedStatusCode int
+	}{
+		{
+			name:               "Stream action error account not found",
+			accountID:          "3c096a40-ccba-4b58-93ed-57379ab04680",
+			err:                domain.ErrNotFound,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Stream action error account of another owner",
+			accountID:          "3c096a40-ccba-4b58-93ed-57379ab04680",
+			principal:          auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04681", nil),
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Stream action error invalid account id",
+			accountID:          "error",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lastEventID string
+
+			req, _ := http.NewRequest(http.MethodGet, "/accounts/events", nil)
+
+			q := req.URL.Query()
+			q.Add("account_id", tt.accountID)
+			req.URL.RawQuery = q.Encode()
+
+			var principal = adminPrincipal
+			if tt.principal.Subject() != "" {
+				principal = tt.principal
+			}
+			req = withPrincipal(req, principal)
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewAccountEvent(mockAccountEvent{lastEventID: &lastEventID, err: tt.err}, logger.LoggerMock{})
+			)
+
+			action.Stream(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+		})
+	}
+}
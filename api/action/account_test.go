@@ -3,17 +3,19 @@ package action
 import (
 	"bytes"
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/gsabadini/go-bank-transfer/api/auth"
 	"github.com/gsabadini/go-bank-transfer/domain"
 	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
 	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
 	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/pkg/errors"
 )
 
 type mockAccountStore struct {
@@ -23,7 +25,13 @@ type mockAccountStore struct {
 	err    error
 }
 
-func (m mockAccountStore) Store(_ context.Context, _, _ string, _ domain.Money) (usecase.AccountOutput, error) {
+func (m mockAccountStore) Store(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ domain.TaxIDType,
+	_ domain.Money,
+) (usecase.AccountOutput, error) {
 	return m.result, m.err
 }
 
@@ -58,13 +66,14 @@ func TestAccount_Store(t *testing.T) {
 				result: usecase.AccountOutput{
 					ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
 					Name:      "Test",
-					CPF:       "07094564964",
+					TaxID:     "07094564964",
+					TaxIDType: "cpf",
 					Balance:   10.5,
 					CreatedAt: time.Time{},
 				},
 				err: nil,
 			},
-			expectedBody:       []byte(`{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Test","cpf":"07094564964","balance":10.5,"created_at":"0001-01-01T00:00:00Z"}`),
+			expectedBody:       []byte(`{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Test","tax_id":"07094564964","tax_id_type":"cpf","balance":10.5,"created_at":"0001-01-01T00:00:00Z"}`),
 			expectedStatusCode: http.StatusCreated,
 		},
 		{
@@ -82,13 +91,14 @@ func TestAccount_Store(t *testing.T) {
 				result: usecase.AccountOutput{
 					ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
 					Name:      "Test",
-					CPF:       "07094564964",
+					TaxID:     "07094564964",
+					TaxIDType: "cpf",
 					Balance:   10000,
 					CreatedAt: time.Time{},
 				},
 				err: nil,
 			},
-			expectedBody:       []byte(`{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Test","cpf":"07094564964","balance":10000,"created_at":"0001-01-01T00:00:00Z"}`),
+			expectedBody:       []byte(`{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Test","tax_id":"07094564964","tax_id_type":"cpf","balance":10000,"created_at":"0001-01-01T00:00:00Z"}`),
 			expectedStatusCode: http.StatusCreated,
 		},
 		{
@@ -106,9 +116,27 @@ func TestAccount_Store(t *testing.T) {
 				result: usecase.AccountOutput{},
 				err:    errors.New("error"),
 			},
-			expectedBody:       []byte(`{"errors":["error"]}`),
+			expectedBody:       []byte(`{"errors":["internal server error"],"code":"internal_error"}`),
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name: "Store action error account already exists",
+			args: args{
+				rawPayload: []byte(
+					`{
+						"name": "test",
+						"cpf": "44451598087",
+						"balance": 10
+					}`,
+				),
+			},
+			ucMock: mockAccountStore{
+				result: usecase.AccountOutput{},
+				err:    errors.Wrap(domain.ErrAccountAlreadyExists, "error creating account"),
+			},
+			expectedBody:       []byte(`{"errors":["account already exists"],"code":"account_already_exists"}`),
+			expectedStatusCode: http.StatusConflict,
+		},
 		{
 			name: "Store action error invalid balance",
 			args: args{
@@ -124,7 +152,7 @@ func TestAccount_Store(t *testing.T) {
 				result: usecase.AccountOutput{},
 				err:    nil,
 			},
-			expectedBody:       []byte(`{"errors":["Balance must be greater than 0"]}`),
+			expectedBody:       []byte(`{"errors":["Balance must be greater than 0"],"code":"invalid_input"}`),
 			expectedStatusCode: http.StatusBadRequest,
 		},
 		{
@@ -142,7 +170,90 @@ func TestAccount_Store(t *testing.T) {
 				result: usecase.AccountOutput{},
 				err:    nil,
 			},
-			expectedBody:       []byte(`{"errors":["Name is a required field","CPF is a required field","Balance must be greater than 0"]}`),
+			expectedBody:       []byte(`{"errors":["CPF is a required field","Name is a required field","Balance must be greater than 0"],"code":"invalid_input"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Store action success company account",
+			args: args{
+				rawPayload: []byte(
+					`{
+						"name": "test",
+						"type": "company",
+						"cnpj": "12.ABC.345/01DE-35",
+						"balance": 10050
+					}`,
+				),
+			},
+			ucMock: mockAccountStore{
+				result: usecase.AccountOutput{
+					ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+					Name:      "Test",
+					TaxID:     "12ABC34501DE35",
+					TaxIDType: "cnpj",
+					Balance:   100.5,
+					CreatedAt: time.Time{},
+				},
+				err: nil,
+			},
+			expectedBody:       []byte(`{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Test","tax_id":"12ABC34501DE35","tax_id_type":"cnpj","balance":100.5,"created_at":"0001-01-01T00:00:00Z"}`),
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Store action error invalid CNPJ",
+			args: args{
+				rawPayload: []byte(
+					`{
+						"name": "test",
+						"type": "company",
+						"cnpj": "11.222.333/0001-82",
+						"balance": 10050
+					}`,
+				),
+			},
+			ucMock: mockAccountStore{
+				result: usecase.AccountOutput{},
+				err:    nil,
+			},
+			expectedBody:       []byte(`{"errors":["CNPJ must be a valid CNPJ"],"code":"invalid_input"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Store action error company without CNPJ",
+			args: args{
+				rawPayload: []byte(
+					`{
+						"name": "test",
+						"type": "company",
+						"cpf": "44451598087",
+						"balance": 10050
+					}`,
+				),
+			},
+			ucMock: mockAccountStore{
+				result: usecase.AccountOutput{},
+				err:    nil,
+			},
+			expectedBody:       []byte(`{"errors":["CNPJ is a required field"],"code":"invalid_input"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Store action error invalid holder type",
+			args: args{
+				rawPayload: []byte(
+					`{
+						"name": "test",
+						"type": "trust",
+						"cpf": "44451598087",
+						"balance": 10050
+					}`,
+				),
+			},
+			ucMock: mockAccountStore{
+				result: usecase.AccountOutput{},
+				err:    nil,
+			},
+			expectedBody:       []byte(`{"errors":["Type must be one of [individual company]"],"code":"invalid_input"}`),
 			expectedStatusCode: http.StatusBadRequest,
 		},
 		{
@@ -158,7 +269,7 @@ func TestAccount_Store(t *testing.T) {
 				result: usecase.AccountOutput{},
 				err:    nil,
 			},
-			expectedBody:       []byte(`{"errors":["invalid character '}' looking for beginning of value"]}`),
+			expectedBody:       []byte(`{"errors":["invalid character '}' looking for beginning of value"],"code":"invalid_json"}`),
 			expectedStatusCode: http.StatusBadRequest,
 		},
 	}
@@ -201,14 +312,142 @@ func TestAccount_Store(t *testing.T) {
 	}
 }
 
+func TestAccount_StoreProblemDetails(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	type args struct {
+		rawPayload []byte
+		accept     string
+	}
+
+	tests := []struct {
+		name                string
+		args                args
+		ucMock              usecase.AccountUseCase
+		expectedBody        []byte
+		expectedStatusCode  int
+		expectedContentType string
+	}{
+		{
+			name: "Store action invalid fields as problem details",
+			args: args{
+				rawPayload: []byte(
+					`{
+						"name": "",
+						"type": "company",
+						"cnpj": "11.222.333/0001-82",
+						"balance": 10
+					}`,
+				),
+				accept: "application/problem+json",
+			},
+			ucMock: mockAccountStore{
+				result: usecase.AccountOutput{},
+				err:    nil,
+			},
+			expectedBody:        []byte(`{"type":"urn:go-bank-transfer:problem:invalid_input","title":"Invalid request body","status":400,"detail":"Name is a required field; CNPJ must be a valid CNPJ","instance":"/v1/accounts","code":"invalid_input","invalid_params":[{"name":"name","reason":"Name is a required field"},{"name":"cnpj","reason":"CNPJ must be a valid CNPJ"}]}`),
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedContentType: "application/problem+json",
+		},
+		{
+			name: "Store action account already exists as problem details",
+			args: args{
+				rawPayload: []byte(
+					`{
+						"name": "test",
+						"cpf": "44451598087",
+						"balance": 10
+					}`,
+				),
+				accept: "application/json;q=0.5, application/problem+json",
+			},
+			ucMock: mockAccountStore{
+				result: usecase.AccountOutput{},
+				err:    errors.Wrap(domain.ErrAccountAlreadyExists, "error creating account"),
+			},
+			expectedBody:        []byte(`{"type":"urn:go-bank-transfer:problem:account_already_exists","title":"Account already exists","status":409,"detail":"account already exists","instance":"/v1/accounts","code":"account_already_exists"}`),
+			expectedStatusCode:  http.StatusConflict,
+			expectedContentType: "application/problem+json",
+		},
+		{
+			name: "Store action problem details refused by quality value",
+			args: args{
+				rawPayload: []byte(
+					`{
+						"name": "test",
+						"cpf": "44451598087",
+						"balance": 10
+					}`,
+				),
+				accept: "application/problem+json;q=0, application/json",
+			},
+			ucMock: mockAccountStore{
+				result: usecase.AccountOutput{},
+				err:    errors.New("error"),
+			},
+			expectedBody:        []byte(`{"errors":["internal server error"],"code":"internal_error"}`),
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedContentType: "application/json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(
+				http.MethodPost,
+				"/v1/accounts",
+				bytes.NewReader(tt.args.rawPayload),
+			)
+			req.Header.Set("Accept", tt.args.accept)
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewAccount(tt.ucMock, logger.LoggerMock{}, validator)
+			)
+
+			action.Store(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if contentType := w.Header().Get("Content-Type"); contentType != tt.expectedContentType {
+				t.Errorf(
+					"[TestCase '%s'] Content-Type: '%v' | Expected: '%v'",
+					tt.name,
+					contentType,
+					tt.expectedContentType,
+				)
+			}
+
+			var result = bytes.TrimSpace(w.Body.Bytes())
+			if !bytes.Equal(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%s' | Expected: '%s'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
+
 type mockAccountFindAll struct {
 	usecase.AccountUseCase
 
-	result []usecase.AccountOutput
+	result usecase.AccountListOutput
 	err    error
 }
 
-func (m mockAccountFindAll) FindAll(_ context.Context) ([]usecase.AccountOutput, error) {
+func (m mockAccountFindAll) FindAll(_ context.Context, _ domain.Pagination) (usecase.AccountListOutput, error) {
 	return m.result, m.err
 }
 
@@ -219,6 +458,7 @@ func TestAccount_Index(t *testing.T) {
 
 	tests := []struct {
 		name               string
+		query              string
 		ucMock             usecase.AccountUseCase
 		expectedBody       []byte
 		expectedStatusCode int
@@ -226,27 +466,31 @@ func TestAccount_Index(t *testing.T) {
 		{
 			name: "FindAll handler success one account",
 			ucMock: mockAccountFindAll{
-				result: []usecase.AccountOutput{
-					{
-						ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
-						Name:      "Test",
-						CPF:       "07094564964",
-						Balance:   10,
-						CreatedAt: time.Time{},
+				result: usecase.AccountListOutput{
+					Data: []usecase.AccountOutput{
+						{
+							ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+							Name:      "Test",
+							TaxID:     "07094564964",
+							TaxIDType: "cpf",
+							Balance:   10,
+							CreatedAt: time.Time{},
+						},
 					},
+					NextCursor: "MjAyMC0wNi0wMVQxMjozMDowMFp8M2MwOTZhNDA",
 				},
 				err: nil,
 			},
-			expectedBody:       []byte(`[{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Test","cpf":"07094564964","balance":10,"created_at":"0001-01-01T00:00:00Z"}]`),
+			expectedBody:       []byte(`{"data":[{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Test","tax_id":"07094564964","tax_id_type":"cpf","balance":10,"created_at":"0001-01-01T00:00:00Z"}],"next_cursor":"MjAyMC0wNi0wMVQxMjozMDowMFp8M2MwOTZhNDA"}`),
 			expectedStatusCode: http.StatusOK,
 		},
 		{
 			name: "FindAll handler success empty",
 			ucMock: mockAccountFindAll{
-				result: []usecase.AccountOutput{},
+				result: usecase.AccountListOutput{Data: []usecase.AccountOutput{}},
 				err:    nil,
 			},
-			expectedBody:       []byte(`[]`),
+			expectedBody:       []byte(`{"data":[],"next_cursor":""}`),
 			expectedStatusCode: http.StatusOK,
 		},
 		{
@@ -254,14 +498,29 @@ func TestAccount_Index(t *testing.T) {
 			ucMock: mockAccountFindAll{
 				err: errors.New("error"),
 			},
-			expectedBody:       []byte(`{"errors":["error"]}`),
+			expectedBody:       []byte(`{"errors":["internal server error"],"code":"internal_error"}`),
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:               "FindAll handler invalid limit",
+			query:              "limit=101",
+			ucMock:             mockAccountFindAll{},
+			expectedBody:       []byte(`{"errors":["limit must be a number between 1 and 100"],"code":"invalid_parameter"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "FindAll handler invalid cursor",
+			query:              "cursor=invalid",
+			ucMock:             mockAccountFindAll{},
+			expectedBody:       []byte(`{"errors":["cursor is invalid"],"code":"invalid_parameter"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			req, _ := http.NewRequest(http.MethodGet, "/accounts", nil)
+			req, _ := http.NewRequest(http.MethodGet, "/accounts?"+tt.query, nil)
+			req = withPrincipal(req, adminPrincipal)
 
 			var (
 				w      = httptest.NewRecorder()
@@ -292,6 +551,177 @@ func TestAccount_Index(t *testing.T) {
 	}
 }
 
+type mockAccountFindByID struct {
+	usecase.AccountUseCase
+
+	result usecase.AccountOutput
+	err    error
+}
+
+func (m mockAccountFindByID) FindByID(_ context.Context, _ domain.AccountID) (usecase.AccountOutput, error) {
+	return m.result, m.err
+}
+
+func TestAccount_FindByID(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	const etag = `"ba22428ba10be7ee556d3c1eb749eec7"`
+
+	type args struct {
+		accountID   string
+		ifNoneMatch string
+	}
+
+	tests := []struct {
+		name               string
+		args               args
+		ucMock             usecase.AccountUseCase
+		expectedBody       []byte
+		expectedStatusCode int
+		expectedETag       string
+	}{
+		{
+			name: "FindByID action success",
+			args: args{
+				accountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+			},
+			ucMock: mockAccountFindByID{
+				result: usecase.AccountOutput{
+					ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+					Name:      "Test",
+					TaxID:     "07094564964",
+					TaxIDType: "cpf",
+					Balance:   10,
+					CreatedAt: time.Time{},
+				},
+				err: nil,
+			},
+			expectedBody:       []byte(`{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Test","tax_id":"07094564964","tax_id_type":"cpf","balance":10,"created_at":"0001-01-01T00:00:00Z"}`),
+			expectedStatusCode: http.StatusOK,
+			expectedETag:       etag,
+		},
+		{
+			name: "FindByID action not modified",
+			args: args{
+				accountID:   "3c096a40-ccba-4b58-93ed-57379ab04680",
+				ifNoneMatch: `"stale", W/` + etag,
+			},
+			ucMock: mockAccountFindByID{
+				result: usecase.AccountOutput{
+					ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+					Name:      "Test",
+					TaxID:     "07094564964",
+					TaxIDType: "cpf",
+					Balance:   10,
+					CreatedAt: time.Time{},
+				},
+				err: nil,
+			},
+			expectedBody:       []byte(``),
+			expectedStatusCode: http.StatusNotModified,
+			expectedETag:       etag,
+		},
+		{
+			name: "FindByID action modified",
+			args: args{
+				accountID:   "3c096a40-ccba-4b58-93ed-57379ab04680",
+				ifNoneMatch: etag,
+			},
+			ucMock: mockAccountFindByID{
+				result: usecase.AccountOutput{
+					ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+					Name:      "Test",
+					TaxID:     "07094564964",
+					TaxIDType: "cpf",
+					Balance:   5,
+					CreatedAt: time.Time{},
+				},
+				err: nil,
+			},
+			expectedBody:       []byte(`{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Test","tax_id":"07094564964","tax_id_type":"cpf","balance":5,"created_at":"0001-01-01T00:00:00Z"}`),
+			expectedStatusCode: http.StatusOK,
+			expectedETag:       `"d5f4c40b9eac0dafa69a7d73f1003d38"`,
+		},
+		{
+			name: "FindByID action error not found",
+			args: args{
+				accountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+			},
+			ucMock: mockAccountFindByID{
+				result: usecase.AccountOutput{},
+				err:    errors.Wrap(domain.ErrNotFound, "error fetching account"),
+			},
+			expectedBody:       []byte(`{"errors":["not found"],"code":"not_found"}`),
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "FindByID action error parameter invalid",
+			args: args{
+				accountID: "error",
+			},
+			ucMock: mockAccountFindByID{
+				result: usecase.AccountOutput{},
+				err:    nil,
+			},
+			expectedBody:       []byte(`{"errors":["parameter invalid"],"code":"invalid_parameter"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/accounts/%s", tt.args.accountID)
+			req, _ := http.NewRequest(http.MethodGet, uri, nil)
+			if tt.args.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.args.ifNoneMatch)
+			}
+
+			q := req.URL.Query()
+			q.Add("account_id", tt.args.accountID)
+			req.URL.RawQuery = q.Encode()
+			req = withPrincipal(req, adminPrincipal)
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewAccount(tt.ucMock, logger.LoggerMock{}, validator)
+			)
+
+			action.FindByID(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if result := w.Header().Get("ETag"); result != tt.expectedETag {
+				t.Errorf("[TestCase '%s'] ETag: '%v' | Expected: '%v'", tt.name, result, tt.expectedETag)
+			}
+
+			var result = bytes.TrimSpace(w.Body.Bytes())
+			if !bytes.Equal(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%s' | Expected: '%s'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
+
+var adminPrincipal = auth.NewPrincipal("backoffice", []domain.Scope{domain.ScopeAdmin})
+
+func withPrincipal(req *http.Request, p auth.Principal) *http.Request {
+	return req.WithContext(auth.WithPrincipal(req.Context(), p))
+}
+
 type mockAccountFindBalance struct {
 	usecase.AccountUseCase
 
@@ -342,7 +772,7 @@ func TestAccount_FindBalance(t *testing.T) {
 				result: usecase.AccountBalanceOutput{},
 				err:    errors.New("error"),
 			},
-			expectedBody:       []byte(`{"errors":["error"]}`),
+			expectedBody:       []byte(`{"errors":["internal server error"],"code":"internal_error"}`),
 			expectedStatusCode: http.StatusInternalServerError,
 		},
 		{
@@ -354,7 +784,7 @@ func TestAccount_FindBalance(t *testing.T) {
 				result: usecase.AccountBalanceOutput{},
 				err:    nil,
 			},
-			expectedBody:       []byte(`{"errors":["parameter invalid"]}`),
+			expectedBody:       []byte(`{"errors":["parameter invalid"],"code":"invalid_parameter"}`),
 			expectedStatusCode: http.StatusBadRequest,
 		},
 		{
@@ -364,10 +794,22 @@ func TestAccount_FindBalance(t *testing.T) {
 			},
 			ucMock: mockAccountFindBalance{
 				result: usecase.AccountBalanceOutput{},
-				err:    domain.ErrNotFound,
+				err:    errors.Wrap(domain.ErrNotFound, "error fetching account balance"),
 			},
-			expectedBody:       []byte(`{"errors":["not found"]}`),
-			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       []byte(`{"errors":["not found"],"code":"not_found"}`),
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "FindBalance action error timeout",
+			args: args{
+				accountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+			},
+			ucMock: mockAccountFindBalance{
+				result: usecase.AccountBalanceOutput{},
+				err:    errors.Wrap(context.DeadlineExceeded, "error fetching account balance"),
+			},
+			expectedBody:       []byte(`{"errors":["request timeout"],"code":"timeout"}`),
+			expectedStatusCode: http.StatusGatewayTimeout,
 		},
 	}
 
@@ -379,6 +821,7 @@ func TestAccount_FindBalance(t *testing.T) {
 			q := req.URL.Query()
 			q.Add("account_id", tt.args.accountID)
 			req.URL.RawQuery = q.Encode()
+			req = withPrincipal(req, adminPrincipal)
 
 			var (
 				w      = httptest.NewRecorder()
@@ -408,3 +851,314 @@ func TestAccount_FindBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestAccount_FindBalanceAuthorization(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	var accountID = "3c096a40-ccba-4b58-93ed-57379ab04680"
+
+	tests := []struct {
+		name               string
+		principal          auth.Principal
+		authenticated      bool
+		expectedBody       []byte
+		expectedStatusCode int
+	}{
+		{
+			name:               "FindBalance action owner of the account",
+			principal:          auth.NewPrincipal(accountID, nil),
+			authenticated:      true,
+			expectedBody:       []byte(`{"balance":10}`),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "FindBalance action admin",
+			principal:          adminPrincipal,
+			authenticated:      true,
+			expectedBody:       []byte(`{"balance":10}`),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "FindBalance action error account of another owner",
+			principal:          auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04681", nil),
+			authenticated:      true,
+			expectedBody:       []byte(`{"errors":["access to the account is not allowed"],"code":"forbidden"}`),
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "FindBalance action error without principal",
+			expectedBody:       []byte(`{"errors":["access to the account is not allowed"],"code":"forbidden"}`),
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/accounts/"+accountID+"/balance?account_id="+accountID, nil)
+			if tt.authenticated {
+				req = withPrincipal(req, tt.principal)
+			}
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewAccount(
+					mockAccountFindBalance{result: usecase.AccountBalanceOutput{Balance: 10}},
+					logger.LoggerMock{},
+					validator,
+				)
+			)
+
+			action.FindBalance(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = bytes.TrimSpace(w.Body.Bytes())
+			if !bytes.Equal(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%s' | Expected: '%s'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
+
+func TestAccount_FindByIDAuthorization(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	var accountID = "3c096a40-ccba-4b58-93ed-57379ab04680"
+
+	tests := []struct {
+		name               string
+		principal          auth.Principal
+		authenticated      bool
+		expectedStatusCode int
+	}{
+		{
+			name:               "FindByID action owner of the account",
+			principal:          auth.NewPrincipal(accountID, nil),
+			authenticated:      true,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "FindByID action error account of another owner",
+			principal:          auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04681", nil),
+			authenticated:      true,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "FindByID action error without principal",
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/accounts/"+accountID+"?account_id="+accountID, nil)
+			if tt.authenticated {
+				req = withPrincipal(req, tt.principal)
+			}
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewAccount(
+					mockAccountFindByID{result: usecase.AccountOutput{ID: accountID, Balance: 10}},
+					logger.LoggerMock{},
+					validator,
+				)
+			)
+
+			action.FindByID(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+		})
+	}
+}
+
+func TestAccount_IndexBalanceVisibility(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	var ucMock = mockAccountFindAll{
+		result: usecase.AccountListOutput{
+			Data: []usecase.AccountOutput{
+				{ID: "3c096a40-ccba-4b58-93ed-57379ab04680", Name: "Owner", Balance: 10},
+				{ID: "3c096a40-ccba-4b58-93ed-57379ab04681", Name: "Other", Balance: 20},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		principal    auth.Principal
+		expectedBody []byte
+	}{
+		{
+			name:         "FindAll handler admin sees every balance",
+			principal:    adminPrincipal,
+			expectedBody: []byte(`{"data":[{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Owner","tax_id":"","tax_id_type":"","balance":10,"created_at":"0001-01-01T00:00:00Z"},{"id":"3c096a40-ccba-4b58-93ed-57379ab04681","name":"Other","tax_id":"","tax_id_type":"","balance":20,"created_at":"0001-01-01T00:00:00Z"}],"next_cursor":""}`),
+		},
+		{
+			name:         "FindAll handler owner only sees the own balance",
+			principal:    auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04680", nil),
+			expectedBody: []byte(`{"data":[{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Owner","tax_id":"","tax_id_type":"","balance":10,"created_at":"0001-01-01T00:00:00Z"},{"id":"3c096a40-ccba-4b58-93ed-57379ab04681","name":"Other","tax_id":"","tax_id_type":"","created_at":"0001-01-01T00:00:00Z"}],"next_cursor":""}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/accounts", nil)
+			req = withPrincipal(req, tt.principal)
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewAccount(ucMock, logger.LoggerMock{}, validator)
+			)
+
+			action.FindAll(w, req)
+
+			var result = bytes.TrimSpace(w.Body.Bytes())
+			if !bytes.Equal(result, tt.expectedBody) {
+				t.Errorf("[TestCase '%s'] Result: '%s' | Expected: '%s'", tt.name, result, tt.expectedBody)
+			}
+		})
+	}
+}
+
+type mockBalanceFindAt struct {
+	result usecase.AccountBalanceOutput
+	err    error
+	at     *time.Time
+}
+
+func (m mockBalanceFindAt) FindAt(_ context.Context, _ domain.AccountID, at time.Time) (usecase.AccountBalanceOutput, error) {
+	if m.at != nil {
+		*m.at = at
+	}
+
+	return m.result, m.err
+}
+
+func TestAccount_FindBalanceAt(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	var at = time.Date(2020, 3, 31, 23, 59, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		at                 string
+		ucMock             mockBalanceFindAt
+		expectedAt         time.Time
+		expectedBody       []byte
+		expectedStatusCode int
+	}{
+		{
+			name: "FindBalance action success with timestamp",
+			at:   "2020-03-31T23:59:00Z",
+			ucMock: mockBalanceFindAt{
+				result: usecase.AccountBalanceOutput{Balance: 10, At: &at},
+			},
+			expectedAt:         at,
+			expectedBody:       []byte(`{"balance":10,"at":"2020-03-31T23:59:00Z"}`),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "FindBalance action success with date includes the whole day",
+			at:   "2020-03-31",
+			ucMock: mockBalanceFindAt{
+				result: usecase.AccountBalanceOutput{Balance: 10, At: &at},
+			},
+			expectedAt:         time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+			expectedBody:       []byte(`{"balance":10,"at":"2020-03-31T23:59:00Z"}`),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "FindBalance action error invalid at",
+			at:                 "31/03/2020",
+			ucMock:             mockBalanceFindAt{},
+			expectedBody:       []byte(`{"errors":["at must be a date (YYYY-MM-DD) or an RFC 3339 timestamp"],"code":"invalid_parameter"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "FindBalance action error fetching account",
+			at:   "2020-03-31T23:59:00Z",
+			ucMock: mockBalanceFindAt{
+				err: errors.Wrap(domain.ErrNotFound, "error fetching account"),
+			},
+			expectedAt:         at,
+			expectedBody:       []byte(`{"errors":["not found"],"code":"not_found"}`),
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var accountID = "3c096a40-ccba-4b58-93ed-57379ab04680"
+
+			uri := fmt.Sprintf("/accounts/%s/balance", accountID)
+			req, _ := http.NewRequest(http.MethodGet, uri, nil)
+
+			q := req.URL.Query()
+			q.Add("account_id", accountID)
+			q.Add("at", tt.at)
+			req.URL.RawQuery = q.Encode()
+			req = withPrincipal(req, adminPrincipal)
+
+			var (
+				w        = httptest.NewRecorder()
+				received time.Time
+			)
+
+			tt.ucMock.at = &received
+			var action = NewAccountBalance(mockAccountFindBalance{}, tt.ucMock, logger.LoggerMock{}, validator)
+
+			action.FindBalance(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if !received.Equal(tt.expectedAt) {
+				t.Errorf("[TestCase '%s'] At: '%v' | Expected: '%v'", tt.name, received, tt.expectedAt)
+			}
+
+			var result = bytes.TrimSpace(w.Body.Bytes())
+			if !bytes.Equal(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%s' | Expected: '%s'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package action
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/input"
+	"github.com/gsabadini/go-bank-transfer/api/logging"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+//DefaultAPIKeyOverlap é o período em que a APIKey rotacionada continua aceita quando overlap_seconds não é informado
+const DefaultAPIKeyOverlap = 24 * time.Hour
+
+//APIKey armazena as dependências para as ações de APIKey
+type APIKey struct {
+	uc        usecase.APIKeyUseCase
+	log       logger.Logger
+	validator validator.Validator
+}
+
+//NewAPIKey constrói um APIKey com suas dependências
+func NewAPIKey(uc usecase.APIKeyUseCase, l logger.Logger, v validator.Validator) APIKey {
+	return APIKey{uc: uc, log: l, validator: v}
+}
+
+//Store é um handler para criação de APIKey, a chave é retornada apenas nesta resposta
+func (a APIKey) Store(w http.ResponseWriter, r *http.Request) {
+	const logKey = "create_api_key"
+
+	var inputAPIKey input.APIKey
+	if !a.decode(w, r, logKey, &inputAPIKey) {
+		return
+	}
+
+	if errs := inputAPIKey.Validate(a.validator); len(errs) > 0 {
+		a.invalid(w, r, logKey, errs)
+		return
+	}
+
+	output, err := a.uc.Store(
+		r.Context(),
+		inputAPIKey.Name,
+		inputAPIKey.DomainScopes(),
+		domain.AccountID(inputAPIKey.AccountID),
+		inputAPIKey.Expiration(),
+	)
+	if err != nil {
+		a.fail(w, r, logKey, "error when creating a new api key", err)
+		return
+	}
+	logging.NewInfo(a.log, logKey, "success creating api key", http.StatusCreated).Log()
+
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
+
+//FindAll é um handler para retornar todas as APIKey, sem as chaves
+func (a APIKey) FindAll(w http.ResponseWriter, r *http.Request) {
+	const logKey = "find_all_api_key"
+
+	output, err := a.uc.FindAll(r.Context())
+	if err != nil {
+		a.fail(w, r, logKey, "error when returning api key list", err)
+		return
+	}
+	logging.NewInfo(a.log, logKey, "success when returning api key list", http.StatusOK).Log()
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+//Rotate é um handler para rotação de uma APIKey
+//
+//Aceita um corpo opcional com overlap_seconds, o período em que a chave atual continua aceita
+func (a APIKey) Rotate(w http.ResponseWriter, r *http.Request) {
+	const logKey = "rotate_api_key"
+
+	ID, ok := a.apiKeyID(w, r, logKey)
+	if !ok {
+		return
+	}
+
+	var inputRotate input.RotateAPIKey
+	if !a.decode(w, r, logKey, &inputRotate) {
+		return
+	}
+
+	if errs := inputRotate.Validate(a.validator); len(errs) > 0 {
+		a.invalid(w, r, logKey, errs)
+		return
+	}
+
+	output, err := a.uc.Rotate(r.Context(), ID, inputRotate.Overlap(DefaultAPIKeyOverlap))
+	if err != nil {
+		a.fail(w, r, logKey, "error when rotating api key", err)
+		return
+	}
+	logging.NewInfo(a.log, logKey, "success rotating api key", http.StatusCreated).Log()
+
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
+
+//Revoke é um handler para revogação de uma APIKey
+func (a APIKey) Revoke(w http.ResponseWriter, r *http.Request) {
+	const logKey = "revoke_api_key"
+
+	ID, ok := a.apiKeyID(w, r, logKey)
+	if !ok {
+		return
+	}
+
+	if err := a.uc.Revoke(r.Context(), ID); err != nil {
+		a.fail(w, r, logKey, "error when revoking api key", err)
+		return
+	}
+	logging.NewInfo(a.log, logKey, "success revoking api key", http.StatusNoContent).Log()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//decode lê o corpo da requisição, um corpo vazio mantém os valores padrão. Responde 400 quando o JSON é inválido
+func (a APIKey) decode(w http.ResponseWriter, r *http.Request, logKey string, v interface{}) bool {
+	defer r.Body.Close()
+
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && err != io.EOF {
+		logging.NewError(
+			a.log,
+			logKey,
+			"error when decoding json",
+			http.StatusBadRequest,
+			err,
+		).Log()
+
+		response.NewErrorWithCode(err, response.CodeInvalidJSON, http.StatusBadRequest).Send(w, r)
+		return false
+	}
+
+	return true
+}
+
+//invalid registra o erro no log e responde 400 com os campos inválidos
+func (a APIKey) invalid(w http.ResponseWriter, r *http.Request, logKey string, errs []validator.FieldError) {
+	logging.NewError(
+		a.log,
+		logKey,
+		"invalid input",
+		http.StatusBadRequest,
+		errors.New("invalid input"),
+	).Log()
+
+	response.NewErrorFields(errs, response.CodeInvalidInput, http.StatusBadRequest).Send(w, r)
+}
+
+//apiKeyID lê o identificador da APIKey, respondendo 400 quando inválido
+func (a APIKey) apiKeyID(w http.ResponseWriter, r *http.Request, logKey string) (domain.APIKeyID, bool) {
+	var ID = r.URL.Query().Get("api_key_id")
+	if !domain.IsValidUUID(ID) {
+		a.fail(w, r, logKey, "parameter invalid", response.ErrParameterInvalid)
+		return "", false
+	}
+
+	return domain.APIKeyID(ID), true
+}
+
+//fail registra o erro no log e responde conforme o catálogo de erros
+func (a APIKey) fail(w http.ResponseWriter, r *http.Request, logKey string, message string, err error) {
+	var resErr = response.TranslateError(err)
+	logging.NewError(
+		a.log,
+		logKey,
+		message,
+		resErr.StatusCode(),
+		err,
+	).Log()
+
+	resErr.Send(w, r)
+}
@@ -0,0 +1,272 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type mockAPIKey struct {
+	usecase.APIKeyUseCase
+
+	result  usecase.APIKeyOutput
+	overlap *time.Duration
+	err     error
+}
+
+func (m mockAPIKey) Store(
+	_ context.Context,
+	_ string,
+	_ []domain.Scope,
+	_ domain.AccountID,
+	_ time.Time,
+) (usecase.APIKeyOutput, error) {
+	return m.result, m.err
+}
+
+func (m mockAPIKey) Rotate(_ context.Context, _ domain.APIKeyID, overlap time.Duration) (usecase.APIKeyOutput, error) {
+	if m.overlap != nil {
+		*m.overlap = overlap
+	}
+
+	return m.result, m.err
+}
+
+func (m mockAPIKey) Revoke(_ context.Context, _ domain.APIKeyID) error {
+	return m.err
+}
+
+func TestAPIKey_Store(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	tests := []struct {
+		name               string
+		rawPayload         []byte
+		ucMock             usecase.APIKeyUseCase
+		expectedBody       []byte
+		expectedStatusCode int
+	}{
+		{
+			name:       "Store action success",
+			rawPayload: []byte(`{"name":"batch","scopes":["transfers:write"]}`),
+			ucMock: mockAPIKey{
+				result: usecase.APIKeyOutput{
+					ID:     "3c096a40-ccba-4b58-93ed-57379ab04680",
+					Name:   "batch",
+					Prefix: "gbk_abcdefgh",
+					Scopes: []string{"transfers:write"},
+					Key:    "gbk_abcdefghijklmnop",
+				},
+			},
+			expectedBody:       []byte(`{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"batch","prefix":"gbk_abcdefgh","scopes":["transfers:write"],"key":"gbk_abcdefghijklmnop","created_at":"0001-01-01T00:00:00Z"}`),
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Store action error invalid scope",
+			rawPayload:         []byte(`{"name":"batch","scopes":["transfers:delete"]}`),
+			ucMock:             mockAPIKey{},
+			expectedBody:       []byte(`{"errors":["scopes must contain only [accounts:read accounts:write transfers:read transfers:write webhooks:read webhooks:write admin]"],"code":"invalid_input"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Store action error expiration in the past",
+			rawPayload:         []byte(`{"name":"batch","scopes":["admin"],"expires_at":"2020-01-01T00:00:00Z"}`),
+			ucMock:             mockAPIKey{},
+			expectedBody:       []byte(`{"errors":["expires_at must be in the future"],"code":"invalid_input"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Store action error invalid JSON",
+			rawPayload:         []byte(`{"name":`),
+			ucMock:             mockAPIKey{},
+			expectedBody:       []byte(`{"errors":["unexpected EOF"],"code":"invalid_json"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/api-keys", bytes.NewReader(tt.rawPayload))
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewAPIKey(tt.ucMock, logger.LoggerMock{}, validator)
+			)
+
+			action.Store(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = bytes.TrimSpace(w.Body.Bytes())
+			if !bytes.Equal(result, tt.expectedBody) {
+				t.Errorf("[TestCase '%s'] Result: '%s' | Expected: '%s'", tt.name, result, tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestAPIKey_Rotate(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	tests := []struct {
+		name               string
+		apiKeyID           string
+		rawPayload         []byte
+		err                error
+		expectedOverlap    time.Duration
+		expectedStatusCode int
+	}{
+		{
+			name:               "Rotate action success with default overlap",
+			apiKeyID:           "3c096a40-ccba-4b58-93ed-57379ab04680",
+			expectedOverlap:    DefaultAPIKeyOverlap,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Rotate action success with overlap",
+			apiKeyID:           "3c096a40-ccba-4b58-93ed-57379ab04680",
+			rawPayload:         []byte(`{"overlap_seconds":60}`),
+			expectedOverlap:    time.Minute,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Rotate action success without overlap",
+			apiKeyID:           "3c096a40-ccba-4b58-93ed-57379ab04680",
+			rawPayload:         []byte(`{"overlap_seconds":0}`),
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Rotate action error negative overlap",
+			apiKeyID:           "3c096a40-ccba-4b58-93ed-57379ab04680",
+			rawPayload:         []byte(`{"overlap_seconds":-1}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Rotate action error api key inactive",
+			apiKeyID:           "3c096a40-ccba-4b58-93ed-57379ab04680",
+			err:                domain.ErrAPIKeyInactive,
+			expectedOverlap:    DefaultAPIKeyOverlap,
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "Rotate action error api key not found",
+			apiKeyID:           "3c096a40-ccba-4b58-93ed-57379ab04680",
+			err:                domain.ErrNotFound,
+			expectedOverlap:    DefaultAPIKeyOverlap,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Rotate action error invalid api key id",
+			apiKeyID:           "error",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/api-keys/rotate", bytes.NewReader(tt.rawPayload))
+
+			q := req.URL.Query()
+			q.Add("api_key_id", tt.apiKeyID)
+			req.URL.RawQuery = q.Encode()
+
+			var (
+				w       = httptest.NewRecorder()
+				overlap time.Duration
+				action  = NewAPIKey(mockAPIKey{overlap: &overlap, err: tt.err}, logger.LoggerMock{}, validator)
+			)
+
+			action.Rotate(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if overlap != tt.expectedOverlap {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, overlap, tt.expectedOverlap)
+			}
+		})
+	}
+}
+
+func TestAPIKey_Revoke(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	tests := []struct {
+		name               string
+		apiKeyID           string
+		ucMock             usecase.APIKeyUseCase
+		expectedStatusCode int
+	}{
+		{
+			name:               "Revoke action success",
+			apiKeyID:           "3c096a40-ccba-4b58-93ed-57379ab04680",
+			ucMock:             mockAPIKey{},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:               "Revoke action error api key not found",
+			apiKeyID:           "3c096a40-ccba-4b58-93ed-57379ab04680",
+			ucMock:             mockAPIKey{err: domain.ErrNotFound},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Revoke action error invalid api key id",
+			apiKeyID:           "error",
+			ucMock:             mockAPIKey{},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodDelete, "/api-keys", nil)
+
+			q := req.URL.Query()
+			q.Add("api_key_id", tt.apiKeyID)
+			req.URL.RawQuery = q.Encode()
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewAPIKey(tt.ucMock, logger.LoggerMock{}, validator)
+			)
+
+			action.Revoke(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+		})
+	}
+}
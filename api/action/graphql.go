@@ -0,0 +1,75 @@
+package action
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gsabadini/go-bank-transfer/api/graph"
+	"github.com/gsabadini/go-bank-transfer/api/logging"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+)
+
+//GraphQL armazena as dependências para a ação do endpoint GraphQL
+type GraphQL struct {
+	schema graph.Schema
+	log    logger.Logger
+}
+
+//NewGraphQL constrói um GraphQL com suas dependências
+func NewGraphQL(schema graph.Schema, l logger.Logger) GraphQL {
+	return GraphQL{schema: schema, log: l}
+}
+
+//Execute é um handler para execução de consultas e mutações GraphQL
+//
+//Consultas inválidas ou acima dos limites de profundidade e complexidade retornam 400. Erros dos resolvers são
+//retornados com 200 ao lado dos dados parciais, conforme a especificação GraphQL
+func (g GraphQL) Execute(w http.ResponseWriter, r *http.Request) {
+	const logKey = "graphql"
+
+	var req graph.Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logging.NewError(
+			g.log,
+			logKey,
+			"error when decoding json",
+			http.StatusBadRequest,
+			err,
+		).Log()
+
+		response.NewErrorWithCode(err, response.CodeInvalidJSON, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	//Erros sem path ocorrem antes da execução, na análise, na validação ou na verificação dos limites da consulta
+	var result = g.schema.Execute(r.Context(), req)
+	if result.Data == nil && result.HasErrors() && len(result.Errors[0].Path) == 0 {
+		logging.NewError(
+			g.log,
+			logKey,
+			"invalid query",
+			http.StatusBadRequest,
+			errors.New(result.Errors[0].Message),
+		).Log()
+
+		response.NewSuccess(result, http.StatusBadRequest).Send(w)
+		return
+	}
+
+	if result.HasErrors() {
+		logging.NewError(
+			g.log,
+			logKey,
+			"error when resolving query",
+			http.StatusOK,
+			graph.Cause(result.Errors[0]),
+		).Log()
+	} else {
+		logging.NewInfo(g.log, logKey, "success when executing query", http.StatusOK).Log()
+	}
+
+	response.NewSuccess(result, http.StatusOK).Send(w)
+}
@@ -0,0 +1,105 @@
+package action
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gsabadini/go-bank-transfer/api/graph"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+func TestGraphQL_Execute(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	schema, err := graph.NewSchema(
+		mockAccountFindAll{
+			result: usecase.AccountListOutput{
+				Data: []usecase.AccountOutput{{ID: "3c096a40-ccba-4b58-93ed-57379ab04680", Name: "Test"}},
+			},
+		},
+		mockTransferFindAll{},
+		mockBalanceFindAt{},
+		validator,
+		graph.Limits{MaxDepth: 3, MaxComplexity: 200},
+	)
+	if err != nil {
+		t.Fatalf("[TestCase 'NewSchema'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	tests := []struct {
+		name               string
+		rawPayload         []byte
+		expectedBody       []byte
+		expectedStatusCode int
+	}{
+		{
+			name:               "Execute action success",
+			rawPayload:         []byte(`{"query": "{ accounts(limit: 1) { data { id name } } }"}`),
+			expectedBody:       []byte(`{"data":{"accounts":{"data":[{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Test"}]}}}`),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Execute action error resolving field",
+			rawPayload:         []byte(`{"query": "{ accounts(limit: 0) { nextCursor } }"}`),
+			expectedBody:       []byte(`{"data":null,"errors":[{"message":"limit must be a number between 1 and 100","locations":[{"line":1,"column":3}],"path":["accounts"],"extensions":{"code":"invalid_parameter","invalid_params":[{"name":"limit","reason":"limit must be a number between 1 and 100"}]}}]}`),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Execute action invalid query",
+			rawPayload:         []byte(`{"query": "{ accounts { unknown } }"}`),
+			expectedBody:       []byte(`{"data":null,"errors":[{"message":"Cannot query field \"unknown\" on type \"AccountConnection\".","locations":[{"line":1,"column":14}],"extensions":{"code":"invalid_query"}}]}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Execute action query too deep",
+			rawPayload:         []byte(`{"query": "{ accounts { data { balance { amount } } } }"}`),
+			expectedBody:       []byte(`{"data":null,"errors":[{"message":"query is too deep: depth 4 exceeds the maximum of 3","locations":[],"extensions":{"code":"query_limit_exceeded"}}]}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Execute action invalid JSON",
+			rawPayload:         []byte(`{"query":`),
+			expectedBody:       []byte(`{"errors":["unexpected EOF"],"code":"invalid_json"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(tt.rawPayload))
+			req = withPrincipal(req, adminPrincipal)
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewGraphQL(schema, logger.LoggerMock{})
+			)
+
+			action.Execute(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = bytes.TrimSpace(w.Body.Bytes())
+			if !bytes.Equal(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%s' | Expected: '%s'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
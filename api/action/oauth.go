@@ -0,0 +1,210 @@
+package action
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/logging"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+//grantClientCredentials é o único grant type aceito pelo endpoint de token
+const grantClientCredentials = "client_credentials"
+
+//basicChallenge é o header WWW-Authenticate dos erros de autenticação de clientes que usaram HTTP Basic
+const basicChallenge = `Basic realm="oauth"`
+
+var errMultipleClientAuthentication = errors.New("client authenticated with more than one method")
+
+//OAuth armazena as dependências do endpoint de token e da publicação das chaves de assinatura
+type OAuth struct {
+	uc     usecase.OAuthClientUseCase
+	issuer auth.TokenIssuer
+	ring   *auth.KeyRing
+	log    logger.Logger
+}
+
+//NewOAuth constrói um OAuth com suas dependências
+func NewOAuth(uc usecase.OAuthClientUseCase, issuer auth.TokenIssuer, ring *auth.KeyRing, l logger.Logger) OAuth {
+	return OAuth{uc: uc, issuer: issuer, ring: ring, log: l}
+}
+
+//tokenResponse armazena o response de sucesso do endpoint de token (RFC 6749, seção 5.1)
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+//Token é um handler para emissão de tokens de acesso através do grant client_credentials (RFC 6749, seção 4.4)
+//
+//O cliente se autentica por HTTP Basic ou pelos campos client_id e client_secret do formulário, e pode solicitar
+//no campo scope um subconjunto dos seus escopos
+func (o OAuth) Token(w http.ResponseWriter, r *http.Request) {
+	const logKey = "oauth_token"
+
+	if contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); contentType != "application/x-www-form-urlencoded" {
+		o.reject(logKey, response.NewOAuthError(
+			response.OAuthInvalidRequest,
+			"content type must be application/x-www-form-urlencoded",
+			http.StatusBadRequest,
+		)).Send(w)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		o.reject(logKey, response.NewOAuthError(response.OAuthInvalidRequest, err.Error(), http.StatusBadRequest)).Send(w)
+		return
+	}
+
+	switch r.PostForm.Get("grant_type") {
+	case grantClientCredentials:
+	case "":
+		o.reject(logKey, response.NewOAuthError(
+			response.OAuthInvalidRequest,
+			"grant_type is required",
+			http.StatusBadRequest,
+		)).Send(w)
+		return
+	default:
+		o.reject(logKey, response.NewOAuthError(
+			response.OAuthUnsupportedGrantType,
+			fmt.Sprintf("grant_type must be %s", grantClientCredentials),
+			http.StatusBadRequest,
+		)).Send(w)
+		return
+	}
+
+	clientID, secret, basic, err := clientCredentials(r)
+	if err != nil {
+		o.reject(logKey, response.NewOAuthError(response.OAuthInvalidRequest, err.Error(), http.StatusBadRequest)).Send(w)
+		return
+	}
+
+	var requested = make([]domain.Scope, 0)
+	for _, scope := range strings.Fields(r.PostForm.Get("scope")) {
+		requested = append(requested, domain.Scope(scope))
+	}
+
+	var client usecase.OAuthClientOutput
+	if domain.IsValidUUID(clientID) {
+		client, err = o.uc.Authenticate(r.Context(), domain.OAuthClientID(clientID), secret, requested)
+	} else {
+		err = domain.ErrInvalidClient
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidClient):
+		var resErr = response.NewOAuthError(response.OAuthInvalidClient, err.Error(), http.StatusUnauthorized)
+		if basic {
+			resErr.WithChallenge(basicChallenge)
+		}
+
+		o.reject(logKey, resErr).Send(w)
+		return
+	case errors.Is(err, domain.ErrInvalidScope):
+		o.reject(logKey, response.NewOAuthError(response.OAuthInvalidScope, err.Error(), http.StatusBadRequest)).Send(w)
+		return
+	case err != nil:
+		o.fail(w, logKey, "error when authenticating oauth client", err)
+		return
+	}
+
+	token, err := o.issuer.Issue(r.Context(), client)
+	if err != nil {
+		o.fail(w, logKey, "error when issuing access token", err)
+		return
+	}
+	logging.NewInfo(o.log, logKey, "success issuing access token", http.StatusOK).Log()
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	response.NewSuccess(tokenResponse{
+		AccessToken: token.Token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(token.ExpiresIn.Seconds()),
+		Scope:       token.Scope,
+	}, http.StatusOK).Send(w)
+}
+
+//JWKS é um handler para publicação das chaves públicas que verificam os tokens de acesso (RFC 7517)
+//
+//O cache do response acompanha o intervalo de atualização das chaves, os consumidores devem buscar o JWKS novamente
+//ao encontrar um kid desconhecido
+func (o OAuth) JWKS(w http.ResponseWriter, r *http.Request) {
+	const logKey = "oauth_jwks"
+
+	keys, err := o.ring.PublicKeys(r.Context(), "")
+	if err != nil {
+		o.fail(w, logKey, "error when loading signing keys", err)
+		return
+	}
+
+	data, err := auth.MarshalJWKS(keys)
+	if err != nil {
+		o.fail(w, logKey, "error when encoding jwks", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int64(o.ring.Refresh().Seconds())))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+//clientCredentials lê as credenciais do cliente do header Authorization ou do formulário, que não podem ser usados
+//ao mesmo tempo. No header as credenciais são codificadas como application/x-www-form-urlencoded (RFC 6749, seção
+//2.3.1)
+func clientCredentials(r *http.Request) (clientID string, secret string, basic bool, err error) {
+	clientID, secret, basic = r.BasicAuth()
+	if !basic {
+		return r.PostForm.Get("client_id"), r.PostForm.Get("client_secret"), false, nil
+	}
+
+	if r.PostForm.Get("client_id") != "" || r.PostForm.Get("client_secret") != "" {
+		return "", "", true, errMultipleClientAuthentication
+	}
+
+	if clientID, err = url.QueryUnescape(clientID); err != nil {
+		return "", "", true, err
+	}
+
+	if secret, err = url.QueryUnescape(secret); err != nil {
+		return "", "", true, err
+	}
+
+	return clientID, secret, true, nil
+}
+
+//reject registra no log a requisição recusada e retorna o response de error
+func (o OAuth) reject(logKey string, resErr *response.OAuthError) *response.OAuthError {
+	logging.NewError(
+		o.log,
+		logKey,
+		"token request rejected",
+		resErr.StatusCode(),
+		errors.New(resErr.Description),
+	).Log()
+
+	return resErr
+}
+
+//fail registra o erro inesperado no log e responde server_error, sem expor os detalhes ao cliente
+func (o OAuth) fail(w http.ResponseWriter, logKey string, message string, err error) {
+	logging.NewError(o.log, logKey, message, http.StatusInternalServerError, err).Log()
+
+	response.NewOAuthError(
+		response.OAuthServerError,
+		"internal server error",
+		http.StatusInternalServerError,
+	).Send(w)
+}
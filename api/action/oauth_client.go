@@ -0,0 +1,121 @@
+package action
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gsabadini/go-bank-transfer/api/input"
+	"github.com/gsabadini/go-bank-transfer/api/logging"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+//OAuthClient armazena as dependências para as ações de OAuthClient
+type OAuthClient struct {
+	uc        usecase.OAuthClientUseCase
+	log       logger.Logger
+	validator validator.Validator
+}
+
+//NewOAuthClient constrói um OAuthClient com suas dependências
+func NewOAuthClient(uc usecase.OAuthClientUseCase, l logger.Logger, v validator.Validator) OAuthClient {
+	return OAuthClient{uc: uc, log: l, validator: v}
+}
+
+//Store é um handler para cadastro de OAuthClient, o client_secret é retornado apenas nesta resposta
+func (o OAuthClient) Store(w http.ResponseWriter, r *http.Request) {
+	const logKey = "create_oauth_client"
+
+	var inputClient input.OAuthClient
+	if err := json.NewDecoder(r.Body).Decode(&inputClient); err != nil {
+		logging.NewError(
+			o.log,
+			logKey,
+			"error when decoding json",
+			http.StatusBadRequest,
+			err,
+		).Log()
+
+		response.NewErrorWithCode(err, response.CodeInvalidJSON, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	if errs := inputClient.Validate(o.validator); len(errs) > 0 {
+		logging.NewError(
+			o.log,
+			logKey,
+			"invalid input",
+			http.StatusBadRequest,
+			errors.New("invalid input"),
+		).Log()
+
+		response.NewErrorFields(errs, response.CodeInvalidInput, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := o.uc.Store(
+		r.Context(),
+		inputClient.Name,
+		inputClient.DomainScopes(),
+		domain.AccountID(inputClient.AccountID),
+	)
+	if err != nil {
+		o.fail(w, r, logKey, "error when creating a new oauth client", err)
+		return
+	}
+	logging.NewInfo(o.log, logKey, "success creating oauth client", http.StatusCreated).Log()
+
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
+
+//FindAll é um handler para retornar todos os OAuthClient, sem os secrets
+func (o OAuthClient) FindAll(w http.ResponseWriter, r *http.Request) {
+	const logKey = "find_all_oauth_client"
+
+	output, err := o.uc.FindAll(r.Context())
+	if err != nil {
+		o.fail(w, r, logKey, "error when returning oauth client list", err)
+		return
+	}
+	logging.NewInfo(o.log, logKey, "success when returning oauth client list", http.StatusOK).Log()
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+//Delete é um handler para remoção de um OAuthClient, os tokens já emitidos continuam válidos até expirarem
+func (o OAuthClient) Delete(w http.ResponseWriter, r *http.Request) {
+	const logKey = "delete_oauth_client"
+
+	var ID = r.URL.Query().Get("client_id")
+	if !domain.IsValidUUID(ID) {
+		o.fail(w, r, logKey, "parameter invalid", response.ErrParameterInvalid)
+		return
+	}
+
+	if err := o.uc.Delete(r.Context(), domain.OAuthClientID(ID)); err != nil {
+		o.fail(w, r, logKey, "error when deleting oauth client", err)
+		return
+	}
+	logging.NewInfo(o.log, logKey, "success deleting oauth client", http.StatusNoContent).Log()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//fail registra o erro no log e responde conforme o catálogo de erros
+func (o OAuthClient) fail(w http.ResponseWriter, r *http.Request, logKey string, message string, err error) {
+	var resErr = response.TranslateError(err)
+	logging.NewError(
+		o.log,
+		logKey,
+		message,
+		resErr.StatusCode(),
+		err,
+	).Log()
+
+	resErr.Send(w, r)
+}
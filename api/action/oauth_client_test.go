@@ -0,0 +1,141 @@
+package action
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+func TestOAuthClient_Store(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	tests := []struct {
+		name               string
+		rawPayload         []byte
+		ucMock             usecase.OAuthClientUseCase
+		expectedBody       []byte
+		expectedStatusCode int
+	}{
+		{
+			name:       "Store action success",
+			rawPayload: []byte(`{"name":"partner","scopes":["transfers:write"]}`),
+			ucMock: mockOAuthClient{
+				result: usecase.OAuthClientOutput{
+					ClientID:     "3c096a40-ccba-4b58-93ed-57379ab04680",
+					ClientSecret: "secret",
+					Name:         "partner",
+					Scopes:       []string{"transfers:write"},
+				},
+			},
+			expectedBody:       []byte(`{"client_id":"3c096a40-ccba-4b58-93ed-57379ab04680","client_secret":"secret","name":"partner","scopes":["transfers:write"],"created_at":"0001-01-01T00:00:00Z"}`),
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Store action error invalid scope",
+			rawPayload:         []byte(`{"name":"partner","scopes":["transfers:delete"]}`),
+			ucMock:             mockOAuthClient{},
+			expectedBody:       []byte(`{"errors":["scopes must contain only [accounts:read accounts:write transfers:read transfers:write webhooks:read webhooks:write admin]"],"code":"invalid_input"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Store action error invalid JSON",
+			rawPayload:         []byte(`{"name":`),
+			ucMock:             mockOAuthClient{},
+			expectedBody:       []byte(`{"errors":["unexpected EOF"],"code":"invalid_json"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/oauth/clients", bytes.NewReader(tt.rawPayload))
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewOAuthClient(tt.ucMock, logger.LoggerMock{}, validator)
+			)
+
+			action.Store(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = bytes.TrimSpace(w.Body.Bytes())
+			if !bytes.Equal(result, tt.expectedBody) {
+				t.Errorf("[TestCase '%s'] Result: '%s' | Expected: '%s'", tt.name, result, tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestOAuthClient_Delete(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	tests := []struct {
+		name               string
+		clientID           string
+		ucMock             usecase.OAuthClientUseCase
+		expectedStatusCode int
+	}{
+		{
+			name:               "Delete action success",
+			clientID:           "3c096a40-ccba-4b58-93ed-57379ab04680",
+			ucMock:             mockOAuthClient{},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:               "Delete action error oauth client not found",
+			clientID:           "3c096a40-ccba-4b58-93ed-57379ab04680",
+			ucMock:             mockOAuthClient{err: domain.ErrNotFound},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Delete action error invalid client id",
+			clientID:           "error",
+			ucMock:             mockOAuthClient{},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodDelete, "/oauth/clients", nil)
+
+			q := req.URL.Query()
+			q.Add("client_id", tt.clientID)
+			req.URL.RawQuery = q.Encode()
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewOAuthClient(tt.ucMock, logger.LoggerMock{}, validator)
+			)
+
+			action.Delete(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type mockOAuthClient struct {
+	usecase.OAuthClientUseCase
+
+	result    usecase.OAuthClientOutput
+	requested *[]domain.Scope
+	err       error
+}
+
+func (m mockOAuthClient) Store(
+	_ context.Context,
+	_ string,
+	_ []domain.Scope,
+	_ domain.AccountID,
+) (usecase.OAuthClientOutput, error) {
+	return m.result, m.err
+}
+
+func (m mockOAuthClient) Delete(_ context.Context, _ domain.OAuthClientID) error {
+	return m.err
+}
+
+func (m mockOAuthClient) Authenticate(
+	_ context.Context,
+	ID domain.OAuthClientID,
+	secret string,
+	scopes []domain.Scope,
+) (usecase.OAuthClientOutput, error) {
+	if m.requested != nil {
+		*m.requested = scopes
+	}
+
+	if m.err != nil {
+		return usecase.OAuthClientOutput{}, m.err
+	}
+
+	if ID.String() != m.result.ClientID || secret != "secret" {
+		return usecase.OAuthClientOutput{}, domain.ErrInvalidClient
+	}
+
+	return m.result, nil
+}
+
+type stubSigningKeyUseCase struct {
+	keys []usecase.SigningKeyOutput
+}
+
+func (s stubSigningKeyUseCase) FindPublished(_ context.Context) ([]usecase.SigningKeyOutput, error) {
+	return s.keys, nil
+}
+
+func newTestKeyRing(t *testing.T) *auth.KeyRing {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return auth.NewKeyRing(
+		stubSigningKeyUseCase{keys: []usecase.SigningKeyOutput{{ID: "key-1", PrivateKey: privateKey}}},
+		time.Minute,
+	)
+}
+
+func TestOAuth_Token(t *testing.T) {
+	t.Parallel()
+
+	const clientID = "3c096a40-ccba-4b58-93ed-57379ab04680"
+
+	var (
+		ring   = newTestKeyRing(t)
+		issuer = auth.NewTokenIssuer(ring, "go-bank-transfer", "", 10*time.Minute)
+		client = usecase.OAuthClientOutput{ClientID: clientID, Scopes: []string{"accounts:read"}}
+	)
+
+	tests := []struct {
+		name               string
+		contentType        string
+		form               url.Values
+		basic              []string
+		err                error
+		expectedRequested  []domain.Scope
+		expectedBody       string
+		expectedChallenge  bool
+		expectedStatusCode int
+	}{
+		{
+			name:               "Token action success with basic authentication",
+			form:               url.Values{"grant_type": {"client_credentials"}},
+			basic:              []string{clientID, "secret"},
+			expectedRequested:  []domain.Scope{},
+			expectedBody:       `"token_type":"Bearer","expires_in":600,"scope":"accounts:read"`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Token action success with form credentials and scope",
+			form: url.Values{
+				"grant_type":    {"client_credentials"},
+				"client_id":     {clientID},
+				"client_secret": {"secret"},
+				"scope":         {"accounts:read  transfers:read"},
+			},
+			expectedRequested:  []domain.Scope{domain.ScopeAccountsRead, domain.ScopeTransfersRead},
+			expectedBody:       `"access_token":"`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Token action error invalid content type",
+			contentType:        "application/json",
+			form:               url.Values{"grant_type": {"client_credentials"}},
+			basic:              []string{clientID, "secret"},
+			expectedBody:       `{"error":"invalid_request"`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Token action error missing grant type",
+			basic:              []string{clientID, "secret"},
+			expectedBody:       `{"error":"invalid_request","error_description":"grant_type is required"}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Token action error unsupported grant type",
+			form:               url.Values{"grant_type": {"password"}},
+			basic:              []string{clientID, "secret"},
+			expectedBody:       `{"error":"unsupported_grant_type"`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Token action error multiple client authentication methods",
+			form:               url.Values{"grant_type": {"client_credentials"}, "client_id": {clientID}},
+			basic:              []string{clientID, "secret"},
+			expectedBody:       `{"error":"invalid_request"`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Token action error invalid secret with basic authentication",
+			form:               url.Values{"grant_type": {"client_credentials"}},
+			basic:              []string{clientID, "wrong"},
+			expectedRequested:  []domain.Scope{},
+			expectedBody:       `{"error":"invalid_client"`,
+			expectedChallenge:  true,
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Token action error invalid client id",
+			form: url.Values{
+				"grant_type":    {"client_credentials"},
+				"client_id":     {"unknown"},
+				"client_secret": {"secret"},
+			},
+			expectedBody:       `{"error":"invalid_client"`,
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "Token action error scope not allowed",
+			form:               url.Values{"grant_type": {"client_credentials"}, "scope": {"admin"}},
+			basic:              []string{clientID, "secret"},
+			err:                domain.ErrInvalidScope,
+			expectedRequested:  []domain.Scope{domain.ScopeAdmin},
+			expectedBody:       `{"error":"invalid_scope"`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Token action error server",
+			form:               url.Values{"grant_type": {"client_credentials"}},
+			basic:              []string{clientID, "secret"},
+			err:                errors.New("database error"),
+			expectedRequested:  []domain.Scope{},
+			expectedBody:       `{"error":"server_error","error_description":"internal server error"}`,
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader(tt.form.Encode()))
+
+			var contentType = "application/x-www-form-urlencoded"
+			if tt.contentType != "" {
+				contentType = tt.contentType
+			}
+			req.Header.Set("Content-Type", contentType)
+
+			if tt.basic != nil {
+				req.SetBasicAuth(url.QueryEscape(tt.basic[0]), url.QueryEscape(tt.basic[1]))
+			}
+
+			var (
+				w         = httptest.NewRecorder()
+				requested []domain.Scope
+				uc        = mockOAuthClient{result: client, requested: &requested, err: tt.err}
+			)
+
+			NewOAuth(uc, issuer, ring, logger.LoggerMock{}).Token(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if !strings.Contains(w.Body.String(), tt.expectedBody) {
+				t.Errorf("[TestCase '%s'] Result: '%s' | Expected: '%s'", tt.name, w.Body.String(), tt.expectedBody)
+			}
+
+			if !reflect.DeepEqual(requested, tt.expectedRequested) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, requested, tt.expectedRequested)
+			}
+
+			if challenge := w.Header().Get("WWW-Authenticate") != ""; challenge != tt.expectedChallenge {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, challenge, tt.expectedChallenge)
+			}
+
+			if w.Header().Get("Cache-Control") != "no-store" {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, w.Header().Get("Cache-Control"), "no-store")
+			}
+		})
+	}
+}
+
+func TestOAuth_JWKS(t *testing.T) {
+	t.Parallel()
+
+	var (
+		req, _ = http.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+		w      = httptest.NewRecorder()
+	)
+
+	NewOAuth(mockOAuthClient{}, auth.TokenIssuer{}, newTestKeyRing(t), logger.LoggerMock{}).JWKS(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf(
+			"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+			"JWKS",
+			w.Code,
+			http.StatusOK,
+		)
+	}
+
+	keys, err := auth.ParseJWKS(bytes.TrimSpace(w.Body.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := keys["key-1"]; !ok || len(keys) != 1 {
+		t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", "JWKS", keys, "key-1")
+	}
+
+	if w.Header().Get("Cache-Control") != "public, max-age=60" {
+		t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", "JWKS", w.Header().Get("Cache-Control"), "public, max-age=60")
+	}
+}
@@ -0,0 +1,14 @@
+package action
+
+import (
+	"net/http"
+
+	"github.com/gsabadini/go-bank-transfer/api/openapi"
+)
+
+//OpenAPI é handler para retornar a especificação OpenAPI 3 da API
+func OpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(openapi.JSON())
+}
@@ -0,0 +1,43 @@
+package action
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOpenAPI(t *testing.T) {
+	t.Parallel()
+
+	req, err := http.NewRequest(http.MethodGet, "/openapi.json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		rr      = httptest.NewRecorder()
+		handler = http.NewServeMux()
+	)
+
+	handler.HandleFunc("/openapi.json", OpenAPI)
+	handler.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Errorf("O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+			status,
+			http.StatusOK,
+		)
+	}
+
+	var doc struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.NewDecoder(rr.Body).Decode(&doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.OpenAPI == "" {
+		t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", "OpenAPI version", doc.OpenAPI, "3.0.3")
+	}
+}
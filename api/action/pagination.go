@@ -0,0 +1,39 @@
+package action
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+)
+
+//parsePagination lê os parâmetros limit e cursor da query string de uma listagem
+func parsePagination(query url.Values) (domain.Pagination, []validator.FieldError) {
+	var (
+		errs  = make([]validator.FieldError, 0)
+		limit int
+	)
+
+	if value := query.Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > domain.MaxPageLimit {
+			errs = append(errs, validator.FieldError{
+				Field:   "limit",
+				Message: fmt.Sprintf("limit must be a number between 1 and %d", domain.MaxPageLimit),
+			})
+		}
+	}
+
+	cursor, err := domain.DecodeCursor(query.Get("cursor"))
+	if err != nil {
+		errs = append(errs, validator.FieldError{
+			Field:   "cursor",
+			Message: "cursor is invalid",
+		})
+	}
+
+	return domain.NewPagination(cursor, limit), errs
+}
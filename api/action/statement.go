@@ -0,0 +1,244 @@
+package action
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/export"
+	"github.com/gsabadini/go-bank-transfer/api/logging"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+const (
+	statementDateLayout    = "2006-01-02"
+	statementDefaultPeriod = 30 * 24 * time.Hour
+)
+
+//Statement armazena as dependências para as ações de extrato
+type Statement struct {
+	uc  usecase.StatementUseCase
+	log logger.Logger
+	now func() time.Time
+}
+
+//NewStatement constrói um Statement com suas dependências
+func NewStatement(uc usecase.StatementUseCase, l logger.Logger) Statement {
+	return Statement{uc: uc, log: l, now: time.Now}
+}
+
+//Find é um handler para retornar o extrato de uma Account em um período
+func (s Statement) Find(w http.ResponseWriter, r *http.Request) {
+	const logKey = "find_statement"
+
+	output, ok := s.statement(w, r, logKey)
+	if !ok {
+		return
+	}
+	logging.NewInfo(s.log, logKey, "success when returning account statement", http.StatusOK).Log()
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+//ExportCSV é um handler para exportar o extrato de uma Account em CSV
+func (s Statement) ExportCSV(w http.ResponseWriter, r *http.Request) {
+	const logKey = "export_statement_csv"
+
+	output, ok := s.statement(w, r, logKey)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := export.CSV(&buf, output); err != nil {
+		s.sendExportError(w, r, logKey, err)
+		return
+	}
+	logging.NewInfo(s.log, logKey, "success when exporting account statement", http.StatusOK).Log()
+
+	sendExport(w, export.CSVContentType, statementFilename(output, "csv"), buf.Bytes())
+}
+
+//ExportOFX é um handler para exportar o extrato de uma Account em OFX
+func (s Statement) ExportOFX(w http.ResponseWriter, r *http.Request) {
+	const logKey = "export_statement_ofx"
+
+	output, ok := s.statement(w, r, logKey)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := export.OFX(&buf, output, s.now()); err != nil {
+		s.sendExportError(w, r, logKey, err)
+		return
+	}
+	logging.NewInfo(s.log, logKey, "success when exporting account statement", http.StatusOK).Log()
+
+	sendExport(w, export.OFXContentType, statementFilename(output, "ofx"), buf.Bytes())
+}
+
+//statement valida a request e busca o extrato, enviando o response de error quando não for possível. O extrato é
+//restrito ao titular da Account e ao escopo admin
+func (s Statement) statement(w http.ResponseWriter, r *http.Request, logKey string) (usecase.StatementOutput, bool) {
+	var accountID = r.URL.Query().Get("account_id")
+	if !domain.IsValidUUID(accountID) {
+		var (
+			err    = response.ErrParameterInvalid
+			resErr = response.TranslateError(err)
+		)
+
+		logging.NewError(
+			s.log,
+			logKey,
+			"parameter invalid",
+			resErr.StatusCode(),
+			err,
+		).Log()
+
+		resErr.Send(w, r)
+		return usecase.StatementOutput{}, false
+	}
+
+	if err := auth.AuthorizeAccount(r.Context(), domain.AccountID(accountID)); err != nil {
+		var resErr = response.TranslateError(err)
+		logging.NewError(
+			s.log,
+			logKey,
+			"account not owned by the caller",
+			resErr.StatusCode(),
+			err,
+		).Log()
+
+		resErr.Send(w, r)
+		return usecase.StatementOutput{}, false
+	}
+
+	from, to, errs := parsePeriod(r.URL.Query(), s.now())
+	if len(errs) > 0 {
+		logging.NewError(
+			s.log,
+			logKey,
+			"invalid period",
+			http.StatusBadRequest,
+			errors.New("invalid period"),
+		).Log()
+
+		response.NewErrorFields(errs, response.CodeInvalidParameter, http.StatusBadRequest).Send(w, r)
+		return usecase.StatementOutput{}, false
+	}
+
+	output, err := s.uc.Find(r.Context(), domain.AccountID(accountID), from, to)
+	if err != nil {
+		var resErr = response.TranslateError(err)
+		logging.NewError(
+			s.log,
+			logKey,
+			"error when returning account statement",
+			resErr.StatusCode(),
+			err,
+		).Log()
+
+		resErr.Send(w, r)
+		return usecase.StatementOutput{}, false
+	}
+
+	return output, true
+}
+
+func (s Statement) sendExportError(w http.ResponseWriter, r *http.Request, logKey string, err error) {
+	var resErr = response.TranslateError(err)
+	logging.NewError(
+		s.log,
+		logKey,
+		"error when exporting account statement",
+		resErr.StatusCode(),
+		err,
+	).Log()
+
+	resErr.Send(w, r)
+}
+
+func sendExport(w http.ResponseWriter, contentType string, filename string, body []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+//statementFilename monta o nome do arquivo exportado a partir da Account e do período
+func statementFilename(output usecase.StatementOutput, extension string) string {
+	return fmt.Sprintf(
+		"statement-%s-%s-%s.%s",
+		output.AccountID,
+		output.From.UTC().Format("20060102"),
+		output.To.UTC().Format("20060102"),
+		extension,
+	)
+}
+
+//parsePeriod lê o período [from, to) da query string, aceitando datas (YYYY-MM-DD) ou timestamps RFC 3339
+//
+//Uma data em to inclui o dia inteiro e, quando omitido, o período termina em now e começa 30 dias antes
+func parsePeriod(query url.Values, now time.Time) (time.Time, time.Time, []validator.FieldError) {
+	var (
+		errs = make([]validator.FieldError, 0)
+		from = now.Add(-statementDefaultPeriod)
+		to   = now
+	)
+
+	if value := query.Get("to"); value != "" {
+		t, dateOnly, err := parsePeriodTime(value)
+		if err != nil {
+			errs = append(errs, validator.FieldError{
+				Field:   "to",
+				Message: "to must be a date (YYYY-MM-DD) or an RFC 3339 timestamp",
+			})
+		}
+
+		if dateOnly {
+			t = t.Add(24 * time.Hour)
+		}
+
+		to = t
+		from = to.Add(-statementDefaultPeriod)
+	}
+
+	if value := query.Get("from"); value != "" {
+		t, _, err := parsePeriodTime(value)
+		if err != nil {
+			errs = append(errs, validator.FieldError{
+				Field:   "from",
+				Message: "from must be a date (YYYY-MM-DD) or an RFC 3339 timestamp",
+			})
+		}
+
+		from = t
+	}
+
+	if len(errs) == 0 && !from.Before(to) {
+		errs = append(errs, validator.FieldError{
+			Field:   "from",
+			Message: "from must be before to",
+		})
+	}
+
+	return from, to, errs
+}
+
+func parsePeriodTime(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(statementDateLayout, value); err == nil {
+		return t, true, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	return t, false, err
+}
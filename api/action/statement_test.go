@@ -0,0 +1,361 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/pkg/errors"
+)
+
+type mockStatementFind struct {
+	result usecase.StatementOutput
+	err    error
+}
+
+func (m mockStatementFind) Find(
+	_ context.Context,
+	ID domain.AccountID,
+	from time.Time,
+	to time.Time,
+) (usecase.StatementOutput, error) {
+	if m.err != nil {
+		return usecase.StatementOutput{}, m.err
+	}
+
+	var output = m.result
+	output.AccountID = ID.String()
+	output.From = from
+	output.To = to
+
+	return output, nil
+}
+
+func TestStatement_Find(t *testing.T) {
+	t.Parallel()
+
+	var now = time.Date(2020, 7, 1, 10, 30, 0, 0, time.UTC)
+
+	type args struct {
+		accountID string
+		query     map[string]string
+	}
+
+	tests := []struct {
+		name               string
+		args               args
+		ucMock             usecase.StatementUseCase
+		expectedBody       []byte
+		expectedStatusCode int
+	}{
+		{
+			name: "Find action success",
+			args: args{
+				accountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+				query:     map[string]string{"from": "2020-06-01", "to": "2020-06-30"},
+			},
+			ucMock: mockStatementFind{
+				result: usecase.StatementOutput{
+					OpeningBalance: 1,
+					ClosingBalance: 0.5,
+					Entries: []usecase.StatementEntryOutput{
+						{
+							ID:                    "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+							Type:                  "transfer_out",
+							CounterpartyAccountID: "3c096a40-ccba-4b58-93ed-57379ab04699",
+							Amount:                -0.5,
+							Balance:               0.5,
+							CreatedAt:             time.Date(2020, 6, 10, 0, 0, 0, 0, time.UTC),
+						},
+					},
+				},
+			},
+			expectedBody:       []byte(`{"account_id":"3c096a40-ccba-4b58-93ed-57379ab04680","from":"2020-06-01T00:00:00Z","to":"2020-07-01T00:00:00Z","opening_balance":1,"closing_balance":0.5,"entries":[{"id":"b51cd6c7-a55c-491e-9140-91903fe66fa9","type":"transfer_out","counterparty_account_id":"3c096a40-ccba-4b58-93ed-57379ab04699","amount":-0.5,"balance":0.5,"created_at":"2020-06-10T00:00:00Z"}]}`),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Find action success with RFC 3339 period",
+			args: args{
+				accountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+				query:     map[string]string{"from": "2020-06-01T12:00:00Z", "to": "2020-06-02T12:00:00Z"},
+			},
+			ucMock: mockStatementFind{
+				result: usecase.StatementOutput{Entries: []usecase.StatementEntryOutput{}},
+			},
+			expectedBody:       []byte(`{"account_id":"3c096a40-ccba-4b58-93ed-57379ab04680","from":"2020-06-01T12:00:00Z","to":"2020-06-02T12:00:00Z","opening_balance":0,"closing_balance":0,"entries":[]}`),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Find action success with default period",
+			args: args{
+				accountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+			},
+			ucMock: mockStatementFind{
+				result: usecase.StatementOutput{Entries: []usecase.StatementEntryOutput{}},
+			},
+			expectedBody:       []byte(`{"account_id":"3c096a40-ccba-4b58-93ed-57379ab04680","from":"2020-06-01T10:30:00Z","to":"2020-07-01T10:30:00Z","opening_balance":0,"closing_balance":0,"entries":[]}`),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Find action error invalid date",
+			args: args{
+				accountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+				query:     map[string]string{"from": "01/06/2020"},
+			},
+			ucMock:             mockStatementFind{},
+			expectedBody:       []byte(`{"errors":["from must be a date (YYYY-MM-DD) or an RFC 3339 timestamp"],"code":"invalid_parameter"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Find action error from after to",
+			args: args{
+				accountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+				query:     map[string]string{"from": "2020-06-30", "to": "2020-06-01"},
+			},
+			ucMock:             mockStatementFind{},
+			expectedBody:       []byte(`{"errors":["from must be before to"],"code":"invalid_parameter"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Find action error not found",
+			args: args{
+				accountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+			},
+			ucMock: mockStatementFind{
+				err: errors.Wrap(domain.ErrNotFound, "error fetching account"),
+			},
+			expectedBody:       []byte(`{"errors":["not found"],"code":"not_found"}`),
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Find action error parameter invalid",
+			args: args{
+				accountID: "error",
+			},
+			ucMock:             mockStatementFind{},
+			expectedBody:       []byte(`{"errors":["parameter invalid"],"code":"invalid_parameter"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/accounts/%s/statement", tt.args.accountID)
+			req, _ := http.NewRequest(http.MethodGet, uri, nil)
+
+			q := req.URL.Query()
+			q.Add("account_id", tt.args.accountID)
+			for key, value := range tt.args.query {
+				q.Add(key, value)
+			}
+			req.URL.RawQuery = q.Encode()
+			req = withPrincipal(req, adminPrincipal)
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewStatement(tt.ucMock, logger.LoggerMock{})
+			)
+			action.now = func() time.Time { return now }
+
+			action.Find(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = bytes.TrimSpace(w.Body.Bytes())
+			if !bytes.Equal(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
+
+func TestStatement_Export(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now    = time.Date(2020, 7, 1, 10, 30, 0, 0, time.UTC)
+		ucMock = mockStatementFind{
+			result: usecase.StatementOutput{
+				OpeningBalance: 1,
+				ClosingBalance: 0.5,
+				Entries: []usecase.StatementEntryOutput{
+					{
+						ID:                    "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+						Type:                  "transfer_out",
+						CounterpartyAccountID: "3c096a40-ccba-4b58-93ed-57379ab04699",
+						Amount:                -0.5,
+						Balance:               0.5,
+						CreatedAt:             time.Date(2020, 6, 10, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+		}
+	)
+
+	tests := []struct {
+		name                string
+		accountID           string
+		handler             func(Statement) http.HandlerFunc
+		expectedStatusCode  int
+		expectedContentType string
+		expectedDisposition string
+		expectedBody        []byte
+	}{
+		{
+			name:                "ExportCSV action success",
+			accountID:           "3c096a40-ccba-4b58-93ed-57379ab04680",
+			handler:             func(s Statement) http.HandlerFunc { return s.ExportCSV },
+			expectedStatusCode:  http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedDisposition: `attachment; filename="statement-3c096a40-ccba-4b58-93ed-57379ab04680-20200601-20200701.csv"`,
+			expectedBody:        []byte("date,id,type,counterparty_account_id,amount,balance\r\n2020-06-10T00:00:00Z,b51cd6c7-a55c-491e-9140-91903fe66fa9,transfer_out,3c096a40-ccba-4b58-93ed-57379ab04699,-0.50,0.50"),
+		},
+		{
+			name:                "ExportOFX action success",
+			accountID:           "3c096a40-ccba-4b58-93ed-57379ab04680",
+			handler:             func(s Statement) http.HandlerFunc { return s.ExportOFX },
+			expectedStatusCode:  http.StatusOK,
+			expectedContentType: "application/x-ofx",
+			expectedDisposition: `attachment; filename="statement-3c096a40-ccba-4b58-93ed-57379ab04680-20200601-20200701.ofx"`,
+			expectedBody:        []byte("</OFX>"),
+		},
+		{
+			name:                "ExportCSV action error parameter invalid",
+			accountID:           "error",
+			handler:             func(s Statement) http.HandlerFunc { return s.ExportCSV },
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedContentType: "application/json",
+			expectedBody:        []byte(`{"errors":["parameter invalid"],"code":"invalid_parameter"}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/accounts/statement", nil)
+
+			q := req.URL.Query()
+			q.Add("account_id", tt.accountID)
+			q.Add("from", "2020-06-01")
+			q.Add("to", "2020-06-30")
+			req.URL.RawQuery = q.Encode()
+			req = withPrincipal(req, adminPrincipal)
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewStatement(ucMock, logger.LoggerMock{})
+			)
+			action.now = func() time.Time { return now }
+
+			tt.handler(action)(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if contentType := w.Header().Get("Content-Type"); contentType != tt.expectedContentType {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, contentType, tt.expectedContentType)
+			}
+
+			if disposition := w.Header().Get("Content-Disposition"); disposition != tt.expectedDisposition {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, disposition, tt.expectedDisposition)
+			}
+
+			var result = bytes.TrimSpace(w.Body.Bytes())
+			if !bytes.HasSuffix(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
+
+func TestStatement_Authorization(t *testing.T) {
+	t.Parallel()
+
+	var (
+		accountID = "3c096a40-ccba-4b58-93ed-57379ab04680"
+		action    = NewStatement(mockStatementFind{}, logger.LoggerMock{})
+	)
+
+	tests := []struct {
+		name               string
+		handler            http.HandlerFunc
+		principal          auth.Principal
+		expectedStatusCode int
+	}{
+		{
+			name:               "Find action owner of the account",
+			handler:            action.Find,
+			principal:          auth.NewPrincipal(accountID, nil),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Find action error account of another owner",
+			handler:            action.Find,
+			principal:          auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04681", nil),
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "ExportCSV action error account of another owner",
+			handler:            action.ExportCSV,
+			principal:          auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04681", nil),
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "ExportOFX action error account of another owner",
+			handler:            action.ExportOFX,
+			principal:          auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04681", nil),
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/accounts/statement?account_id="+accountID, nil)
+			req = withPrincipal(req, tt.principal)
+
+			var w = httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/gsabadini/go-bank-transfer/api/auth"
 	"github.com/gsabadini/go-bank-transfer/api/input"
 	"github.com/gsabadini/go-bank-transfer/api/logging"
 	"github.com/gsabadini/go-bank-transfer/api/response"
@@ -41,7 +42,7 @@ func (t Transfer) Store(w http.ResponseWriter, r *http.Request) {
 			err,
 		).Log()
 
-		response.NewError(err, http.StatusBadRequest).Send(w)
+		response.NewErrorWithCode(err, response.CodeInvalidJSON, http.StatusBadRequest).Send(w, r)
 		return
 	}
 	defer r.Body.Close()
@@ -55,41 +56,43 @@ func (t Transfer) Store(w http.ResponseWriter, r *http.Request) {
 			errors.New("invalid input"),
 		).Log()
 
-		response.NewErrorMessage(errs, http.StatusBadRequest).Send(w)
+		response.NewErrorFields(errs, response.CodeInvalidInput, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	var accountOriginID = domain.AccountID(inputTransfer.AccountOriginID)
+	if err := auth.AuthorizeAccount(r.Context(), accountOriginID); err != nil {
+		var resErr = response.TranslateError(err)
+		logging.NewError(
+			t.log,
+			logKey,
+			"origin account not owned by the caller",
+			resErr.StatusCode(),
+			err,
+		).Log()
+
+		resErr.Send(w, r)
 		return
 	}
 
 	output, err := t.uc.Store(
 		r.Context(),
-		domain.AccountID(inputTransfer.AccountOriginID),
+		accountOriginID,
 		domain.AccountID(inputTransfer.AccountDestinationID),
 		domain.Money(inputTransfer.Amount),
 	)
 	if err != nil {
-		switch err {
-		case domain.ErrInsufficientBalance:
-			logging.NewError(
-				t.log,
-				logKey,
-				"insufficient balance",
-				http.StatusUnprocessableEntity,
-				err,
-			).Log()
-
-			response.NewError(err, http.StatusUnprocessableEntity).Send(w)
-			return
-		default:
-			logging.NewError(
-				t.log,
-				logKey,
-				"error when creating a new transfer",
-				http.StatusInternalServerError,
-				err,
-			).Log()
-
-			response.NewError(err, http.StatusInternalServerError).Send(w)
-			return
-		}
+		var resErr = response.TranslateError(err)
+		logging.NewError(
+			t.log,
+			logKey,
+			"error when creating a new transfer",
+			resErr.StatusCode(),
+			err,
+		).Log()
+
+		resErr.Send(w, r)
+		return
 	}
 
 	logging.NewInfo(t.log, logKey, "success create transfer", http.StatusCreated).Log()
@@ -97,21 +100,52 @@ func (t Transfer) Store(w http.ResponseWriter, r *http.Request) {
 	response.NewSuccess(output, http.StatusCreated).Send(w)
 }
 
-//FindAll é um handler para retornar todas as Transfer
+//FindAll é um handler para retornar uma página de Transfer filtrada e ordenada pela query string
 func (t Transfer) FindAll(w http.ResponseWriter, r *http.Request) {
 	const logKey = "find_all_transfer"
 
-	output, err := t.uc.FindAll(r.Context())
+	query, errs := parseTransferQuery(r.URL.Query())
+	if len(errs) > 0 {
+		logging.NewError(
+			t.log,
+			logKey,
+			"invalid query",
+			http.StatusBadRequest,
+			errors.New("invalid query"),
+		).Log()
+
+		response.NewErrorFields(errs, response.CodeInvalidParameter, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	filter, err := auth.AuthorizeTransferFilter(r.Context(), query.Filter)
+	if err != nil {
+		var resErr = response.TranslateError(err)
+		logging.NewError(
+			t.log,
+			logKey,
+			"transfer filter not owned by the caller",
+			resErr.StatusCode(),
+			err,
+		).Log()
+
+		resErr.Send(w, r)
+		return
+	}
+	query.Filter = filter
+
+	output, err := t.uc.FindAll(r.Context(), query)
 	if err != nil {
+		var resErr = response.TranslateError(err)
 		logging.NewError(
 			t.log,
 			logKey,
 			"error when returning the transfer list",
-			http.StatusInternalServerError,
+			resErr.StatusCode(),
 			err,
 		).Log()
 
-		response.NewError(err, http.StatusInternalServerError).Send(w)
+		resErr.Send(w, r)
 		return
 	}
 	logging.NewInfo(t.log, logKey, "success when returning transfer list", http.StatusOK).Log()
@@ -0,0 +1,119 @@
+package action
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+)
+
+//parseTransferQuery lê os filtros, a ordenação e a paginação da listagem de Transfer da query string
+func parseTransferQuery(query url.Values) (domain.TransferQuery, []validator.FieldError) {
+	var (
+		filter domain.TransferFilter
+		errs   = make([]validator.FieldError, 0)
+	)
+
+	for _, account := range []struct {
+		param  string
+		target *domain.AccountID
+	}{
+		{param: "account_id", target: &filter.AccountID},
+		{param: "account_origin_id", target: &filter.AccountOriginID},
+		{param: "account_destination_id", target: &filter.AccountDestinationID},
+	} {
+		var param = account.param
+		if value := query.Get(param); value != "" {
+			if !domain.IsValidUUID(value) {
+				errs = append(errs, validator.FieldError{
+					Field:   param,
+					Message: fmt.Sprintf("%s must be a valid UUID", param),
+				})
+			}
+
+			*account.target = domain.AccountID(value)
+		}
+	}
+
+	for _, amount := range []struct {
+		param  string
+		target *domain.Money
+	}{
+		{param: "min_amount", target: &filter.MinAmount},
+		{param: "max_amount", target: &filter.MaxAmount},
+	} {
+		var param = amount.param
+		if value := query.Get(param); value != "" {
+			cents, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || cents <= 0 {
+				errs = append(errs, validator.FieldError{
+					Field:   param,
+					Message: fmt.Sprintf("%s must be a positive integer amount in cents", param),
+				})
+			}
+
+			*amount.target = domain.Money(cents)
+		}
+	}
+
+	if filter.MinAmount > 0 && filter.MaxAmount > 0 && filter.MinAmount > filter.MaxAmount {
+		errs = append(errs, validator.FieldError{
+			Field:   "min_amount",
+			Message: "min_amount must be less than or equal to max_amount",
+		})
+	}
+
+	if value := query.Get("created_from"); value != "" {
+		createdFrom, _, err := parsePeriodTime(value)
+		if err != nil {
+			errs = append(errs, validator.FieldError{
+				Field:   "created_from",
+				Message: "created_from must be a date (YYYY-MM-DD) or an RFC 3339 timestamp",
+			})
+		}
+
+		filter.CreatedFrom = createdFrom
+	}
+
+	if value := query.Get("created_to"); value != "" {
+		createdTo, dateOnly, err := parsePeriodTime(value)
+		if err != nil {
+			errs = append(errs, validator.FieldError{
+				Field:   "created_to",
+				Message: "created_to must be a date (YYYY-MM-DD) or an RFC 3339 timestamp",
+			})
+		}
+
+		if dateOnly {
+			createdTo = createdTo.Add(24 * time.Hour)
+		}
+
+		filter.CreatedTo = createdTo
+	}
+
+	if value := query.Get("status"); value != "" {
+		filter.Status = domain.TransferStatus(value)
+		if !filter.Status.IsValid() {
+			errs = append(errs, validator.FieldError{
+				Field:   "status",
+				Message: "status must be one of [completed]",
+			})
+		}
+	}
+
+	sort, err := domain.ParseTransferSort(query.Get("sort"))
+	if err != nil {
+		errs = append(errs, validator.FieldError{
+			Field:   "sort",
+			Message: "sort must be one of [created_at -created_at amount -amount]",
+		})
+	}
+
+	pagination, paginationErrs := parsePagination(query)
+	errs = append(errs, paginationErrs...)
+
+	return domain.TransferQuery{Filter: filter, Sort: sort, Pagination: pagination}, errs
+}
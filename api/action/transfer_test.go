@@ -3,16 +3,20 @@ package action
 import (
 	"bytes"
 	"context"
-	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/gsabadini/go-bank-transfer/api/auth"
 	"github.com/gsabadini/go-bank-transfer/domain"
 	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
 	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
 	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/pkg/errors"
 )
 
 type mockTransferStore struct {
@@ -62,11 +66,12 @@ func TestTransfer_Store(t *testing.T) {
 					AccountOriginID:      "3c096a40-ccba-4b58-93ed-57379ab04680",
 					AccountDestinationID: "3c096a40-ccba-4b58-93ed-57379ab04681",
 					Amount:               10,
+					Status:               "completed",
 					CreatedAt:            time.Time{},
 				},
 				err: nil,
 			},
-			expectedBody:       []byte(`{"id":"3c096a40-ccba-4b58-93ed-57379ab04679","account_origin_id":"3c096a40-ccba-4b58-93ed-57379ab04680","account_destination_id":"3c096a40-ccba-4b58-93ed-57379ab04681","amount":10,"created_at":"0001-01-01T00:00:00Z"}`),
+			expectedBody:       []byte(`{"id":"3c096a40-ccba-4b58-93ed-57379ab04679","account_origin_id":"3c096a40-ccba-4b58-93ed-57379ab04680","account_destination_id":"3c096a40-ccba-4b58-93ed-57379ab04681","amount":10,"status":"completed","created_at":"0001-01-01T00:00:00Z"}`),
 			expectedStatusCode: http.StatusCreated,
 		},
 		{
@@ -84,7 +89,7 @@ func TestTransfer_Store(t *testing.T) {
 				result: usecase.TransferOutput{},
 				err:    errors.New("error"),
 			},
-			expectedBody:       []byte(`{"errors":["error"]}`),
+			expectedBody:       []byte(`{"errors":["internal server error"],"code":"internal_error"}`),
 			expectedStatusCode: http.StatusInternalServerError,
 		},
 		{
@@ -102,7 +107,61 @@ func TestTransfer_Store(t *testing.T) {
 				result: usecase.TransferOutput{},
 				err:    domain.ErrInsufficientBalance,
 			},
-			expectedBody:       []byte(`{"errors":["origin account does not have sufficient balance"]}`),
+			expectedBody:       []byte(`{"errors":["origin account does not have sufficient balance"],"code":"insufficient_balance"}`),
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Store action error wrapped insufficient balance",
+			args: args{
+				rawPayload: []byte(
+					`{
+						"account_destination_id": "3c096a40-ccba-4b58-93ed-57379ab04680",
+						"account_origin_id": "3c096a40-ccba-4b58-93ed-57379ab04681",
+						"amount": 10
+					}`,
+				),
+			},
+			ucMock: mockTransferStore{
+				result: usecase.TransferOutput{},
+				err:    errors.Wrap(domain.ErrInsufficientBalance, "error processing transfer"),
+			},
+			expectedBody:       []byte(`{"errors":["origin account does not have sufficient balance"],"code":"insufficient_balance"}`),
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Store action error account origin not found",
+			args: args{
+				rawPayload: []byte(
+					`{
+						"account_destination_id": "3c096a40-ccba-4b58-93ed-57379ab04680",
+						"account_origin_id": "3c096a40-ccba-4b58-93ed-57379ab04681",
+						"amount": 10
+					}`,
+				),
+			},
+			ucMock: mockTransferStore{
+				result: usecase.TransferOutput{},
+				err:    domain.ErrAccountOriginNotFound,
+			},
+			expectedBody:       []byte(`{"errors":["account origin not found"],"code":"account_origin_not_found"}`),
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Store action error account destination not found",
+			args: args{
+				rawPayload: []byte(
+					`{
+						"account_destination_id": "3c096a40-ccba-4b58-93ed-57379ab04680",
+						"account_origin_id": "3c096a40-ccba-4b58-93ed-57379ab04681",
+						"amount": 10
+					}`,
+				),
+			},
+			ucMock: mockTransferStore{
+				result: usecase.TransferOutput{},
+				err:    domain.ErrAccountDestinationNotFound,
+			},
+			expectedBody:       []byte(`{"errors":["account destination not found"],"code":"account_destination_not_found"}`),
 			expectedStatusCode: http.StatusUnprocessableEntity,
 		},
 		{
@@ -120,7 +179,7 @@ func TestTransfer_Store(t *testing.T) {
 				result: usecase.TransferOutput{},
 				err:    nil,
 			},
-			expectedBody:       []byte(`{"errors":["account origin equals destination account"]}`),
+			expectedBody:       []byte(`{"errors":["account origin equals destination account"],"code":"invalid_input"}`),
 			expectedStatusCode: http.StatusBadRequest,
 		},
 		{
@@ -138,7 +197,7 @@ func TestTransfer_Store(t *testing.T) {
 				result: usecase.TransferOutput{},
 				err:    nil,
 			},
-			expectedBody:       []byte(`{"errors":["invalid character ',' looking for beginning of value"]}`),
+			expectedBody:       []byte(`{"errors":["invalid character ',' looking for beginning of value"],"code":"invalid_json"}`),
 			expectedStatusCode: http.StatusBadRequest,
 		},
 		{
@@ -156,7 +215,7 @@ func TestTransfer_Store(t *testing.T) {
 				result: usecase.TransferOutput{},
 				err:    nil,
 			},
-			expectedBody:       []byte(`{"errors":["Amount must be greater than 0"]}`),
+			expectedBody:       []byte(`{"errors":["Amount must be greater than 0"],"code":"invalid_input"}`),
 			expectedStatusCode: http.StatusBadRequest,
 		},
 		{
@@ -174,7 +233,7 @@ func TestTransfer_Store(t *testing.T) {
 				result: usecase.TransferOutput{},
 				err:    nil,
 			},
-			expectedBody:       []byte(`{"errors":["AccountOriginID is a required field","AccountDestinationID is a required field","Amount must be greater than 0"]}`),
+			expectedBody:       []byte(`{"errors":["AccountOriginID is a required field","AccountDestinationID is a required field","Amount must be greater than 0"],"code":"invalid_input"}`),
 			expectedStatusCode: http.StatusBadRequest,
 		},
 	}
@@ -186,6 +245,7 @@ func TestTransfer_Store(t *testing.T) {
 				"/transfers",
 				bytes.NewReader(tt.args.rawPayload),
 			)
+			req = withPrincipal(req, adminPrincipal)
 
 			var (
 				w      = httptest.NewRecorder()
@@ -216,14 +276,88 @@ func TestTransfer_Store(t *testing.T) {
 	}
 }
 
+func TestTransfer_StoreAuthorization(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	var payload = []byte(
+		`{
+			"account_destination_id": "3c096a40-ccba-4b58-93ed-57379ab04680",
+			"account_origin_id": "3c096a40-ccba-4b58-93ed-57379ab04681",
+			"amount": 10
+		}`,
+	)
+
+	tests := []struct {
+		name               string
+		principal          auth.Principal
+		authenticated      bool
+		expectedStatusCode int
+	}{
+		{
+			name:               "Store action owner of the origin account",
+			principal:          auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04681", nil),
+			authenticated:      true,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Store action admin",
+			principal:          adminPrincipal,
+			authenticated:      true,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Store action error origin account of another owner",
+			principal:          auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04680", nil),
+			authenticated:      true,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Store action error without principal",
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/transfers", bytes.NewReader(payload))
+			if tt.authenticated {
+				req = withPrincipal(req, tt.principal)
+			}
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewTransfer(mockTransferStore{}, logger.LoggerMock{}, validator)
+			)
+
+			action.Store(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+		})
+	}
+}
+
 type mockTransferFindAll struct {
 	usecase.TransferUseCase
 
-	result []usecase.TransferOutput
+	result usecase.TransferListOutput
 	err    error
+	query  *domain.TransferQuery
 }
 
-func (m mockTransferFindAll) FindAll(_ context.Context) ([]usecase.TransferOutput, error) {
+func (m mockTransferFindAll) FindAll(_ context.Context, query domain.TransferQuery) (usecase.TransferListOutput, error) {
+	if m.query != nil {
+		*m.query = query
+	}
+
 	return m.result, m.err
 }
 
@@ -234,6 +368,7 @@ func TestTransfer_Index(t *testing.T) {
 
 	tests := []struct {
 		name               string
+		query              string
 		ucMock             usecase.TransferUseCase
 		expectedBody       []byte
 		expectedStatusCode int
@@ -241,27 +376,31 @@ func TestTransfer_Index(t *testing.T) {
 		{
 			name: "FindAll handler success one transfer",
 			ucMock: mockTransferFindAll{
-				result: []usecase.TransferOutput{
-					{
-						ID:                   "3c096a40-ccba-4b58-93ed-57379ab04679",
-						AccountOriginID:      "3c096a40-ccba-4b58-93ed-57379ab04680",
-						AccountDestinationID: "3c096a40-ccba-4b58-93ed-57379ab04681",
-						Amount:               10,
-						CreatedAt:            time.Time{},
+				result: usecase.TransferListOutput{
+					Data: []usecase.TransferOutput{
+						{
+							ID:                   "3c096a40-ccba-4b58-93ed-57379ab04679",
+							AccountOriginID:      "3c096a40-ccba-4b58-93ed-57379ab04680",
+							AccountDestinationID: "3c096a40-ccba-4b58-93ed-57379ab04681",
+							Amount:               10,
+							Status:               "completed",
+							CreatedAt:            time.Time{},
+						},
 					},
+					NextCursor: "MjAyMC0wNi0wMVQxMjozMDowMFp8M2MwOTZhNDA",
 				},
 				err: nil,
 			},
-			expectedBody:       []byte(`[{"id":"3c096a40-ccba-4b58-93ed-57379ab04679","account_origin_id":"3c096a40-ccba-4b58-93ed-57379ab04680","account_destination_id":"3c096a40-ccba-4b58-93ed-57379ab04681","amount":10,"created_at":"0001-01-01T00:00:00Z"}]`),
+			expectedBody:       []byte(`{"data":[{"id":"3c096a40-ccba-4b58-93ed-57379ab04679","account_origin_id":"3c096a40-ccba-4b58-93ed-57379ab04680","account_destination_id":"3c096a40-ccba-4b58-93ed-57379ab04681","amount":10,"status":"completed","created_at":"0001-01-01T00:00:00Z"}],"next_cursor":"MjAyMC0wNi0wMVQxMjozMDowMFp8M2MwOTZhNDA"}`),
 			expectedStatusCode: http.StatusOK,
 		},
 		{
 			name: "FindAll handler success empty",
 			ucMock: mockTransferFindAll{
-				result: []usecase.TransferOutput{},
+				result: usecase.TransferListOutput{Data: []usecase.TransferOutput{}},
 				err:    nil,
 			},
-			expectedBody:       []byte(`[]`),
+			expectedBody:       []byte(`{"data":[],"next_cursor":""}`),
 			expectedStatusCode: http.StatusOK,
 		},
 		{
@@ -269,14 +408,36 @@ func TestTransfer_Index(t *testing.T) {
 			ucMock: mockTransferFindAll{
 				err: errors.New("error"),
 			},
-			expectedBody:       []byte(`{"errors":["error"]}`),
+			expectedBody:       []byte(`{"errors":["internal server error"],"code":"internal_error"}`),
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:               "FindAll handler invalid limit",
+			query:              "limit=101",
+			ucMock:             mockTransferFindAll{},
+			expectedBody:       []byte(`{"errors":["limit must be a number between 1 and 100"],"code":"invalid_parameter"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "FindAll handler invalid filters",
+			query:              "account_origin_id=error&min_amount=500&max_amount=100&status=pending&sort=name",
+			ucMock:             mockTransferFindAll{},
+			expectedBody:       []byte(`{"errors":["account_origin_id must be a valid UUID","min_amount must be less than or equal to max_amount","status must be one of [completed]","sort must be one of [created_at -created_at amount -amount]"],"code":"invalid_parameter"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "FindAll handler invalid cursor",
+			query:              "cursor=invalid",
+			ucMock:             mockTransferFindAll{},
+			expectedBody:       []byte(`{"errors":["cursor is invalid"],"code":"invalid_parameter"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			req, _ := http.NewRequest(http.MethodGet, "/transfers", nil)
+			req, _ := http.NewRequest(http.MethodGet, "/transfers?"+tt.query, nil)
+			req = withPrincipal(req, adminPrincipal)
 
 			var (
 				w      = httptest.NewRecorder()
@@ -306,3 +467,127 @@ func TestTransfer_Index(t *testing.T) {
 		})
 	}
 }
+
+func TestTransfer_IndexOwnership(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	const (
+		own     domain.AccountID = "3c096a40-ccba-4b58-93ed-57379ab04680"
+		another domain.AccountID = "3c096a40-ccba-4b58-93ed-57379ab04681"
+	)
+
+	tests := []struct {
+		name               string
+		query              string
+		expectedFilter     domain.TransferFilter
+		expectedStatusCode int
+	}{
+		{
+			name:               "FindAll handler limited to the holder account",
+			expectedFilter:     domain.TransferFilter{AccountID: own},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "FindAll handler another account as counterparty",
+			query:              "account_origin_id=" + own.String() + "&account_destination_id=" + another.String(),
+			expectedFilter:     domain.TransferFilter{AccountOriginID: own, AccountDestinationID: another},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "FindAll handler error account of another owner",
+			query:              "account_id=" + another.String(),
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "FindAll handler error destination account of another owner",
+			query:              "account_destination_id=" + another.String(),
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/transfers?"+tt.query, nil)
+			req = withPrincipal(req, auth.NewPrincipal(own.String(), auth.AccountHolderScopes()))
+
+			var (
+				query  domain.TransferQuery
+				w      = httptest.NewRecorder()
+				action = NewTransfer(
+					mockTransferFindAll{result: usecase.TransferListOutput{Data: []usecase.TransferOutput{}}, query: &query},
+					logger.LoggerMock{},
+					validator,
+				)
+			)
+
+			action.FindAll(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if query.Filter != tt.expectedFilter {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, query.Filter, tt.expectedFilter)
+			}
+		})
+	}
+}
+
+func TestParseTransferQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		query    string
+		expected domain.TransferQuery
+	}{
+		{
+			name:  "Default query",
+			query: "",
+			expected: domain.TransferQuery{
+				Sort:       domain.TransferSortCreatedAt,
+				Pagination: domain.NewPagination(domain.Cursor{}, domain.DefaultPageLimit),
+			},
+		},
+		{
+			name:  "Query with all filters",
+			query: "account_id=3c096a40-ccba-4b58-93ed-57379ab04680&account_origin_id=3c096a40-ccba-4b58-93ed-57379ab04681&account_destination_id=3c096a40-ccba-4b58-93ed-57379ab04682&min_amount=100&max_amount=500&created_from=2020-06-01&created_to=2020-06-30&status=completed&sort=-amount&limit=10",
+			expected: domain.TransferQuery{
+				Filter: domain.TransferFilter{
+					AccountID:            "3c096a40-ccba-4b58-93ed-57379ab04680",
+					AccountOriginID:      "3c096a40-ccba-4b58-93ed-57379ab04681",
+					AccountDestinationID: "3c096a40-ccba-4b58-93ed-57379ab04682",
+					MinAmount:            100,
+					MaxAmount:            500,
+					CreatedFrom:          time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+					CreatedTo:            time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC),
+					Status:               domain.TransferStatusCompleted,
+				},
+				Sort:       domain.TransferSortAmountDesc,
+				Pagination: domain.NewPagination(domain.Cursor{}, 10),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, _ := url.ParseQuery(tt.query)
+
+			result, errs := parseTransferQuery(values)
+			if len(errs) > 0 {
+				t.Errorf("[TestCase '%s'] Unexpected errors: '%v'", tt.name, errs)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+package action
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/input"
+	"github.com/gsabadini/go-bank-transfer/api/logging"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+//Webhook armazena as dependências para as ações de WebhookSubscription
+type Webhook struct {
+	uc        usecase.WebhookUseCase
+	log       logger.Logger
+	validator validator.Validator
+}
+
+//NewWebhook constrói um Webhook com suas dependências
+func NewWebhook(uc usecase.WebhookUseCase, l logger.Logger, v validator.Validator) Webhook {
+	return Webhook{uc: uc, log: l, validator: v}
+}
+
+//Store é um handler para criação de WebhookSubscription
+//
+//A WebhookSubscription deve pertencer a uma Account do Principal, somente o escopo admin cria assinaturas de todas
+//as Account
+func (wh Webhook) Store(w http.ResponseWriter, r *http.Request) {
+	const logKey = "create_webhook"
+
+	inputWebhook, ok := wh.decode(w, r, logKey)
+	if !ok {
+		return
+	}
+
+	if err := auth.AuthorizeAccount(r.Context(), domain.AccountID(inputWebhook.AccountID)); err != nil {
+		wh.fail(w, r, logKey, "account not owned by the caller", err)
+		return
+	}
+
+	output, err := wh.uc.Store(
+		r.Context(),
+		inputWebhook.URL,
+		inputWebhook.DomainEventTypes(),
+		domain.AccountID(inputWebhook.AccountID),
+	)
+	if err != nil {
+		wh.fail(w, r, logKey, "error when creating a new webhook", err)
+		return
+	}
+	logging.NewInfo(wh.log, logKey, "success creating webhook", http.StatusCreated).Log()
+
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
+
+//Update é um handler para alteração de uma WebhookSubscription, restrito ao titular da Account e ao escopo admin
+func (wh Webhook) Update(w http.ResponseWriter, r *http.Request) {
+	const logKey = "update_webhook"
+
+	ID, ok := wh.webhookID(w, r, logKey)
+	if !ok {
+		return
+	}
+
+	inputWebhook, ok := wh.decode(w, r, logKey)
+	if !ok {
+		return
+	}
+
+	if _, ok := wh.find(w, r, logKey, ID); !ok {
+		return
+	}
+
+	if err := auth.AuthorizeAccount(r.Context(), domain.AccountID(inputWebhook.AccountID)); err != nil {
+		wh.fail(w, r, logKey, "account not owned by the caller", err)
+		return
+	}
+
+	output, err := wh.uc.Update(
+		r.Context(),
+		ID,
+		inputWebhook.URL,
+		inputWebhook.DomainEventTypes(),
+		domain.AccountID(inputWebhook.AccountID),
+	)
+	if err != nil {
+		wh.fail(w, r, logKey, "error when updating webhook", err)
+		return
+	}
+	logging.NewInfo(wh.log, logKey, "success updating webhook", http.StatusOK).Log()
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+//Delete é um handler para remoção de uma WebhookSubscription, restrito ao titular da Account e ao escopo admin
+func (wh Webhook) Delete(w http.ResponseWriter, r *http.Request) {
+	const logKey = "delete_webhook"
+
+	ID, ok := wh.webhookID(w, r, logKey)
+	if !ok {
+		return
+	}
+
+	if _, ok := wh.find(w, r, logKey, ID); !ok {
+		return
+	}
+
+	if err := wh.uc.Delete(r.Context(), ID); err != nil {
+		wh.fail(w, r, logKey, "error when deleting webhook", err)
+		return
+	}
+	logging.NewInfo(wh.log, logKey, "success deleting webhook", http.StatusNoContent).Log()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+//FindAll é um handler para retornar as WebhookSubscription das Account do Principal, o escopo admin recebe todas
+func (wh Webhook) FindAll(w http.ResponseWriter, r *http.Request) {
+	const logKey = "find_all_webhook"
+
+	output, err := wh.uc.FindAll(r.Context())
+	if err != nil {
+		wh.fail(w, r, logKey, "error when returning webhook list", err)
+		return
+	}
+	logging.NewInfo(wh.log, logKey, "success when returning webhook list", http.StatusOK).Log()
+
+	var (
+		principal, _ = auth.FromContext(r.Context())
+		owned        = make([]usecase.WebhookOutput, 0, len(output))
+	)
+
+	for _, webhook := range output {
+		if principal.CanAccessAccount(domain.AccountID(webhook.AccountID)) {
+			owned = append(owned, webhook)
+		}
+	}
+
+	response.NewSuccess(owned, http.StatusOK).Send(w)
+}
+
+//FindByID é um handler para retornar uma WebhookSubscription, restrito ao titular da Account e ao escopo admin
+func (wh Webhook) FindByID(w http.ResponseWriter, r *http.Request) {
+	const logKey = "find_webhook"
+
+	ID, ok := wh.webhookID(w, r, logKey)
+	if !ok {
+		return
+	}
+
+	output, ok := wh.find(w, r, logKey, ID)
+	if !ok {
+		return
+	}
+	logging.NewInfo(wh.log, logKey, "success when returning webhook", http.StatusOK).Log()
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+//FindDeliveries é um handler para retornar as entregas mais recentes de uma WebhookSubscription
+//
+//Aceita os parâmetros status (pending, succeeded ou dead) e limit, restrito ao titular da Account e ao escopo admin
+func (wh Webhook) FindDeliveries(w http.ResponseWriter, r *http.Request) {
+	const logKey = "find_webhook_deliveries"
+
+	ID, ok := wh.webhookID(w, r, logKey)
+	if !ok {
+		return
+	}
+
+	if _, ok := wh.find(w, r, logKey, ID); !ok {
+		return
+	}
+
+	var (
+		errs   = make([]validator.FieldError, 0)
+		status = domain.WebhookDeliveryStatus(r.URL.Query().Get("status"))
+		limit  = domain.DefaultPageLimit
+	)
+
+	if status != "" && !status.IsValid() {
+		errs = append(errs, validator.FieldError{
+			Field:   "status",
+			Message: "status must be one of [pending succeeded dead]",
+		})
+	}
+
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > domain.MaxPageLimit {
+			errs = append(errs, validator.FieldError{
+				Field:   "limit",
+				Message: fmt.Sprintf("limit must be a number between 1 and %d", domain.MaxPageLimit),
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		logging.NewError(
+			wh.log,
+			logKey,
+			"invalid parameters",
+			http.StatusBadRequest,
+			errors.New("invalid parameters"),
+		).Log()
+
+		response.NewErrorFields(errs, response.CodeInvalidParameter, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := wh.uc.FindDeliveries(r.Context(), ID, status, limit)
+	if err != nil {
+		wh.fail(w, r, logKey, "error when returning webhook deliveries", err)
+		return
+	}
+	logging.NewInfo(wh.log, logKey, "success when returning webhook deliveries", http.StatusOK).Log()
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+//Replay é um handler para reenviar uma entrega de uma WebhookSubscription, restrito ao titular da Account e ao
+//escopo admin
+func (wh Webhook) Replay(w http.ResponseWriter, r *http.Request) {
+	const logKey = "replay_webhook_delivery"
+
+	ID, ok := wh.webhookID(w, r, logKey)
+	if !ok {
+		return
+	}
+
+	var deliveryID = r.URL.Query().Get("delivery_id")
+	if !domain.IsValidUUID(deliveryID) {
+		wh.fail(w, r, logKey, "parameter invalid", response.ErrParameterInvalid)
+		return
+	}
+
+	if _, ok := wh.find(w, r, logKey, ID); !ok {
+		return
+	}
+
+	output, err := wh.uc.Replay(r.Context(), ID, domain.WebhookDeliveryID(deliveryID))
+	if err != nil {
+		wh.fail(w, r, logKey, "error when replaying webhook delivery", err)
+		return
+	}
+	logging.NewInfo(wh.log, logKey, "success replaying webhook delivery", http.StatusAccepted).Log()
+
+	response.NewSuccess(output, http.StatusAccepted).Send(w)
+}
+
+//decode lê e valida o corpo da requisição, respondendo 400 quando inválido
+func (wh Webhook) decode(w http.ResponseWriter, r *http.Request, logKey string) (input.Webhook, bool) {
+	var inputWebhook input.Webhook
+	if err := json.NewDecoder(r.Body).Decode(&inputWebhook); err != nil {
+		logging.NewError(
+			wh.log,
+			logKey,
+			"error when decoding json",
+			http.StatusBadRequest,
+			err,
+		).Log()
+
+		response.NewErrorWithCode(err, response.CodeInvalidJSON, http.StatusBadRequest).Send(w, r)
+		return input.Webhook{}, false
+	}
+	defer r.Body.Close()
+
+	if errs := inputWebhook.Validate(wh.validator); len(errs) > 0 {
+		logging.NewError(
+			wh.log,
+			logKey,
+			"invalid input",
+			http.StatusBadRequest,
+			errors.New("invalid input"),
+		).Log()
+
+		response.NewErrorFields(errs, response.CodeInvalidInput, http.StatusBadRequest).Send(w, r)
+		return input.Webhook{}, false
+	}
+
+	return inputWebhook, true
+}
+
+//webhookID lê o identificador da WebhookSubscription, respondendo 400 quando inválido
+func (wh Webhook) webhookID(w http.ResponseWriter, r *http.Request, logKey string) (domain.WebhookSubscriptionID, bool) {
+	var ID = r.URL.Query().Get("webhook_id")
+	if !domain.IsValidUUID(ID) {
+		wh.fail(w, r, logKey, "parameter invalid", response.ErrParameterInvalid)
+		return "", false
+	}
+
+	return domain.WebhookSubscriptionID(ID), true
+}
+
+//find busca a WebhookSubscription e verifica se o Principal pode operar a sua Account, respondendo conforme o
+//catálogo de erros quando não encontrada ou não autorizada
+//
+//Assinaturas sem Account recebem eventos de todas as Account e são restritas ao escopo admin
+func (wh Webhook) find(
+	w http.ResponseWriter,
+	r *http.Request,
+	logKey string,
+	ID domain.WebhookSubscriptionID,
+) (usecase.WebhookOutput, bool) {
+	output, err := wh.uc.FindByID(r.Context(), ID)
+	if err != nil {
+		wh.fail(w, r, logKey, "error when returning webhook", err)
+		return usecase.WebhookOutput{}, false
+	}
+
+	if err := auth.AuthorizeAccount(r.Context(), domain.AccountID(output.AccountID)); err != nil {
+		wh.fail(w, r, logKey, "webhook not owned by the caller", err)
+		return usecase.WebhookOutput{}, false
+	}
+
+	return output, true
+}
+
+//fail registra o erro no log e responde conforme o catálogo de erros
+func (wh Webhook) fail(w http.ResponseWriter, r *http.Request, logKey string, message string, err error) {
+	var resErr = response.TranslateError(err)
+	logging.NewError(
+		wh.log,
+		logKey,
+		message,
+		resErr.StatusCode(),
+		err,
+	).Log()
+
+	resErr.Send(w, r)
+}
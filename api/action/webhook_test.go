@@ -0,0 +1,497 @@
+package action
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type mockWebhook struct {
+	usecase.WebhookUseCase
+
+	result     usecase.WebhookOutput
+	results    []usecase.WebhookOutput
+	deliveries []usecase.WebhookDeliveryOutput
+	delivery   usecase.WebhookDeliveryOutput
+	err        error
+}
+
+func (m mockWebhook) Store(
+	_ context.Context,
+	_ string,
+	_ []domain.EventType,
+	_ domain.AccountID,
+) (usecase.WebhookOutput, error) {
+	return m.result, m.err
+}
+
+func (m mockWebhook) Update(
+	_ context.Context,
+	_ domain.WebhookSubscriptionID,
+	_ string,
+	_ []domain.EventType,
+	_ domain.AccountID,
+) (usecase.WebhookOutput, error) {
+	return m.result, m.err
+}
+
+func (m mockWebhook) Delete(_ context.Context, _ domain.WebhookSubscriptionID) error {
+	return m.err
+}
+
+func (m mockWebhook) FindAll(_ context.Context) ([]usecase.WebhookOutput, error) {
+	return m.results, m.err
+}
+
+func (m mockWebhook) FindByID(_ context.Context, _ domain.WebhookSubscriptionID) (usecase.WebhookOutput, error) {
+	return m.result, m.err
+}
+
+func (m mockWebhook) FindDeliveries(
+	_ context.Context,
+	_ domain.WebhookSubscriptionID,
+	_ domain.WebhookDeliveryStatus,
+	_ int,
+) ([]usecase.WebhookDeliveryOutput, error) {
+	return m.deliveries, m.err
+}
+
+func (m mockWebhook) Replay(
+	_ context.Context,
+	_ domain.WebhookSubscriptionID,
+	_ domain.WebhookDeliveryID,
+) (usecase.WebhookDeliveryOutput, error) {
+	return m.delivery, m.err
+}
+
+func TestWebhook_Store(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	tests := []struct {
+		name               string
+		rawPayload         []byte
+		ucMock             usecase.WebhookUseCase
+		expectedBody       []byte
+		expectedStatusCode int
+	}{
+		{
+			name:       "Store action success",
+			rawPayload: []byte(`{"url":"https://example.com/hook","event_types":["transfer.completed"]}`),
+			ucMock: mockWebhook{
+				result: usecase.WebhookOutput{
+					ID:         "3c096a40-ccba-4b58-93ed-57379ab04680",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"transfer.completed"},
+					Secret:     "whsec_test",
+					CreatedAt:  time.Time{},
+				},
+			},
+			expectedBody:       []byte(`{"id":"3c096a40-ccba-4b58-93ed-57379ab04680","url":"https://example.com/hook","event_types":["transfer.completed"],"secret":"whsec_test","created_at":"0001-01-01T00:00:00Z"}`),
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Store action error invalid event type",
+			rawPayload:         []byte(`{"url":"https://example.com/hook","event_types":["transfer.created"]}`),
+			ucMock:             mockWebhook{},
+			expectedBody:       []byte(`{"errors":["event_types must contain only [account.created balance.changed transfer.completed transfer.failed]"],"code":"invalid_input"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Store action error internal url",
+			rawPayload:         []byte(`{"url":"http://169.254.169.254/latest","event_types":["transfer.completed"]}`),
+			ucMock:             mockWebhook{},
+			expectedBody:       []byte(`{"errors":["URL must be an https URL with a public host"],"code":"invalid_input"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(tt.rawPayload))
+			req = withPrincipal(req, adminPrincipal)
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewWebhook(tt.ucMock, logger.LoggerMock{}, validator)
+			)
+
+			action.Store(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = bytes.TrimSpace(w.Body.Bytes())
+			if !bytes.Equal(result, tt.expectedBody) {
+				t.Errorf("[TestCase '%s'] Result: '%s' | Expected: '%s'", tt.name, result, tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestWebhook_FindDeliveries(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	tests := []struct {
+		name               string
+		webhookID          string
+		query              string
+		ucMock             usecase.WebhookUseCase
+		expectedBody       []byte
+		expectedStatusCode int
+	}{
+		{
+			name:      "FindDeliveries action success",
+			webhookID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+			query:     "status=dead",
+			ucMock: mockWebhook{
+				deliveries: []usecase.WebhookDeliveryOutput{
+					{
+						ID:             "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+						SubscriptionID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+						MessageID:      "0c83e1d2-cc5a-4b48-8e0e-2ec2e2a3a8b4",
+						EventType:      "transfer.completed",
+						Status:         "dead",
+						Attempts:       5,
+						LastError:      "unexpected status 500",
+					},
+				},
+			},
+			expectedBody:       []byte(`[{"id":"b51cd6c7-a55c-491e-9140-91903fe66fa9","subscription_id":"3c096a40-ccba-4b58-93ed-57379ab04680","message_id":"0c83e1d2-cc5a-4b48-8e0e-2ec2e2a3a8b4","event_type":"transfer.completed","status":"dead","attempts":5,"last_error":"unexpected status 500","created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}]`),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "FindDeliveries action error invalid status",
+			webhookID:          "3c096a40-ccba-4b58-93ed-57379ab04680",
+			query:              "status=failed&limit=0",
+			ucMock:             mockWebhook{},
+			expectedBody:       []byte(`{"errors":["status must be one of [pending succeeded dead]","limit must be a number between 1 and 100"],"code":"invalid_parameter"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "FindDeliveries action error webhook not found",
+			webhookID:          "3c096a40-ccba-4b58-93ed-57379ab04680",
+			ucMock:             mockWebhook{err: domain.ErrNotFound},
+			expectedBody:       []byte(`{"errors":["not found"],"code":"not_found"}`),
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "FindDeliveries action error invalid webhook id",
+			webhookID:          "error",
+			ucMock:             mockWebhook{},
+			expectedBody:       []byte(`{"errors":["parameter invalid"],"code":"invalid_parameter"}`),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/webhooks/"+tt.webhookID+"/deliveries?"+tt.query, nil)
+
+			q := req.URL.Query()
+			q.Add("webhook_id", tt.webhookID)
+			req.URL.RawQuery = q.Encode()
+			req = withPrincipal(req, adminPrincipal)
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewWebhook(tt.ucMock, logger.LoggerMock{}, validator)
+			)
+
+			action.FindDeliveries(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = bytes.TrimSpace(w.Body.Bytes())
+			if !bytes.Equal(result, tt.expectedBody) {
+				t.Errorf("[TestCase '%s'] Result: '%s' | Expected: '%s'", tt.name, result, tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestWebhook_Replay(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	tests := []struct {
+		name               string
+		deliveryID         string
+		ucMock             usecase.WebhookUseCase
+		expectedStatusCode int
+	}{
+		{
+			name:               "Replay action success",
+			deliveryID:         "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+			ucMock:             mockWebhook{delivery: usecase.WebhookDeliveryOutput{Status: "pending"}},
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name:               "Replay action error delivery not found",
+			deliveryID:         "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+			ucMock:             mockWebhook{err: domain.ErrNotFound},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Replay action error invalid delivery id",
+			deliveryID:         "error",
+			ucMock:             mockWebhook{},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/webhooks/replay", nil)
+
+			q := req.URL.Query()
+			q.Add("webhook_id", "3c096a40-ccba-4b58-93ed-57379ab04680")
+			q.Add("delivery_id", tt.deliveryID)
+			req.URL.RawQuery = q.Encode()
+			req = withPrincipal(req, adminPrincipal)
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewWebhook(tt.ucMock, logger.LoggerMock{}, validator)
+			)
+
+			action.Replay(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+		})
+	}
+}
+
+func TestWebhook_Ownership(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	var (
+		owner   = auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04680", auth.AccountHolderScopes())
+		another = auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04681", auth.AccountHolderScopes())
+		ucMock  = mockWebhook{
+			result: usecase.WebhookOutput{
+				ID:        "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+				AccountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+			},
+			delivery: usecase.WebhookDeliveryOutput{Status: "pending"},
+		}
+		action = NewWebhook(ucMock, logger.LoggerMock{}, validator)
+	)
+
+	tests := []struct {
+		name               string
+		method             string
+		handler            http.HandlerFunc
+		rawPayload         string
+		principal          auth.Principal
+		expectedStatusCode int
+	}{
+		{
+			name:               "Store action owner of the account",
+			method:             http.MethodPost,
+			handler:            action.Store,
+			rawPayload:         `{"url":"https://example.com/hook","event_types":["transfer.completed"],"account_id":"3c096a40-ccba-4b58-93ed-57379ab04680"}`,
+			principal:          owner,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Store action error account of another owner",
+			method:             http.MethodPost,
+			handler:            action.Store,
+			rawPayload:         `{"url":"https://example.com/hook","event_types":["transfer.completed"],"account_id":"3c096a40-ccba-4b58-93ed-57379ab04680"}`,
+			principal:          another,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Store action error without account",
+			method:             http.MethodPost,
+			handler:            action.Store,
+			rawPayload:         `{"url":"https://example.com/hook","event_types":["transfer.completed"]}`,
+			principal:          owner,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Update action error webhook of another owner",
+			method:             http.MethodPut,
+			handler:            action.Update,
+			rawPayload:         `{"url":"https://example.com/hook","event_types":["transfer.completed"],"account_id":"3c096a40-ccba-4b58-93ed-57379ab04681"}`,
+			principal:          another,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Update action error moving the webhook to another account",
+			method:             http.MethodPut,
+			handler:            action.Update,
+			rawPayload:         `{"url":"https://example.com/hook","event_types":["transfer.completed"],"account_id":"3c096a40-ccba-4b58-93ed-57379ab04681"}`,
+			principal:          owner,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "FindByID action owner of the account",
+			method:             http.MethodGet,
+			handler:            action.FindByID,
+			principal:          owner,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "FindByID action error webhook of another owner",
+			method:             http.MethodGet,
+			handler:            action.FindByID,
+			principal:          another,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Delete action error webhook of another owner",
+			method:             http.MethodDelete,
+			handler:            action.Delete,
+			principal:          another,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "FindDeliveries action error webhook of another owner",
+			method:             http.MethodGet,
+			handler:            action.FindDeliveries,
+			principal:          another,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Replay action error webhook of another owner",
+			method:             http.MethodPost,
+			handler:            action.Replay,
+			principal:          another,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Delete action admin",
+			method:             http.MethodDelete,
+			handler:            action.Delete,
+			principal:          adminPrincipal,
+			expectedStatusCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.method, "/webhooks", bytes.NewReader([]byte(tt.rawPayload)))
+
+			q := req.URL.Query()
+			q.Add("webhook_id", "b51cd6c7-a55c-491e-9140-91903fe66fa9")
+			q.Add("delivery_id", "0c83e1d2-cc5a-4b48-8e0e-2ec2e2a3a8b4")
+			req.URL.RawQuery = q.Encode()
+			req = withPrincipal(req, tt.principal)
+
+			var w = httptest.NewRecorder()
+
+			tt.handler(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+		})
+	}
+}
+
+func TestWebhook_FindAllOwnership(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	var ucMock = mockWebhook{
+		results: []usecase.WebhookOutput{
+			{ID: "b51cd6c7-a55c-491e-9140-91903fe66fa9", AccountID: "3c096a40-ccba-4b58-93ed-57379ab04680"},
+			{ID: "b51cd6c7-a55c-491e-9140-91903fe66fa8", AccountID: "3c096a40-ccba-4b58-93ed-57379ab04681"},
+			{ID: "b51cd6c7-a55c-491e-9140-91903fe66fa7"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		principal   auth.Principal
+		expectedIDs []string
+	}{
+		{
+			name:        "FindAll action owner only sees the own webhooks",
+			principal:   auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04680", auth.AccountHolderScopes()),
+			expectedIDs: []string{"b51cd6c7-a55c-491e-9140-91903fe66fa9"},
+		},
+		{
+			name:      "FindAll action admin sees every webhook",
+			principal: adminPrincipal,
+			expectedIDs: []string{
+				"b51cd6c7-a55c-491e-9140-91903fe66fa9",
+				"b51cd6c7-a55c-491e-9140-91903fe66fa8",
+				"b51cd6c7-a55c-491e-9140-91903fe66fa7",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/webhooks", nil)
+			req = withPrincipal(req, tt.principal)
+
+			var (
+				w      = httptest.NewRecorder()
+				action = NewWebhook(ucMock, logger.LoggerMock{}, validator)
+			)
+
+			action.FindAll(w, req)
+
+			var result []usecase.WebhookOutput
+			if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+				t.Fatalf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, nil)
+			}
+
+			var IDs = make([]string, 0, len(result))
+			for _, webhook := range result {
+				IDs = append(IDs, webhook.ID)
+			}
+
+			if !reflect.DeepEqual(IDs, tt.expectedIDs) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, IDs, tt.expectedIDs)
+			}
+		})
+	}
+}
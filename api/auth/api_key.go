@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+//APIKeyVerifier armazena a estrutura de verificação das APIKey, repassando os demais tokens ao próximo TokenVerifier
+type APIKeyVerifier struct {
+	uc   usecase.APIKeyUseCase
+	next TokenVerifier
+}
+
+//NewAPIKeyVerifier constrói um APIKeyVerifier, os tokens sem o prefixo das APIKey são verificados por next
+func NewAPIKeyVerifier(uc usecase.APIKeyUseCase, next TokenVerifier) APIKeyVerifier {
+	return APIKeyVerifier{uc: uc, next: next}
+}
+
+//Verify autentica a APIKey, retornando o Principal com os seus escopos e a Account que ela opera
+func (v APIKeyVerifier) Verify(ctx context.Context, token string) (Principal, error) {
+	if !strings.HasPrefix(token, usecase.APIKeyPrefix) {
+		if v.next == nil {
+			return Principal{}, ErrUnauthenticated
+		}
+
+		return v.next.Verify(ctx, token)
+	}
+
+	output, err := v.uc.Authenticate(ctx, token)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrAPIKeyInactive) {
+			return Principal{}, ErrUnauthenticated
+		}
+
+		return Principal{}, err
+	}
+
+	var scopes = make([]domain.Scope, 0, len(output.Scopes))
+	for _, scope := range output.Scopes {
+		scopes = append(scopes, domain.Scope(scope))
+	}
+
+	return NewPrincipal(output.AccountID, scopes), nil
+}
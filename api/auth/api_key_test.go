@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type stubAPIKeyUseCase struct {
+	usecase.APIKeyUseCase
+	outputs map[string]usecase.APIKeyOutput
+	err     error
+}
+
+func (s stubAPIKeyUseCase) Authenticate(_ context.Context, key string) (usecase.APIKeyOutput, error) {
+	if s.err != nil {
+		return usecase.APIKeyOutput{}, s.err
+	}
+
+	output, ok := s.outputs[key]
+	if !ok {
+		return usecase.APIKeyOutput{}, domain.ErrNotFound
+	}
+
+	return output, nil
+}
+
+type stubTokenVerifier struct{}
+
+func (stubTokenVerifier) Verify(_ context.Context, token string) (Principal, error) {
+	if token != "jwt" {
+		return Principal{}, ErrUnauthenticated
+	}
+
+	return NewPrincipal("jwt-subject", []domain.Scope{domain.ScopeAdmin}), nil
+}
+
+func TestAPIKeyVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	var (
+		accountID = "3c096a40-ccba-4b58-93ed-57379ab04680"
+		uc        = stubAPIKeyUseCase{
+			outputs: map[string]usecase.APIKeyOutput{
+				"gbk_valid": {
+					AccountID: accountID,
+					Scopes:    []string{"accounts:read", "transfers:write"},
+				},
+			},
+		}
+		errDatabase = errors.New("database error")
+	)
+
+	tests := []struct {
+		name            string
+		verifier        APIKeyVerifier
+		token           string
+		expectedSubject string
+		expectedScopes  []domain.Scope
+		expectedError   error
+	}{
+		{
+			name:            "Valid api key",
+			verifier:        NewAPIKeyVerifier(uc, stubTokenVerifier{}),
+			token:           "gbk_valid",
+			expectedSubject: accountID,
+			expectedScopes:  []domain.Scope{domain.ScopeAccountsRead, domain.ScopeTransfersWrite},
+		},
+		{
+			name:          "Unknown api key",
+			verifier:      NewAPIKeyVerifier(uc, stubTokenVerifier{}),
+			token:         "gbk_unknown",
+			expectedError: ErrUnauthenticated,
+		},
+		{
+			name:          "Inactive api key",
+			verifier:      NewAPIKeyVerifier(stubAPIKeyUseCase{err: domain.ErrAPIKeyInactive}, stubTokenVerifier{}),
+			token:         "gbk_valid",
+			expectedError: ErrUnauthenticated,
+		},
+		{
+			name:          "Error authenticating api key",
+			verifier:      NewAPIKeyVerifier(stubAPIKeyUseCase{err: errDatabase}, stubTokenVerifier{}),
+			token:         "gbk_valid",
+			expectedError: errDatabase,
+		},
+		{
+			name:            "Token delegated to the next verifier",
+			verifier:        NewAPIKeyVerifier(uc, stubTokenVerifier{}),
+			token:           "jwt",
+			expectedSubject: "jwt-subject",
+			expectedScopes:  []domain.Scope{domain.ScopeAdmin},
+		},
+		{
+			name:          "Token without next verifier",
+			verifier:      NewAPIKeyVerifier(uc, nil),
+			token:         "jwt",
+			expectedError: ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := tt.verifier.Verify(context.Background(), tt.token)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if principal.Subject() != tt.expectedSubject {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, principal.Subject(), tt.expectedSubject)
+			}
+
+			if !reflect.DeepEqual(principal.Scopes(), tt.expectedScopes) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, principal.Scopes(), tt.expectedScopes)
+			}
+		})
+	}
+}
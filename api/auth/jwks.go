@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+var errInvalidJWK = errors.New("invalid jwk")
+
+//KeySet armazena as chaves públicas RSA de um JSON Web Key Set (RFC 7517), indexadas pelo kid
+type KeySet map[string]*rsa.PublicKey
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+//ParseJWKS lê as chaves RSA de assinatura de um JWKS, ignorando as chaves de outros tipos ou usos
+func ParseJWKS(data []byte) (KeySet, error) {
+	var set jwks
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, errors.Wrap(err, "error decoding jwks")
+	}
+
+	var keys = make(KeySet, len(set.Keys))
+	for _, key := range set.Keys {
+		if key.Kty != "RSA" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+
+		publicKey, err := key.rsaPublicKey()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error decoding jwk '%s'", key.Kid)
+		}
+
+		keys[key.Kid] = publicKey
+	}
+
+	return keys, nil
+}
+
+//MarshalJWKS codifica as chaves como um JWKS de chaves RSA de assinatura RS256, ordenadas pelo kid
+func MarshalJWKS(keys KeySet) ([]byte, error) {
+	var set = jwks{Keys: make([]jwk, 0, len(keys))}
+	for kid, key := range keys {
+		set.Keys = append(set.Keys, jwk{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+
+	sort.Slice(set.Keys, func(i, j int) bool {
+		return set.Keys[i].Kid < set.Keys[j].Kid
+	})
+
+	data, err := json.Marshal(set)
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding jwks")
+	}
+
+	return data, nil
+}
+
+func (k jwk) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decoding modulus")
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, errors.Wrap(err, "error decoding exponent")
+	}
+
+	var exponent = new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, errInvalidJWK
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"strings"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/pkg/errors"
+)
+
+var (
+	errUnknownKey       = errors.New("unknown signing key")
+	errMissingExpiresAt = errors.New("token without expiration")
+	errInvalidIssuer    = errors.New("invalid token issuer")
+	errInvalidAudience  = errors.New("invalid token audience")
+	errMissingSubject   = errors.New("token without subject")
+	errNoKeyConfigured  = errors.New("no jwt signing key configured")
+)
+
+//TokenVerifier define a verificação das credenciais de uma request, retornando o Principal autenticado
+type TokenVerifier interface {
+	Verify(context.Context, string) (Principal, error)
+}
+
+//AccountHolderScopes são os escopos dos tokens sem a claim scope, emitidos para o titular de uma Account
+//
+//Os escopos de webhooks só alcançam as WebhookSubscription da própria Account, a verificação do titular é feita nas
+//actions
+func AccountHolderScopes() []domain.Scope {
+	return []domain.Scope{
+		domain.ScopeAccountsRead,
+		domain.ScopeAccountsWrite,
+		domain.ScopeTransfersRead,
+		domain.ScopeTransfersWrite,
+		domain.ScopeWebhooksRead,
+		domain.ScopeWebhooksWrite,
+	}
+}
+
+//JWTVerifier armazena a estrutura de verificação de JWTs assinados com HS256 ou RS256
+type JWTVerifier struct {
+	secret   []byte
+	keys     KeySet
+	issuer   string
+	audience string
+	now      func() time.Time
+}
+
+//NewJWTVerifier constrói um JWTVerifier, o secret habilita tokens HS256 e as chaves do JWKS habilitam tokens RS256
+//
+//Issuer e audience só são verificados quando informados
+func NewJWTVerifier(secret []byte, keys KeySet, issuer, audience string) (JWTVerifier, error) {
+	if len(secret) == 0 && len(keys) == 0 {
+		return JWTVerifier{}, errNoKeyConfigured
+	}
+
+	return JWTVerifier{
+		secret:   secret,
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
+		now:      time.Now,
+	}, nil
+}
+
+//Verify valida a assinatura e as claims do token, retornando o Principal identificado pela claim sub
+//
+//Os escopos são lidos da claim scope, separados por espaço como na RFC 8693. Tokens sem a claim recebem os
+//AccountHolderScopes
+func (v JWTVerifier) Verify(_ context.Context, token string) (Principal, error) {
+	var (
+		claims = jwt.MapClaims{}
+		parser = jwt.Parser{ValidMethods: v.methods()}
+	)
+
+	if _, err := parser.ParseWithClaims(token, claims, v.key); err != nil {
+		return Principal{}, errors.Wrap(ErrUnauthenticated, err.Error())
+	}
+
+	if err := v.validateClaims(claims); err != nil {
+		return Principal{}, errors.Wrap(ErrUnauthenticated, err.Error())
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return Principal{}, errors.Wrap(ErrUnauthenticated, errMissingSubject.Error())
+	}
+
+	return NewPrincipal(subject, scopes(claims["scope"])), nil
+}
+
+func (v JWTVerifier) methods() []string {
+	var methods []string
+	if len(v.secret) > 0 {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+
+	if len(v.keys) > 0 {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+
+	return methods
+}
+
+//key seleciona a chave de verificação pelo algoritmo do token, impedindo que a chave pública seja usada como secret
+func (v JWTVerifier) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return v.secret, nil
+	case *jwt.SigningMethodRSA:
+		return v.publicKey(token)
+	default:
+		return nil, jwt.ErrInvalidKeyType
+	}
+}
+
+func (v JWTVerifier) publicKey(token *jwt.Token) (*rsa.PublicKey, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, nil
+		}
+	}
+
+	key, ok := v.keys[kid]
+	if !ok {
+		return nil, errUnknownKey
+	}
+
+	return key, nil
+}
+
+func (v JWTVerifier) validateClaims(claims jwt.MapClaims) error {
+	var now = v.now().Unix()
+
+	if !claims.VerifyExpiresAt(now, true) {
+		if _, ok := claims["exp"]; !ok {
+			return errMissingExpiresAt
+		}
+
+		return errors.New("token is expired")
+	}
+
+	if !claims.VerifyNotBefore(now, false) {
+		return errors.New("token is not valid yet")
+	}
+
+	if v.issuer != "" && !claims.VerifyIssuer(v.issuer, true) {
+		return errInvalidIssuer
+	}
+
+	if v.audience != "" && !verifyAudience(claims["aud"], v.audience) {
+		return errInvalidAudience
+	}
+
+	return nil
+}
+
+//verifyAudience aceita a claim aud como string ou lista de strings, conforme a RFC 7519
+func verifyAudience(aud interface{}, audience string) bool {
+	switch value := aud.(type) {
+	case string:
+		return value == audience
+	case []interface{}:
+		for _, a := range value {
+			if s, ok := a.(string); ok && s == audience {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func scopes(claim interface{}) []domain.Scope {
+	value, ok := claim.(string)
+	if !ok {
+		return AccountHolderScopes()
+	}
+
+	var scopes = make([]domain.Scope, 0)
+	for _, scope := range strings.Fields(value) {
+		scopes = append(scopes, domain.Scope(scope))
+	}
+
+	return scopes
+}
@@ -0,0 +1,289 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+
+	"github.com/golang-jwt/jwt"
+)
+
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	var token = jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed
+}
+
+func jwksOf(kid string, key *rsa.PublicKey) []byte {
+	return []byte(fmt.Sprintf(
+		`{"keys":[{"kty":"RSA","use":"sig","alg":"RS256","kid":"%s","n":"%s","e":"%s"},{"kty":"EC","kid":"ec"}]}`,
+		kid,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	))
+}
+
+func TestJWTVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := ParseJWKS(jwksOf("key-1", &privateKey.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		secret  = []byte("secret")
+		exp     = time.Now().Add(time.Hour).Unix()
+		subject = "3c096a40-ccba-4b58-93ed-57379ab04680"
+		claims  = func(extra jwt.MapClaims) jwt.MapClaims {
+			var c = jwt.MapClaims{"sub": subject, "exp": exp, "iss": "bank", "aud": "api"}
+			for k, v := range extra {
+				if v == nil {
+					delete(c, k)
+					continue
+				}
+				c[k] = v
+			}
+			return c
+		}
+	)
+
+	verifier, err := NewJWTVerifier(secret, keys, "bank", "api")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		token          string
+		expectedScopes []domain.Scope
+		expectedError  bool
+	}{
+		{
+			name:           "Valid HS256 token",
+			token:          sign(t, jwt.SigningMethodHS256, secret, "", claims(nil)),
+			expectedScopes: AccountHolderScopes(),
+		},
+		{
+			name:           "Valid RS256 token",
+			token:          sign(t, jwt.SigningMethodRS256, privateKey, "key-1", claims(nil)),
+			expectedScopes: AccountHolderScopes(),
+		},
+		{
+			name:           "Valid RS256 token without kid and a single key",
+			token:          sign(t, jwt.SigningMethodRS256, privateKey, "", claims(nil)),
+			expectedScopes: AccountHolderScopes(),
+		},
+		{
+			name:           "Token with scopes",
+			token:          sign(t, jwt.SigningMethodHS256, secret, "", claims(jwt.MapClaims{"scope": "admin transfers:write"})),
+			expectedScopes: []domain.Scope{domain.ScopeAdmin, domain.ScopeTransfersWrite},
+		},
+		{
+			name:           "Token with audience list",
+			token:          sign(t, jwt.SigningMethodHS256, secret, "", claims(jwt.MapClaims{"aud": []string{"other", "api"}})),
+			expectedScopes: AccountHolderScopes(),
+		},
+		{
+			name:          "Invalid HS256 signature",
+			token:         sign(t, jwt.SigningMethodHS256, []byte("other"), "", claims(nil)),
+			expectedError: true,
+		},
+		{
+			name:          "RS256 token signed by an unknown key",
+			token:         sign(t, jwt.SigningMethodRS256, otherKey, "key-1", claims(nil)),
+			expectedError: true,
+		},
+		{
+			name:          "RS256 token with unknown kid",
+			token:         sign(t, jwt.SigningMethodRS256, privateKey, "key-2", claims(nil)),
+			expectedError: true,
+		},
+		{
+			name:          "Algorithm not allowed",
+			token:         sign(t, jwt.SigningMethodHS512, secret, "", claims(nil)),
+			expectedError: true,
+		},
+		{
+			name:          "Unsigned token",
+			token:         sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", claims(nil)),
+			expectedError: true,
+		},
+		{
+			name:          "Expired token",
+			token:         sign(t, jwt.SigningMethodHS256, secret, "", claims(jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()})),
+			expectedError: true,
+		},
+		{
+			name:          "Token without expiration",
+			token:         sign(t, jwt.SigningMethodHS256, secret, "", claims(jwt.MapClaims{"exp": nil})),
+			expectedError: true,
+		},
+		{
+			name:          "Token not valid yet",
+			token:         sign(t, jwt.SigningMethodHS256, secret, "", claims(jwt.MapClaims{"nbf": time.Now().Add(time.Hour).Unix()})),
+			expectedError: true,
+		},
+		{
+			name:          "Invalid issuer",
+			token:         sign(t, jwt.SigningMethodHS256, secret, "", claims(jwt.MapClaims{"iss": "other"})),
+			expectedError: true,
+		},
+		{
+			name:          "Invalid audience",
+			token:         sign(t, jwt.SigningMethodHS256, secret, "", claims(jwt.MapClaims{"aud": "other"})),
+			expectedError: true,
+		},
+		{
+			name:          "Token without subject",
+			token:         sign(t, jwt.SigningMethodHS256, secret, "", claims(jwt.MapClaims{"sub": nil})),
+			expectedError: true,
+		},
+		{
+			name:          "Malformed token",
+			token:         "invalid",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := verifier.Verify(context.Background(), tt.token)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if err != nil {
+				if !errors.Is(err, ErrUnauthenticated) {
+					t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, err, ErrUnauthenticated)
+				}
+				return
+			}
+
+			if principal.Subject() != subject {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, principal.Subject(), subject)
+			}
+
+			if !reflect.DeepEqual(principal.Scopes(), tt.expectedScopes) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, principal.Scopes(), tt.expectedScopes)
+			}
+		})
+	}
+}
+
+//TestJWTVerifier_VerifyAlgorithmConfusion garante que a chave pública RSA não é aceita como secret de um token HS256
+func TestJWTVerifier_VerifyAlgorithmConfusion(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data = jwksOf("key-1", &privateKey.PublicKey)
+	keys, err := ParseJWKS(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifier, err := NewJWTVerifier(nil, keys, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var token = sign(t, jwt.SigningMethodHS256, data, "key-1", jwt.MapClaims{
+		"sub": "3c096a40-ccba-4b58-93ed-57379ab04680",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+
+	if _, err := verifier.Verify(context.Background(), token); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("[TestCase 'HS256 token with RSA key'] Result: '%v' | Expected: '%v'", err, ErrUnauthenticated)
+	}
+}
+
+func TestNewJWTVerifier(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewJWTVerifier(nil, KeySet{}, "", ""); err != errNoKeyConfigured {
+		t.Errorf("[TestCase 'Without keys'] Result: '%v' | Expected: '%v'", err, errNoKeyConfigured)
+	}
+}
+
+func TestParseJWKS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		data          string
+		expectedKids  int
+		expectedError bool
+	}{
+		{
+			name:         "Ignores keys that are not RSA signing keys",
+			data:         `{"keys":[{"kty":"RSA","use":"enc","kid":"enc","n":"AQAB","e":"AQAB"},{"kty":"oct","kid":"oct"}]}`,
+			expectedKids: 0,
+		},
+		{
+			name:         "RSA signing key",
+			data:         `{"keys":[{"kty":"RSA","kid":"key-1","n":"AQAB","e":"AQAB"}]}`,
+			expectedKids: 1,
+		},
+		{
+			name:          "Invalid exponent",
+			data:          `{"keys":[{"kty":"RSA","kid":"key-1","n":"AQAB","e":"AQ"}]}`,
+			expectedError: true,
+		},
+		{
+			name:          "Invalid modulus encoding",
+			data:          `{"keys":[{"kty":"RSA","kid":"key-1","n":"***","e":"AQAB"}]}`,
+			expectedError: true,
+		},
+		{
+			name:          "Invalid JSON",
+			data:          `{"keys":`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := ParseJWKS([]byte(tt.data))
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if len(keys) != tt.expectedKids {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, len(keys), tt.expectedKids)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+//minKeyRingRefresh é o intervalo mínimo entre as atualizações antecipadas do KeyRing, disparadas por um kid
+//desconhecido, para que tokens forjados não sobrecarreguem o database
+const minKeyRingRefresh = 10 * time.Second
+
+var errNoSigningKey = errors.New("no signing key published")
+
+//KeyRing armazena em memória as SigningKey publicadas, atualizando-as a cada refresh
+//
+//As chaves são compartilhadas entre as instâncias pelo database, um kid desconhecido antecipa a atualização para
+//aceitar os tokens assinados pela chave que outra instância acabou de gerar
+type KeyRing struct {
+	uc      usecase.SigningKeyUseCase
+	refresh time.Duration
+	now     func() time.Time
+
+	mu        sync.Mutex
+	keys      []usecase.SigningKeyOutput
+	fetchedAt time.Time
+}
+
+//NewKeyRing constrói um KeyRing com suas dependências
+func NewKeyRing(uc usecase.SigningKeyUseCase, refresh time.Duration) *KeyRing {
+	return &KeyRing{uc: uc, refresh: refresh, now: time.Now}
+}
+
+//Refresh retorna o intervalo de atualização das chaves
+func (k *KeyRing) Refresh() time.Duration {
+	return k.refresh
+}
+
+//SigningKey retorna a chave que assina os novos tokens
+func (k *KeyRing) SigningKey(ctx context.Context) (usecase.SigningKeyOutput, error) {
+	keys, err := k.load(ctx, "")
+	if err != nil {
+		return usecase.SigningKeyOutput{}, err
+	}
+
+	if len(keys) == 0 {
+		return usecase.SigningKeyOutput{}, errNoSigningKey
+	}
+
+	return keys[0], nil
+}
+
+//PublicKeys retorna as chaves públicas publicadas, atualizando-as antes quando o kid ainda não é conhecido
+func (k *KeyRing) PublicKeys(ctx context.Context, kid string) (KeySet, error) {
+	keys, err := k.load(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+
+	var set = make(KeySet, len(keys))
+	for _, key := range keys {
+		set[key.ID] = &key.PrivateKey.PublicKey
+	}
+
+	return set, nil
+}
+
+func (k *KeyRing) load(ctx context.Context, kid string) ([]usecase.SigningKeyOutput, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	var (
+		now     = k.now()
+		elapsed = now.Sub(k.fetchedAt)
+	)
+
+	if len(k.keys) > 0 && elapsed < k.refresh && (kid == "" || k.contains(kid) || elapsed < minKeyRingRefresh) {
+		return k.keys, nil
+	}
+
+	keys, err := k.uc.FindPublished(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	k.keys = keys
+	k.fetchedAt = now
+
+	return k.keys, nil
+}
+
+func (k *KeyRing) contains(kid string) bool {
+	for _, key := range k.keys {
+		if key.ID == kid {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/pkg/errors"
+)
+
+//AccessToken armazena um token de acesso emitido para um OAuthClient
+type AccessToken struct {
+	Token     string
+	ExpiresIn time.Duration
+	Scope     string
+}
+
+//TokenIssuer armazena a estrutura de emissão dos tokens de acesso do grant client_credentials (RFC 6749)
+type TokenIssuer struct {
+	ring     *KeyRing
+	issuer   string
+	audience string
+	ttl      time.Duration
+	now      func() time.Time
+}
+
+//NewTokenIssuer constrói um TokenIssuer, a claim aud só é emitida quando o audience é informado
+func NewTokenIssuer(ring *KeyRing, issuer, audience string, ttl time.Duration) TokenIssuer {
+	return TokenIssuer{
+		ring:     ring,
+		issuer:   issuer,
+		audience: audience,
+		ttl:      ttl,
+		now:      time.Now,
+	}
+}
+
+//Issue assina com RS256 um JWT de curta duração para o OAuthClient autenticado
+//
+//A claim sub é a Account do cliente, para que ele opere apenas a própria Account, ou o client_id quando o cliente
+//não está vinculado a uma Account
+func (i TokenIssuer) Issue(ctx context.Context, client usecase.OAuthClientOutput) (AccessToken, error) {
+	key, err := i.ring.SigningKey(ctx)
+	if err != nil {
+		return AccessToken{}, errors.Wrap(err, "error loading signing key")
+	}
+
+	var subject = client.AccountID
+	if subject == "" {
+		subject = client.ClientID
+	}
+
+	var (
+		now    = i.now()
+		scope  = strings.Join(client.Scopes, " ")
+		claims = jwt.MapClaims{
+			"iss":       i.issuer,
+			"sub":       subject,
+			"client_id": client.ClientID,
+			"scope":     scope,
+			"iat":       now.Unix(),
+			"exp":       now.Add(i.ttl).Unix(),
+			"jti":       domain.NewUUID(),
+		}
+	)
+
+	if i.audience != "" {
+		claims["aud"] = i.audience
+	}
+
+	var token = jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = key.ID
+
+	signed, err := token.SignedString(key.PrivateKey)
+	if err != nil {
+		return AccessToken{}, errors.Wrap(err, "error signing access token")
+	}
+
+	return AccessToken{Token: signed, ExpiresIn: i.ttl, Scope: scope}, nil
+}
+
+//IssuedTokenVerifier armazena a estrutura de verificação dos tokens emitidos pelo TokenIssuer, repassando os tokens
+//de outros emissores ao próximo TokenVerifier
+type IssuedTokenVerifier struct {
+	ring     *KeyRing
+	issuer   string
+	audience string
+	next     TokenVerifier
+	now      func() time.Time
+}
+
+//NewIssuedTokenVerifier constrói um IssuedTokenVerifier, os tokens cuja claim iss difere do issuer são verificados
+//por next
+func NewIssuedTokenVerifier(ring *KeyRing, issuer, audience string, next TokenVerifier) IssuedTokenVerifier {
+	return IssuedTokenVerifier{
+		ring:     ring,
+		issuer:   issuer,
+		audience: audience,
+		next:     next,
+		now:      time.Now,
+	}
+}
+
+//Verify valida o token com as chaves publicadas no KeyRing, retornando o Principal com os escopos concedidos
+func (v IssuedTokenVerifier) Verify(ctx context.Context, token string) (Principal, error) {
+	var claims = jwt.MapClaims{}
+
+	unverified, _, err := new(jwt.Parser).ParseUnverified(token, claims)
+	if err != nil || !claims.VerifyIssuer(v.issuer, true) {
+		if v.next == nil {
+			return Principal{}, ErrUnauthenticated
+		}
+
+		return v.next.Verify(ctx, token)
+	}
+
+	kid, _ := unverified.Header["kid"].(string)
+
+	keys, err := v.ring.PublicKeys(ctx, kid)
+	if err != nil {
+		return Principal{}, errors.Wrap(err, "error loading signing keys")
+	}
+
+	if len(keys) == 0 {
+		return Principal{}, ErrUnauthenticated
+	}
+
+	return JWTVerifier{keys: keys, issuer: v.issuer, audience: v.audience, now: v.now}.Verify(ctx, token)
+}
@@ -0,0 +1,304 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/golang-jwt/jwt"
+)
+
+type stubSigningKeyUseCase struct {
+	keys  []usecase.SigningKeyOutput
+	calls *int
+}
+
+func (s stubSigningKeyUseCase) FindPublished(_ context.Context) ([]usecase.SigningKeyOutput, error) {
+	if s.calls != nil {
+		*s.calls++
+	}
+
+	return s.keys, nil
+}
+
+func newTestKeyRing(t *testing.T) (*KeyRing, *rsa.PrivateKey) {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var uc = stubSigningKeyUseCase{keys: []usecase.SigningKeyOutput{{ID: "key-1", PrivateKey: privateKey}}}
+
+	return NewKeyRing(uc, time.Minute), privateKey
+}
+
+func TestIssuedTokenVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	ring, _ := newTestKeyRing(t)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		accountID = "3c096a40-ccba-4b58-93ed-57379ab04680"
+		clientID  = "e48bc8a3-a4b2-4b76-a8b3-9b4b73d6d4a4"
+		issuer    = NewTokenIssuer(ring, "go-bank-transfer", "bank-api", 10*time.Minute)
+		expired   = issuer
+		verifier  = NewIssuedTokenVerifier(ring, "go-bank-transfer", "bank-api", stubTokenVerifier{})
+	)
+
+	expired.now = func() time.Time {
+		return time.Now().Add(-time.Hour)
+	}
+
+	issue := func(i TokenIssuer, client usecase.OAuthClientOutput) string {
+		token, err := i.Issue(context.Background(), client)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token.Token
+	}
+
+	tests := []struct {
+		name            string
+		verifier        IssuedTokenVerifier
+		token           string
+		expectedSubject string
+		expectedScopes  []domain.Scope
+		expectedError   error
+	}{
+		{
+			name:     "Token of a client linked to an account",
+			verifier: verifier,
+			token: issue(issuer, usecase.OAuthClientOutput{
+				ClientID:  clientID,
+				AccountID: accountID,
+				Scopes:    []string{"accounts:read", "transfers:write"},
+			}),
+			expectedSubject: accountID,
+			expectedScopes:  []domain.Scope{domain.ScopeAccountsRead, domain.ScopeTransfersWrite},
+		},
+		{
+			name:            "Token of a client without account",
+			verifier:        verifier,
+			token:           issue(issuer, usecase.OAuthClientOutput{ClientID: clientID, Scopes: []string{"admin"}}),
+			expectedSubject: clientID,
+			expectedScopes:  []domain.Scope{domain.ScopeAdmin},
+		},
+		{
+			name:          "Expired token",
+			verifier:      verifier,
+			token:         issue(expired, usecase.OAuthClientOutput{ClientID: clientID, Scopes: []string{"admin"}}),
+			expectedError: ErrUnauthenticated,
+		},
+		{
+			name:     "Invalid audience",
+			verifier: NewIssuedTokenVerifier(ring, "go-bank-transfer", "other-api", stubTokenVerifier{}),
+			token: issue(issuer, usecase.OAuthClientOutput{
+				ClientID: clientID,
+				Scopes:   []string{"admin"},
+			}),
+			expectedError: ErrUnauthenticated,
+		},
+		{
+			name:     "Token signed with an unpublished key",
+			verifier: verifier,
+			token: sign(t, jwt.SigningMethodRS256, otherKey, "key-1", jwt.MapClaims{
+				"iss":   "go-bank-transfer",
+				"aud":   "bank-api",
+				"sub":   clientID,
+				"scope": "admin",
+				"exp":   time.Now().Add(time.Minute).Unix(),
+			}),
+			expectedError: ErrUnauthenticated,
+		},
+		{
+			name:            "Token of another issuer delegated to the next verifier",
+			verifier:        verifier,
+			token:           "jwt",
+			expectedSubject: "jwt-subject",
+			expectedScopes:  []domain.Scope{domain.ScopeAdmin},
+		},
+		{
+			name:          "Token of another issuer without next verifier",
+			verifier:      NewIssuedTokenVerifier(ring, "go-bank-transfer", "bank-api", nil),
+			token:         "jwt",
+			expectedError: ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := tt.verifier.Verify(context.Background(), tt.token)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if principal.Subject() != tt.expectedSubject {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, principal.Subject(), tt.expectedSubject)
+			}
+
+			if !reflect.DeepEqual(principal.Scopes(), tt.expectedScopes) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, principal.Scopes(), tt.expectedScopes)
+			}
+		})
+	}
+}
+
+func TestTokenIssuer_Issue(t *testing.T) {
+	t.Parallel()
+
+	ring, privateKey := newTestKeyRing(t)
+
+	var issuer = NewTokenIssuer(ring, "go-bank-transfer", "", 5*time.Minute)
+
+	token, err := issuer.Issue(context.Background(), usecase.OAuthClientOutput{
+		ClientID: "client",
+		Scopes:   []string{"accounts:read", "transfers:read"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if token.Scope != "accounts:read transfers:read" {
+		t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", "Scope", token.Scope, "accounts:read transfers:read")
+	}
+
+	if token.ExpiresIn != 5*time.Minute {
+		t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", "ExpiresIn", token.ExpiresIn, 5*time.Minute)
+	}
+
+	var claims = jwt.MapClaims{}
+	parsed, err := jwt.ParseWithClaims(token.Token, claims, func(*jwt.Token) (interface{}, error) {
+		return &privateKey.PublicKey, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed.Header["kid"] != "key-1" {
+		t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", "Kid", parsed.Header["kid"], "key-1")
+	}
+
+	if _, ok := claims["aud"]; ok {
+		t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", "Audience", claims["aud"], nil)
+	}
+
+	if claims["client_id"] != "client" || claims["sub"] != "client" {
+		t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", "Subject", claims["sub"], "client")
+	}
+}
+
+func TestKeyRing_PublicKeys(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		calls = 0
+		now   = time.Now()
+		ring  = NewKeyRing(stubSigningKeyUseCase{
+			keys:  []usecase.SigningKeyOutput{{ID: "key-1", PrivateKey: privateKey}},
+			calls: &calls,
+		}, time.Minute)
+	)
+
+	ring.now = func() time.Time {
+		return now
+	}
+
+	tests := []struct {
+		name          string
+		elapsed       time.Duration
+		kid           string
+		expectedCalls int
+	}{
+		{
+			name:          "First load",
+			kid:           "key-1",
+			expectedCalls: 1,
+		},
+		{
+			name:          "Unknown kid right after a refresh uses the cached keys",
+			elapsed:       5 * time.Second,
+			kid:           "key-2",
+			expectedCalls: 1,
+		},
+		{
+			name:          "Unknown kid refreshes the keys",
+			elapsed:       minKeyRingRefresh,
+			kid:           "key-2",
+			expectedCalls: 2,
+		},
+		{
+			name:          "Known kid uses the cached keys",
+			elapsed:       30 * time.Second,
+			kid:           "key-1",
+			expectedCalls: 2,
+		},
+		{
+			name:          "Expired cache refreshes the keys",
+			elapsed:       30 * time.Second,
+			kid:           "key-1",
+			expectedCalls: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		now = now.Add(tt.elapsed)
+
+		keys, err := ring.PublicKeys(context.Background(), tt.kid)
+		if err != nil {
+			t.Fatalf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, err, nil)
+		}
+
+		if _, ok := keys["key-1"]; !ok || len(keys) != 1 {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, keys, "key-1")
+		}
+
+		if calls != tt.expectedCalls {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, calls, tt.expectedCalls)
+		}
+	}
+}
+
+func TestMarshalJWKS(t *testing.T) {
+	t.Parallel()
+
+	ring, privateKey := newTestKeyRing(t)
+
+	keys, err := ring.PublicKeys(context.Background(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := MarshalJWKS(keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := ParseJWKS(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(parsed, KeySet{"key-1": &privateKey.PublicKey}) {
+		t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", "Round trip", parsed, privateKey.PublicKey)
+	}
+}
@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+var (
+	//ErrUnauthenticated é um erro de credencial ausente ou inválida
+	ErrUnauthenticated = errors.New("missing or invalid credentials")
+
+	//ErrForbidden é um erro de acesso a uma Account que não pertence ao Principal autenticado
+	ErrForbidden = errors.New("access to the account is not allowed")
+
+	//ErrInsufficientScope é um erro de acesso a uma operação não concedida nos escopos do Principal autenticado
+	ErrInsufficientScope = errors.New("insufficient scope")
+)
+
+//Principal armazena a identidade autenticada de uma request
+//
+//O subject identifica a Account do titular, que só pode operar a própria Account a menos que possua o escopo admin
+type Principal struct {
+	subject string
+	scopes  []domain.Scope
+}
+
+//NewPrincipal cria um Principal
+func NewPrincipal(subject string, scopes []domain.Scope) Principal {
+	return Principal{subject: subject, scopes: scopes}
+}
+
+//Subject retorna o identificador do Principal
+func (p Principal) Subject() string {
+	return p.subject
+}
+
+//Scopes retorna os escopos concedidos ao Principal
+func (p Principal) Scopes() []domain.Scope {
+	return p.scopes
+}
+
+//HasScope informa se o escopo foi concedido ao Principal, o escopo admin concede todos os demais
+func (p Principal) HasScope(scope domain.Scope) bool {
+	for _, s := range p.scopes {
+		if s == scope || s == domain.ScopeAdmin {
+			return true
+		}
+	}
+
+	return false
+}
+
+//CanAccessAccount informa se o Principal é o titular da Account ou possui o escopo admin
+func (p Principal) CanAccessAccount(ID domain.AccountID) bool {
+	return p.HasScope(domain.ScopeAdmin) || (p.subject != "" && p.subject == ID.String())
+}
+
+type principalKey struct{}
+
+//WithPrincipal retorna uma cópia do contexto com o Principal autenticado
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+//FromContext retorna o Principal autenticado armazenado no contexto
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+//AuthorizeAccount retorna ErrForbidden quando o Principal do contexto não pode operar a Account, inclusive quando a
+//request não foi autenticada
+func AuthorizeAccount(ctx context.Context, ID domain.AccountID) error {
+	p, ok := FromContext(ctx)
+	if !ok || !p.CanAccessAccount(ID) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+//AuthorizeTransferFilter restringe o TransferFilter às Transfer da Account do Principal do contexto, preservando o
+//filtro do escopo admin
+//
+//Sem Account informada o filtro é limitado à Account do titular. Uma Account de outro titular só é aceita como
+//contraparte, quando o filtro também fixa a Account do titular, caso contrário retorna ErrForbidden
+func AuthorizeTransferFilter(ctx context.Context, filter domain.TransferFilter) (domain.TransferFilter, error) {
+	p, ok := FromContext(ctx)
+	if ok && p.HasScope(domain.ScopeAdmin) {
+		return filter, nil
+	}
+
+	if !ok || p.Subject() == "" {
+		return domain.TransferFilter{}, ErrForbidden
+	}
+
+	var own = domain.AccountID(p.Subject())
+	if filter.AccountID != "" && filter.AccountID != own {
+		return domain.TransferFilter{}, ErrForbidden
+	}
+
+	if filter.AccountID == own || filter.AccountOriginID == own || filter.AccountDestinationID == own {
+		return filter, nil
+	}
+
+	if filter.AccountOriginID != "" || filter.AccountDestinationID != "" {
+		return domain.TransferFilter{}, ErrForbidden
+	}
+
+	filter.AccountID = own
+	return filter, nil
+}
+
+//RequireScope retorna ErrInsufficientScope quando o escopo não foi concedido ao Principal do contexto, inclusive
+//quando a request não foi autenticada
+func RequireScope(ctx context.Context, scope domain.Scope) error {
+	p, ok := FromContext(ctx)
+	if !ok || !p.HasScope(scope) {
+		return ErrInsufficientScope
+	}
+
+	return nil
+}
+
+//BearerToken extrai o token de um header Authorization no esquema Bearer (RFC 6750)
+func BearerToken(header string) (string, bool) {
+	const scheme = "bearer "
+
+	if len(header) <= len(scheme) || !strings.EqualFold(header[:len(scheme)], scheme) {
+		return "", false
+	}
+
+	var token = strings.TrimSpace(header[len(scheme):])
+	return token, token != ""
+}
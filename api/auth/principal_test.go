@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+func TestAuthorizeAccount(t *testing.T) {
+	t.Parallel()
+
+	const accountID domain.AccountID = "3c096a40-ccba-4b58-93ed-57379ab04680"
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		expectedError error
+	}{
+		{
+			name: "Owner of the account",
+			ctx:  WithPrincipal(context.Background(), NewPrincipal(accountID.String(), nil)),
+		},
+		{
+			name: "Admin scope",
+			ctx:  WithPrincipal(context.Background(), NewPrincipal("backoffice", []domain.Scope{domain.ScopeAdmin})),
+		},
+		{
+			name:          "Account of another owner",
+			ctx:           WithPrincipal(context.Background(), NewPrincipal("a5cb1ba2-d5ba-4d1b-8a5a-37ab5c2d2fa5", nil)),
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Principal without subject",
+			ctx:           WithPrincipal(context.Background(), NewPrincipal("", []domain.Scope{domain.ScopeTransfersWrite})),
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Request not authenticated",
+			ctx:           context.Background(),
+			expectedError: ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		if err := AuthorizeAccount(tt.ctx, accountID); err != tt.expectedError {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, err, tt.expectedError)
+		}
+	}
+}
+
+func TestAuthorizeTransferFilter(t *testing.T) {
+	t.Parallel()
+
+	const (
+		own     domain.AccountID = "3c096a40-ccba-4b58-93ed-57379ab04680"
+		another domain.AccountID = "a5cb1ba2-d5ba-4d1b-8a5a-37ab5c2d2fa5"
+	)
+
+	var holder = WithPrincipal(context.Background(), NewPrincipal(own.String(), AccountHolderScopes()))
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		filter        domain.TransferFilter
+		expected      domain.TransferFilter
+		expectedError error
+	}{
+		{
+			name:     "Filter without account limited to the holder account",
+			ctx:      holder,
+			filter:   domain.TransferFilter{MinAmount: 100},
+			expected: domain.TransferFilter{AccountID: own, MinAmount: 100},
+		},
+		{
+			name:     "Filter by the holder account",
+			ctx:      holder,
+			filter:   domain.TransferFilter{AccountOriginID: own},
+			expected: domain.TransferFilter{AccountOriginID: own},
+		},
+		{
+			name:     "Another account as counterparty of the holder account",
+			ctx:      holder,
+			filter:   domain.TransferFilter{AccountOriginID: another, AccountDestinationID: own},
+			expected: domain.TransferFilter{AccountOriginID: another, AccountDestinationID: own},
+		},
+		{
+			name:     "Admin scope keeps the filter",
+			ctx:      WithPrincipal(context.Background(), NewPrincipal("", []domain.Scope{domain.ScopeAdmin})),
+			filter:   domain.TransferFilter{AccountID: another},
+			expected: domain.TransferFilter{AccountID: another},
+		},
+		{
+			name:          "Account of another owner",
+			ctx:           holder,
+			filter:        domain.TransferFilter{AccountID: another},
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Origin account of another owner",
+			ctx:           holder,
+			filter:        domain.TransferFilter{AccountOriginID: another},
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Account of another owner with the holder account as origin",
+			ctx:           holder,
+			filter:        domain.TransferFilter{AccountID: another, AccountOriginID: own},
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Principal without subject",
+			ctx:           WithPrincipal(context.Background(), NewPrincipal("", []domain.Scope{domain.ScopeTransfersRead})),
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Request not authenticated",
+			ctx:           context.Background(),
+			expectedError: ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		result, err := AuthorizeTransferFilter(tt.ctx, tt.filter)
+		if err != tt.expectedError {
+			t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+		}
+
+		if result != tt.expected {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+		}
+	}
+}
+
+func TestRequireScope(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		scope         domain.Scope
+		expectedError error
+	}{
+		{
+			name:  "Scope granted",
+			ctx:   WithPrincipal(context.Background(), NewPrincipal("", []domain.Scope{domain.ScopeTransfersWrite})),
+			scope: domain.ScopeTransfersWrite,
+		},
+		{
+			name:  "Admin scope grants every scope",
+			ctx:   WithPrincipal(context.Background(), NewPrincipal("", []domain.Scope{domain.ScopeAdmin})),
+			scope: domain.ScopeWebhooksWrite,
+		},
+		{
+			name:          "Scope not granted",
+			ctx:           WithPrincipal(context.Background(), NewPrincipal("", []domain.Scope{domain.ScopeTransfersRead})),
+			scope:         domain.ScopeTransfersWrite,
+			expectedError: ErrInsufficientScope,
+		},
+		{
+			name:          "Admin scope not granted",
+			ctx:           WithPrincipal(context.Background(), NewPrincipal("", AccountHolderScopes())),
+			scope:         domain.ScopeAdmin,
+			expectedError: ErrInsufficientScope,
+		},
+		{
+			name:          "Request not authenticated",
+			ctx:           context.Background(),
+			scope:         domain.ScopeAccountsRead,
+			expectedError: ErrInsufficientScope,
+		},
+	}
+
+	for _, tt := range tests {
+		if err := RequireScope(tt.ctx, tt.scope); err != tt.expectedError {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, err, tt.expectedError)
+		}
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		header        string
+		expectedToken string
+		expectedOk    bool
+	}{
+		{header: "Bearer abc", expectedToken: "abc", expectedOk: true},
+		{header: "bearer  abc ", expectedToken: "abc", expectedOk: true},
+		{header: "Bearer ", expectedOk: false},
+		{header: "Basic abc", expectedOk: false},
+		{header: "", expectedOk: false},
+	}
+
+	for _, tt := range tests {
+		token, ok := BearerToken(tt.header)
+		if token != tt.expectedToken || ok != tt.expectedOk {
+			t.Errorf("[TestCase '%s'] Result: '%v' '%v' | Expected: '%v' '%v'", tt.header, token, ok, tt.expectedToken, tt.expectedOk)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+//CSVContentType é o Content-Type do extrato exportado em CSV
+const CSVContentType = "text/csv; charset=utf-8"
+
+var csvHeader = []string{
+	"date",
+	"id",
+	"type",
+	"counterparty_account_id",
+	"amount",
+	"balance",
+}
+
+//CSV escreve os lançamentos do extrato no formato CSV da RFC 4180, com o saldo após cada lançamento
+func CSV(w io.Writer, statement usecase.StatementOutput) error {
+	var writer = csv.NewWriter(w)
+	writer.UseCRLF = true
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, entry := range statement.Entries {
+		if err := writer.Write([]string{
+			entry.CreatedAt.UTC().Format(time.RFC3339),
+			entry.ID,
+			entry.Type,
+			entry.CounterpartyAccountID,
+			formatAmount(entry.Amount),
+			formatAmount(entry.Balance),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+//formatAmount formata um valor monetário com duas casas decimais e ponto como separador
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
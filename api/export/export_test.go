@@ -0,0 +1,171 @@
+package export
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+var update = flag.Bool("update", false, "atualiza os arquivos golden")
+
+func statementFixture() usecase.StatementOutput {
+	return usecase.StatementOutput{
+		AccountID:      "3c096a40-ccba-4b58-93ed-57379ab04680",
+		From:           time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+		To:             time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC),
+		OpeningBalance: 0,
+		ClosingBalance: 85.1,
+		Entries: []usecase.StatementEntryOutput{
+			{
+				ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+				Type:      "initial_deposit",
+				Amount:    100,
+				Balance:   100,
+				CreatedAt: time.Date(2020, 6, 1, 9, 0, 0, 0, time.UTC),
+			},
+			{
+				ID:                    "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+				Type:                  "transfer_out",
+				CounterpartyAccountID: "3c096a40-ccba-4b58-93ed-57379ab04699",
+				Amount:                -25.5,
+				Balance:               74.5,
+				CreatedAt:             time.Date(2020, 6, 10, 14, 30, 0, 0, time.FixedZone("BRT", -3*60*60)),
+			},
+			{
+				ID:                    "c71cd6c7-a55c-491e-9140-91903fe66fa0",
+				Type:                  "transfer_in",
+				CounterpartyAccountID: "3c096a40-ccba-4b58-93ed-57379ab04699",
+				Amount:                10.6,
+				Balance:               85.1,
+				CreatedAt:             time.Date(2020, 6, 20, 8, 15, 30, 0, time.UTC),
+			},
+		},
+	}
+}
+
+func TestCSV(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement usecase.StatementOutput
+		golden    string
+	}{
+		{
+			name:      "Statement with movements",
+			statement: statementFixture(),
+			golden:    "statement.csv",
+		},
+		{
+			name: "Statement without movements",
+			statement: usecase.StatementOutput{
+				AccountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+				Entries:   []usecase.StatementEntryOutput{},
+			},
+			golden: "statement_empty.csv",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := CSV(&buf, tt.statement); err != nil {
+				t.Fatalf("[TestCase '%s'] Unexpected error: '%v'", tt.name, err)
+			}
+
+			assertGolden(t, tt.name, tt.golden, buf.Bytes())
+		})
+	}
+}
+
+func TestOFX(t *testing.T) {
+	var generatedAt = time.Date(2020, 7, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		statement usecase.StatementOutput
+		golden    string
+	}{
+		{
+			name:      "Statement with movements",
+			statement: statementFixture(),
+			golden:    "statement.ofx",
+		},
+		{
+			name: "Statement without movements",
+			statement: usecase.StatementOutput{
+				AccountID:      "3c096a40-ccba-4b58-93ed-57379ab04680",
+				From:           time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+				To:             time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC),
+				OpeningBalance: 12.3,
+				ClosingBalance: 12.3,
+				Entries:        []usecase.StatementEntryOutput{},
+			},
+			golden: "statement_empty.ofx",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := OFX(&buf, tt.statement, generatedAt); err != nil {
+				t.Fatalf("[TestCase '%s'] Unexpected error: '%v'", tt.name, err)
+			}
+
+			assertGolden(t, tt.name, tt.golden, buf.Bytes())
+		})
+	}
+}
+
+func TestReconciliationCSV(t *testing.T) {
+	var reconciliation = usecase.ReconciliationOutput{
+		GeneratedAt: time.Date(2020, 7, 2, 12, 0, 0, 0, time.UTC),
+		Checked:     3,
+		Divergent:   2,
+		Items: []usecase.ReconciliationItemOutput{
+			{
+				AccountID:       "3c096a40-ccba-4b58-93ed-57379ab04680",
+				StoredBalance:   100,
+				ComputedBalance: 74.5,
+				Difference:      25.5,
+				CorrectionID:    "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+			},
+			{
+				AccountID:       "3c096a40-ccba-4b58-93ed-57379ab04699",
+				StoredBalance:   10,
+				ComputedBalance: 20.6,
+				Difference:      -10.6,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := ReconciliationCSV(&buf, reconciliation); err != nil {
+		t.Fatalf("Unexpected error: '%v'", err)
+	}
+
+	assertGolden(t, "Reconciliation with divergences", "reconciliation.csv", buf.Bytes())
+}
+
+func assertGolden(t *testing.T, name string, golden string, result []byte) {
+	t.Helper()
+
+	var path = filepath.Join("testdata", golden)
+	if *update {
+		if err := ioutil.WriteFile(path, result, 0644); err != nil {
+			t.Fatalf("[TestCase '%s'] Error updating golden file: '%v'", name, err)
+		}
+	}
+
+	expected, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Error reading golden file: '%v'", name, err)
+	}
+
+	if !bytes.Equal(result, expected) {
+		t.Errorf("[TestCase '%s'] Result: '%s' | Expected: '%s'", name, result, expected)
+	}
+}
@@ -0,0 +1,161 @@
+package export
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+const (
+	//OFXContentType é o Content-Type do extrato exportado em OFX
+	OFXContentType = "application/x-ofx"
+
+	//ofxBankID identifica a instituição no BANKACCTFROM do OFX
+	ofxBankID = "0"
+
+	ofxCurrency = "BRL"
+
+	ofxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>` + "\n" +
+		`<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>` + "\n"
+
+	//ofxDateLayout é o formato de data do OFX, sempre em UTC
+	ofxDateLayout = "20060102150405.000[0:GMT]"
+)
+
+type ofxDocument struct {
+	XMLName xml.Name  `xml:"OFX"`
+	SignOn  ofxSignOn `xml:"SIGNONMSGSRSV1>SONRS"`
+	Bank    ofxBank   `xml:"BANKMSGSRSV1>STMTTRNRS"`
+}
+
+type ofxStatus struct {
+	Code     int    `xml:"CODE"`
+	Severity string `xml:"SEVERITY"`
+}
+
+type ofxSignOn struct {
+	Status   ofxStatus `xml:"STATUS"`
+	DTServer string    `xml:"DTSERVER"`
+	Language string    `xml:"LANGUAGE"`
+}
+
+type ofxBank struct {
+	TrnUID    string       `xml:"TRNUID"`
+	Status    ofxStatus    `xml:"STATUS"`
+	Statement ofxStatement `xml:"STMTRS"`
+}
+
+type ofxStatement struct {
+	CurDef       string             `xml:"CURDEF"`
+	Account      ofxAccount         `xml:"BANKACCTFROM"`
+	Transactions ofxTransactionList `xml:"BANKTRANLIST"`
+	LedgerBal    ofxBalance         `xml:"LEDGERBAL"`
+}
+
+type ofxAccount struct {
+	BankID   string `xml:"BANKID"`
+	AcctID   string `xml:"ACCTID"`
+	AcctType string `xml:"ACCTTYPE"`
+}
+
+type ofxTransactionList struct {
+	DTStart      string           `xml:"DTSTART"`
+	DTEnd        string           `xml:"DTEND"`
+	Transactions []ofxTransaction `xml:"STMTTRN"`
+}
+
+type ofxTransaction struct {
+	TrnType  string `xml:"TRNTYPE"`
+	DTPosted string `xml:"DTPOSTED"`
+	TrnAmt   string `xml:"TRNAMT"`
+	FITID    string `xml:"FITID"`
+	Memo     string `xml:"MEMO"`
+}
+
+type ofxBalance struct {
+	BalAmt string `xml:"BALAMT"`
+	DTAsOf string `xml:"DTASOF"`
+}
+
+//OFX escreve o extrato no formato OFX 2.2 (XML), usando o identificador de cada lançamento como FITID
+func OFX(w io.Writer, statement usecase.StatementOutput, generatedAt time.Time) error {
+	var transactions = make([]ofxTransaction, 0, len(statement.Entries))
+
+	for _, entry := range statement.Entries {
+		var trnType = "CREDIT"
+		if entry.Amount < 0 {
+			trnType = "DEBIT"
+		}
+
+		transactions = append(transactions, ofxTransaction{
+			TrnType:  trnType,
+			DTPosted: formatOFXDate(entry.CreatedAt),
+			TrnAmt:   formatAmount(entry.Amount),
+			FITID:    entry.ID,
+			Memo:     ofxMemo(entry),
+		})
+	}
+
+	var document = ofxDocument{
+		SignOn: ofxSignOn{
+			Status:   ofxStatus{Code: 0, Severity: "INFO"},
+			DTServer: formatOFXDate(generatedAt),
+			Language: "POR",
+		},
+		Bank: ofxBank{
+			TrnUID: "0",
+			Status: ofxStatus{Code: 0, Severity: "INFO"},
+			Statement: ofxStatement{
+				CurDef: ofxCurrency,
+				Account: ofxAccount{
+					BankID:   ofxBankID,
+					AcctID:   statement.AccountID,
+					AcctType: "CHECKING",
+				},
+				Transactions: ofxTransactionList{
+					DTStart:      formatOFXDate(statement.From),
+					DTEnd:        formatOFXDate(statement.To),
+					Transactions: transactions,
+				},
+				LedgerBal: ofxBalance{
+					BalAmt: formatAmount(statement.ClosingBalance),
+					DTAsOf: formatOFXDate(statement.To),
+				},
+			},
+		},
+	}
+
+	if _, err := io.WriteString(w, ofxHeader); err != nil {
+		return err
+	}
+
+	var encoder = xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+//ofxMemo descreve o lançamento, o NAME do OFX não comporta o identificador da Account de contrapartida
+func ofxMemo(entry usecase.StatementEntryOutput) string {
+	switch entry.Type {
+	case domain.StatementEntryTransferIn.String():
+		return "Transfer from " + entry.CounterpartyAccountID
+	case domain.StatementEntryTransferOut.String():
+		return "Transfer to " + entry.CounterpartyAccountID
+	case domain.StatementEntryInitialDeposit.String():
+		return "Initial deposit"
+	}
+
+	return entry.Type
+}
+
+func formatOFXDate(t time.Time) string {
+	return t.UTC().Format(ofxDateLayout)
+}
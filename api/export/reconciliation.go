@@ -0,0 +1,41 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+var reconciliationCSVHeader = []string{
+	"account_id",
+	"stored_balance",
+	"computed_balance",
+	"difference",
+	"correction_id",
+}
+
+//ReconciliationCSV escreve as Account divergentes da conciliação no formato CSV da RFC 4180
+func ReconciliationCSV(w io.Writer, reconciliation usecase.ReconciliationOutput) error {
+	var writer = csv.NewWriter(w)
+	writer.UseCRLF = true
+
+	if err := writer.Write(reconciliationCSVHeader); err != nil {
+		return err
+	}
+
+	for _, item := range reconciliation.Items {
+		if err := writer.Write([]string{
+			item.AccountID,
+			formatAmount(item.StoredBalance),
+			formatAmount(item.ComputedBalance),
+			formatAmount(item.Difference),
+			item.CorrectionID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,76 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/graphql-go/graphql"
+)
+
+//accountField constrói um campo obrigatório de Account lido do output do caso de uso
+func accountField(t graphql.Output, value func(usecase.AccountOutput) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(t),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return value(p.Source.(usecase.AccountOutput)), nil
+		},
+	}
+}
+
+//transferField constrói um campo obrigatório de Transfer lido do output do caso de uso
+func transferField(t graphql.Output, value func(usecase.TransferOutput) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(t),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return value(p.Source.(usecase.TransferOutput)), nil
+		},
+	}
+}
+
+//nullable retorna nil para uma string vazia, que é apresentada como null
+func nullable(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+
+	return value
+}
+
+//parsePagination valida os argumentos limit e cursor de um campo paginado
+func parsePagination(args map[string]interface{}) (domain.Pagination, []validator.FieldError) {
+	var (
+		errs      = make([]validator.FieldError, 0)
+		limit, _  = args["limit"].(int)
+		cursor, _ = args["cursor"].(string)
+	)
+
+	if _, ok := args["limit"]; ok && (limit < 1 || limit > domain.MaxPageLimit) {
+		errs = append(errs, validator.FieldError{
+			Field:   "limit",
+			Message: fmt.Sprintf("limit must be a number between 1 and %d", domain.MaxPageLimit),
+		})
+	}
+
+	after, err := domain.DecodeCursor(cursor)
+	if err != nil {
+		errs = append(errs, validator.FieldError{
+			Field:   "cursor",
+			Message: "cursor is invalid",
+		})
+	}
+
+	return domain.NewPagination(after, limit), errs
+}
+
+func stringArg(args map[string]interface{}, name string) string {
+	value, _ := args[name].(string)
+	return value
+}
+
+func int64Arg(args map[string]interface{}, name string) int64 {
+	value, _ := args[name].(int)
+	return int64(value)
+}
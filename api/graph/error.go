@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+//Error é um erro de resolver que expõe o código estável da API nas extensions da resposta GraphQL
+type Error struct {
+	message       string
+	code          string
+	invalidParams []response.InvalidParam
+	cause         error
+}
+
+//Error retorna a mensagem do erro
+func (e Error) Error() string {
+	return e.message
+}
+
+//Extensions retorna o código do erro e, nas falhas de validação, os campos inválidos
+func (e Error) Extensions() map[string]interface{} {
+	var extensions = map[string]interface{}{"code": e.code}
+	if len(e.invalidParams) > 0 {
+		extensions["invalid_params"] = e.invalidParams
+	}
+
+	return extensions
+}
+
+//translateError converte um erro da aplicação utilizando o mesmo catálogo de códigos da API HTTP, o erro original é
+//mantido apenas para o log
+func translateError(err error) error {
+	var resErr = response.TranslateError(err)
+
+	return Error{message: strings.Join(resErr.Errors, ", "), code: resErr.Code, cause: err}
+}
+
+//Cause retorna o erro original de um erro da resposta para o log, ou a própria mensagem quando não há um erro da
+//aplicação
+func Cause(err gqlerrors.FormattedError) error {
+	if e, ok := original(err).(Error); ok && e.cause != nil {
+		return e.cause
+	}
+
+	return errors.New(err.Message)
+}
+
+//invalidInput constrói um Error com os campos inválidos de um input
+func invalidInput(errs []validator.FieldError, code string) error {
+	var (
+		messages      = make([]string, 0, len(errs))
+		invalidParams = make([]response.InvalidParam, 0, len(errs))
+	)
+
+	for _, err := range errs {
+		messages = append(messages, err.Message)
+		invalidParams = append(invalidParams, response.InvalidParam{Name: err.Field, Reason: err.Message})
+	}
+
+	return Error{message: strings.Join(messages, ", "), code: code, invalidParams: invalidParams}
+}
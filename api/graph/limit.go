@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var (
+	//ErrQueryTooDeep é um erro de consulta com campos aninhados além da profundidade máxima
+	ErrQueryTooDeep = errors.New("query is too deep")
+
+	//ErrQueryTooComplex é um erro de consulta com complexidade estimada acima da máxima
+	ErrQueryTooComplex = errors.New("query is too complex")
+)
+
+//paginatedFields armazena os campos que retornam uma página, cujo custo é multiplicado pelo limit
+var paginatedFields = map[string]bool{
+	"accounts":  true,
+	"transfers": true,
+}
+
+//Limits armazena a profundidade e a complexidade máximas aceitas para uma consulta
+//
+//A complexidade soma um ponto por campo, multiplicando o custo dos campos paginados pelo limit solicitado. Os campos
+//de introspecção não são contabilizados
+type Limits struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+//limitChecker calcula a profundidade e a complexidade da operação executada em um documento
+type limitChecker struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+}
+
+//checkLimits retorna um erro quando a operação selecionada excede os Limits
+func checkLimits(document *ast.Document, operationName string, variables map[string]interface{}, limits Limits) error {
+	var (
+		checker   = limitChecker{fragments: make(map[string]*ast.FragmentDefinition), variables: variables}
+		operation *ast.OperationDefinition
+	)
+
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.FragmentDefinition:
+			checker.fragments[definition.Name.Value] = definition
+		case *ast.OperationDefinition:
+			if operationName == "" || (definition.Name != nil && definition.Name.Value == operationName) {
+				operation = definition
+			}
+		}
+	}
+
+	if operation == nil {
+		return nil
+	}
+
+	if depth := checker.depth(operation.SelectionSet, map[string]bool{}); depth > limits.MaxDepth {
+		return fmt.Errorf("%w: depth %d exceeds the maximum of %d", ErrQueryTooDeep, depth, limits.MaxDepth)
+	}
+
+	if complexity := checker.complexity(operation.SelectionSet, map[string]bool{}); complexity > limits.MaxComplexity {
+		return fmt.Errorf(
+			"%w: complexity %d exceeds the maximum of %d",
+			ErrQueryTooComplex,
+			complexity,
+			limits.MaxComplexity,
+		)
+	}
+
+	return nil
+}
+
+//depth retorna o maior nível de campos aninhados do conjunto de seleção
+func (l limitChecker) depth(selectionSet *ast.SelectionSet, visited map[string]bool) int {
+	var max int
+	l.walk(selectionSet, visited, func(field *ast.Field, visited map[string]bool) {
+		if depth := 1 + l.depth(field.SelectionSet, visited); depth > max {
+			max = depth
+		}
+	})
+
+	return max
+}
+
+//complexity retorna o custo estimado do conjunto de seleção
+func (l limitChecker) complexity(selectionSet *ast.SelectionSet, visited map[string]bool) int {
+	var total int
+	l.walk(selectionSet, visited, func(field *ast.Field, visited map[string]bool) {
+		total += 1 + l.multiplier(field)*l.complexity(field.SelectionSet, visited)
+	})
+
+	return total
+}
+
+//walk chama fn para cada campo do conjunto de seleção, expandindo os fragmentos e ignorando a introspecção
+func (l limitChecker) walk(
+	selectionSet *ast.SelectionSet,
+	visited map[string]bool,
+	fn func(*ast.Field, map[string]bool),
+) {
+	if selectionSet == nil {
+		return
+	}
+
+	for _, selection := range selectionSet.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(selection.Name.Value, "__") {
+				continue
+			}
+
+			fn(selection, visited)
+		case *ast.InlineFragment:
+			l.walk(selection.SelectionSet, visited, fn)
+		case *ast.FragmentSpread:
+			var name = selection.Name.Value
+
+			fragment, ok := l.fragments[name]
+			if !ok || visited[name] {
+				continue
+			}
+
+			visited[name] = true
+			l.walk(fragment.SelectionSet, visited, fn)
+			delete(visited, name)
+		}
+	}
+}
+
+//multiplier retorna a quantidade de itens esperada de um campo, o limit solicitado para os campos paginados
+func (l limitChecker) multiplier(field *ast.Field) int {
+	if !paginatedFields[field.Name.Value] {
+		return 1
+	}
+
+	var limit = domain.DefaultPageLimit
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "limit" {
+			continue
+		}
+
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(value.Value); err == nil {
+				limit = n
+			}
+		case *ast.Variable:
+			if n, ok := l.variables[value.Name.Value].(float64); ok {
+				limit = int(n)
+			}
+		}
+	}
+
+	if limit < 1 || limit > domain.MaxPageLimit {
+		return domain.MaxPageLimit
+	}
+
+	return limit
+}
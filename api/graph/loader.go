@@ -0,0 +1,97 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type loaderKey struct{}
+
+//accountResult armazena o resultado da busca de uma Account pelo accountLoader, output nil quando não encontrada
+type accountResult struct {
+	output *usecase.AccountOutput
+	err    error
+}
+
+//accountLoader agrupa as buscas de Account por id de uma requisição GraphQL em uma única chamada ao caso de uso
+//
+//Os resolvers registram o id e retornam uma função que o executor chama somente depois de resolver os demais campos
+//do mesmo nível, quando todos os ids já foram registrados. Os resultados ficam em cache até o fim da requisição
+type accountLoader struct {
+	uc      usecase.AccountUseCase
+	mu      sync.Mutex
+	pending []domain.AccountID
+	results map[domain.AccountID]*accountResult
+}
+
+func newAccountLoader(uc usecase.AccountUseCase) *accountLoader {
+	return &accountLoader{uc: uc, results: make(map[domain.AccountID]*accountResult)}
+}
+
+//withAccountLoader retorna um contexto com um accountLoader novo para a requisição
+func withAccountLoader(ctx context.Context, uc usecase.AccountUseCase) context.Context {
+	return context.WithValue(ctx, loaderKey{}, newAccountLoader(uc))
+}
+
+//accountLoaderFrom retorna o accountLoader da requisição, criando um sem cache compartilhado quando ausente
+func accountLoaderFrom(ctx context.Context, uc usecase.AccountUseCase) *accountLoader {
+	if loader, ok := ctx.Value(loaderKey{}).(*accountLoader); ok {
+		return loader
+	}
+
+	return newAccountLoader(uc)
+}
+
+//load registra o id para a próxima busca em lote e retorna a função que resolve a Account
+func (l *accountLoader) load(ctx context.Context, ID domain.AccountID) func() (interface{}, error) {
+	l.mu.Lock()
+	if _, ok := l.results[ID]; !ok {
+		l.results[ID] = nil
+		l.pending = append(l.pending, ID)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		var result = l.result(ctx, ID)
+		if result.err != nil {
+			return nil, result.err
+		}
+
+		if result.output == nil {
+			return nil, nil
+		}
+
+		return *result.output, nil
+	}
+}
+
+//result busca em lote os ids pendentes, se houver, e retorna o resultado do id
+func (l *accountLoader) result(ctx context.Context, ID domain.AccountID) accountResult {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.pending) > 0 {
+		var IDs = l.pending
+		l.pending = nil
+
+		outputs, err := l.uc.FindByIDs(ctx, IDs)
+		for _, pendingID := range IDs {
+			l.results[pendingID] = &accountResult{err: err}
+		}
+
+		if err == nil {
+			for i := range outputs {
+				l.results[domain.AccountID(outputs[i].ID)] = &accountResult{output: &outputs[i]}
+			}
+		}
+	}
+
+	if result := l.results[ID]; result != nil {
+		return *result
+	}
+
+	return accountResult{}
+}
@@ -0,0 +1,523 @@
+package graph
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/input"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+//Request armazena a estrutura de dados de uma requisição GraphQL
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+//Schema armazena o schema GraphQL de Account e Transfer e as dependências dos seus resolvers
+type Schema struct {
+	schema     graphql.Schema
+	accountUC  usecase.AccountUseCase
+	transferUC usecase.TransferUseCase
+	balanceUC  usecase.BalanceUseCase
+	validator  validator.Validator
+	limits     Limits
+}
+
+//NewSchema constrói um Schema com suas dependências
+func NewSchema(
+	accountUC usecase.AccountUseCase,
+	transferUC usecase.TransferUseCase,
+	balanceUC usecase.BalanceUseCase,
+	v validator.Validator,
+	limits Limits,
+) (Schema, error) {
+	var s = Schema{
+		accountUC:  accountUC,
+		transferUC: transferUC,
+		balanceUC:  balanceUC,
+		validator:  v,
+		limits:     limits,
+	}
+
+	schema, err := s.build()
+	if err != nil {
+		return Schema{}, err
+	}
+	s.schema = schema
+
+	return s, nil
+}
+
+//Execute valida e executa uma requisição GraphQL
+//
+//Consultas inválidas ou acima dos Limits são recusadas antes da execução. As buscas de Account dos resolvers são
+//agrupadas por requisição
+func (s Schema) Execute(ctx context.Context, req Request) *graphql.Result {
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{
+			formatError(gqlerrors.FormatError(err), response.CodeInvalidQuery),
+		}}
+	}
+
+	if result := graphql.ValidateDocument(&s.schema, document, nil); !result.IsValid {
+		var errs = make([]gqlerrors.FormattedError, 0, len(result.Errors))
+		for _, err := range result.Errors {
+			errs = append(errs, formatError(err, response.CodeInvalidQuery))
+		}
+
+		return &graphql.Result{Errors: errs}
+	}
+
+	if err := checkLimits(document, req.OperationName, req.Variables, s.limits); err != nil {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{
+			formatError(gqlerrors.NewFormattedError(err.Error()), response.CodeQueryLimitExceeded),
+		}}
+	}
+
+	var result = graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           document,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       withAccountLoader(ctx, s.accountUC),
+	})
+	restoreExtensions(result.Errors)
+
+	return result
+}
+
+//restoreExtensions recupera as extensions dos erros das funções resolvidas em lote, que o executor descarta ao
+//envolver o erro original
+func restoreExtensions(errs []gqlerrors.FormattedError) {
+	for i := range errs {
+		if errs[i].Extensions != nil {
+			continue
+		}
+
+		if extended, ok := original(errs[i]).(gqlerrors.ExtendedError); ok {
+			errs[i].Extensions = extended.Extensions()
+		}
+	}
+}
+
+//original retorna o erro do resolver envolvido pelo executor, ou nil quando ele não é um ExtendedError
+func original(formatted gqlerrors.FormattedError) error {
+	var err = formatted.OriginalError()
+	for err != nil {
+		switch original := err.(type) {
+		case gqlerrors.ExtendedError:
+			return original
+		case *gqlerrors.Error:
+			err = original.OriginalError
+		case gqlerrors.FormattedError:
+			err = original.OriginalError()
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+//formatError adiciona o código da API nas extensions de um erro de consulta
+func formatError(err gqlerrors.FormattedError, code string) gqlerrors.FormattedError {
+	err.Extensions = map[string]interface{}{"code": code}
+	return err
+}
+
+func (s Schema) build() (graphql.Schema, error) {
+	var (
+		holderType = graphql.NewEnum(graphql.EnumConfig{
+			Name: "HolderType",
+			Values: graphql.EnumValueConfigMap{
+				"INDIVIDUAL": &graphql.EnumValueConfig{Value: string(domain.HolderTypeIndividual)},
+				"COMPANY":    &graphql.EnumValueConfig{Value: string(domain.HolderTypeCompany)},
+			},
+		})
+
+		balanceType = graphql.NewObject(graphql.ObjectConfig{
+			Name: "Balance",
+			Fields: graphql.Fields{
+				"amount": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.Float),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(usecase.AccountBalanceOutput).Balance, nil
+					},
+				},
+				"at": &graphql.Field{
+					Type: graphql.DateTime,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						if at := p.Source.(usecase.AccountBalanceOutput).At; at != nil {
+							return *at, nil
+						}
+
+						return nil, nil
+					},
+				},
+			},
+		})
+
+		accountType = graphql.NewObject(graphql.ObjectConfig{
+			Name: "Account",
+			Fields: graphql.Fields{
+				"id":        accountField(graphql.ID, func(a usecase.AccountOutput) interface{} { return a.ID }),
+				"name":      accountField(graphql.String, func(a usecase.AccountOutput) interface{} { return a.Name }),
+				"taxId":     accountField(graphql.String, func(a usecase.AccountOutput) interface{} { return a.TaxID }),
+				"taxIdType": accountField(graphql.String, func(a usecase.AccountOutput) interface{} { return a.TaxIDType }),
+				"createdAt": accountField(graphql.DateTime, func(a usecase.AccountOutput) interface{} { return a.CreatedAt }),
+				"balance": &graphql.Field{
+					Type:        graphql.NewNonNull(balanceType),
+					Description: "Saldo atual ou, com at, o saldo no instante informado",
+					Args: graphql.FieldConfigArgument{
+						"at": &graphql.ArgumentConfig{Type: graphql.DateTime},
+					},
+					Resolve: s.resolveBalance,
+				},
+			},
+		})
+
+		transferType = graphql.NewObject(graphql.ObjectConfig{
+			Name: "Transfer",
+			Fields: graphql.Fields{
+				"id":     transferField(graphql.ID, func(t usecase.TransferOutput) interface{} { return t.ID }),
+				"amount": transferField(graphql.Float, func(t usecase.TransferOutput) interface{} { return t.Amount }),
+				"status": transferField(graphql.String, func(t usecase.TransferOutput) interface{} { return t.Status }),
+				"accountOriginId": transferField(graphql.ID, func(t usecase.TransferOutput) interface{} {
+					return t.AccountOriginID
+				}),
+				"accountDestinationId": transferField(graphql.ID, func(t usecase.TransferOutput) interface{} {
+					return t.AccountDestinationID
+				}),
+				"createdAt": transferField(graphql.DateTime, func(t usecase.TransferOutput) interface{} {
+					return t.CreatedAt
+				}),
+				"origin": &graphql.Field{
+					Type: accountType,
+					Resolve: requireScope(domain.ScopeAccountsRead, func(p graphql.ResolveParams) (interface{}, error) {
+						return s.loadAccount(p.Context, p.Source.(usecase.TransferOutput).AccountOriginID), nil
+					}),
+				},
+				"destination": &graphql.Field{
+					Type: accountType,
+					Resolve: requireScope(domain.ScopeAccountsRead, func(p graphql.ResolveParams) (interface{}, error) {
+						return s.loadAccount(p.Context, p.Source.(usecase.TransferOutput).AccountDestinationID), nil
+					}),
+				},
+			},
+		})
+
+		accountConnectionType = graphql.NewObject(graphql.ObjectConfig{
+			Name: "AccountConnection",
+			Fields: graphql.Fields{
+				"data": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(accountType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(usecase.AccountListOutput).Data, nil
+					},
+				},
+				"nextCursor": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return nullable(p.Source.(usecase.AccountListOutput).NextCursor), nil
+					},
+				},
+			},
+		})
+
+		transferConnectionType = graphql.NewObject(graphql.ObjectConfig{
+			Name: "TransferConnection",
+			Fields: graphql.Fields{
+				"data": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(transferType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(usecase.TransferListOutput).Data, nil
+					},
+				},
+				"nextCursor": &graphql.Field{
+					Type: graphql.String,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return nullable(p.Source.(usecase.TransferListOutput).NextCursor), nil
+					},
+				},
+			},
+		})
+
+		paginationArgs = func(extra graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+			extra["limit"] = &graphql.ArgumentConfig{Type: graphql.Int}
+			extra["cursor"] = &graphql.ArgumentConfig{Type: graphql.String}
+			return extra
+		}
+	)
+
+	accountType.AddFieldConfig("transfers", &graphql.Field{
+		Type:        graphql.NewNonNull(transferConnectionType),
+		Description: "Transferências enviadas ou recebidas pela conta, das mais recentes para as mais antigas",
+		Args:        paginationArgs(graphql.FieldConfigArgument{}),
+		Resolve: requireScope(domain.ScopeTransfersRead, func(p graphql.ResolveParams) (interface{}, error) {
+			var account = p.Source.(usecase.AccountOutput)
+			if err := auth.AuthorizeAccount(p.Context, domain.AccountID(account.ID)); err != nil {
+				return nil, translateError(err)
+			}
+
+			return s.resolveTransfers(p, account.ID)
+		}),
+	})
+
+	var query = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"account": &graphql.Field{
+				Type: accountType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: requireScope(domain.ScopeAccountsRead, s.resolveAccount),
+			},
+			"accounts": &graphql.Field{
+				Type:    graphql.NewNonNull(accountConnectionType),
+				Args:    paginationArgs(graphql.FieldConfigArgument{}),
+				Resolve: requireScope(domain.ScopeAccountsRead, s.resolveAccounts),
+			},
+			"transfers": &graphql.Field{
+				Type: graphql.NewNonNull(transferConnectionType),
+				Args: paginationArgs(graphql.FieldConfigArgument{
+					"accountId": &graphql.ArgumentConfig{Type: graphql.ID},
+				}),
+				Resolve: requireScope(domain.ScopeTransfersRead, func(p graphql.ResolveParams) (interface{}, error) {
+					accountID, _ := p.Args["accountId"].(string)
+					return s.resolveTransfers(p, accountID)
+				}),
+			},
+		},
+	})
+
+	var mutation = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"createAccount": &graphql.Field{
+				Type: graphql.NewNonNull(accountType),
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.NewInputObject(graphql.InputObjectConfig{
+							Name: "CreateAccountInput",
+							Fields: graphql.InputObjectConfigFieldMap{
+								"name":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+								"type":    &graphql.InputObjectFieldConfig{Type: holderType},
+								"cpf":     &graphql.InputObjectFieldConfig{Type: graphql.String},
+								"cnpj":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+								"balance": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+							},
+						})),
+					},
+				},
+				Resolve: requireScope(domain.ScopeAccountsWrite, s.resolveCreateAccount),
+			},
+			"createTransfer": &graphql.Field{
+				Type: graphql.NewNonNull(transferType),
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.NewInputObject(graphql.InputObjectConfig{
+							Name: "CreateTransferInput",
+							Fields: graphql.InputObjectConfigFieldMap{
+								"accountOriginId":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+								"accountDestinationId": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+								"amount":               &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+							},
+						})),
+					},
+				},
+				Resolve: requireScope(domain.ScopeTransfersWrite, s.resolveCreateTransfer),
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query, Mutation: mutation})
+}
+
+func (s Schema) resolveAccount(p graphql.ResolveParams) (interface{}, error) {
+	var ID, _ = p.Args["id"].(string)
+	if !domain.IsValidUUID(ID) {
+		return nil, invalidInput(
+			[]validator.FieldError{{Field: "id", Message: "id must be a valid UUID"}},
+			response.CodeInvalidParameter,
+		)
+	}
+
+	if err := auth.AuthorizeAccount(p.Context, domain.AccountID(ID)); err != nil {
+		return nil, translateError(err)
+	}
+
+	var load = s.loadAccount(p.Context, ID)
+	return func() (interface{}, error) {
+		account, err := load()
+		if err != nil {
+			return nil, err
+		}
+
+		if account == nil {
+			return nil, translateError(domain.ErrNotFound)
+		}
+
+		return account, nil
+	}, nil
+}
+
+func (s Schema) resolveAccounts(p graphql.ResolveParams) (interface{}, error) {
+	pagination, errs := parsePagination(p.Args)
+	if len(errs) > 0 {
+		return nil, invalidInput(errs, response.CodeInvalidParameter)
+	}
+
+	output, err := s.accountUC.FindAll(p.Context, pagination)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return output, nil
+}
+
+func (s Schema) resolveBalance(p graphql.ResolveParams) (interface{}, error) {
+	var account = p.Source.(usecase.AccountOutput)
+	if err := auth.AuthorizeAccount(p.Context, domain.AccountID(account.ID)); err != nil {
+		return nil, translateError(err)
+	}
+
+	at, ok := p.Args["at"].(time.Time)
+	if !ok {
+		return usecase.AccountBalanceOutput{Balance: account.Balance}, nil
+	}
+
+	output, err := s.balanceUC.FindAt(p.Context, domain.AccountID(account.ID), at)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return output, nil
+}
+
+func (s Schema) resolveTransfers(p graphql.ResolveParams, accountID string) (interface{}, error) {
+	pagination, errs := parsePagination(p.Args)
+	if accountID != "" && !domain.IsValidUUID(accountID) {
+		errs = append(errs, validator.FieldError{Field: "accountId", Message: "accountId must be a valid UUID"})
+	}
+
+	if len(errs) > 0 {
+		return nil, invalidInput(errs, response.CodeInvalidParameter)
+	}
+
+	filter, err := auth.AuthorizeTransferFilter(p.Context, domain.TransferFilter{AccountID: domain.AccountID(accountID)})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	output, err := s.transferUC.FindAll(p.Context, domain.TransferQuery{
+		Filter:     filter,
+		Sort:       domain.TransferSortCreatedAtDesc,
+		Pagination: pagination,
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return output, nil
+}
+
+func (s Schema) resolveCreateAccount(p graphql.ResolveParams) (interface{}, error) {
+	var (
+		args, _      = p.Args["input"].(map[string]interface{})
+		inputAccount = input.Account{
+			Name:    stringArg(args, "name"),
+			Type:    stringArg(args, "type"),
+			CPF:     stringArg(args, "cpf"),
+			CNPJ:    stringArg(args, "cnpj"),
+			Balance: int64Arg(args, "balance"),
+		}
+	)
+
+	if errs := inputAccount.Validate(s.validator); len(errs) > 0 {
+		return nil, invalidInput(errs, response.CodeInvalidInput)
+	}
+
+	output, err := s.accountUC.Store(
+		p.Context,
+		inputAccount.Name,
+		inputAccount.TaxID(),
+		inputAccount.HolderType().TaxIDType(),
+		domain.Money(inputAccount.Balance),
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return output, nil
+}
+
+func (s Schema) resolveCreateTransfer(p graphql.ResolveParams) (interface{}, error) {
+	var (
+		args, _       = p.Args["input"].(map[string]interface{})
+		inputTransfer = input.Transfer{
+			AccountOriginID:      stringArg(args, "accountOriginId"),
+			AccountDestinationID: stringArg(args, "accountDestinationId"),
+			Amount:               int64Arg(args, "amount"),
+		}
+	)
+
+	if errs := inputTransfer.Validate(s.validator); len(errs) > 0 {
+		return nil, invalidInput(errs, response.CodeInvalidInput)
+	}
+
+	if err := auth.AuthorizeAccount(p.Context, domain.AccountID(inputTransfer.AccountOriginID)); err != nil {
+		return nil, translateError(err)
+	}
+
+	output, err := s.transferUC.Store(
+		p.Context,
+		domain.AccountID(inputTransfer.AccountOriginID),
+		domain.AccountID(inputTransfer.AccountDestinationID),
+		domain.Money(inputTransfer.Amount),
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return output, nil
+}
+
+//requireScope exige o escopo do Principal da request antes de resolver o campo
+func requireScope(scope domain.Scope, resolve graphql.FieldResolveFn) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		if err := auth.RequireScope(p.Context, scope); err != nil {
+			return nil, translateError(err)
+		}
+
+		return resolve(p)
+	}
+}
+
+//loadAccount registra a busca em lote da Account e retorna a função que a resolve, com os erros já traduzidos
+func (s Schema) loadAccount(ctx context.Context, ID string) func() (interface{}, error) {
+	var load = accountLoaderFrom(ctx, s.accountUC).load(ctx, domain.AccountID(ID))
+
+	return func() (interface{}, error) {
+		account, err := load()
+		if err != nil {
+			return nil, translateError(err)
+		}
+
+		return account, nil
+	}
+}
@@ -0,0 +1,353 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+const (
+	accountA = "3c096a40-ccba-4b58-93ed-57379ab04680"
+	accountB = "a5cb1ba2-d5ba-4d1b-8a5a-37ab5c2d2fa5"
+	accountC = "b7ed4d6a-f3b6-4b4a-9e5b-2f8f9f5ed0f1"
+)
+
+type mockAccount struct {
+	usecase.AccountUseCase
+
+	calls *[][]domain.AccountID
+	err   error
+}
+
+func (m mockAccount) FindByIDs(_ context.Context, IDs []domain.AccountID) ([]usecase.AccountOutput, error) {
+	*m.calls = append(*m.calls, IDs)
+
+	var outputs []usecase.AccountOutput
+	for _, ID := range IDs {
+		if ID == accountC {
+			continue
+		}
+
+		outputs = append(outputs, usecase.AccountOutput{ID: ID.String(), Name: "Test " + ID.String()[:4], Balance: 10.5})
+	}
+
+	return outputs, m.err
+}
+
+func (m mockAccount) Store(
+	_ context.Context,
+	name string,
+	taxID string,
+	_ domain.TaxIDType,
+	balance domain.Money,
+) (usecase.AccountOutput, error) {
+	return usecase.AccountOutput{ID: accountA, Name: name, TaxID: taxID, Balance: balance.Float64()}, m.err
+}
+
+type mockTransfer struct {
+	usecase.TransferUseCase
+}
+
+func (m mockTransfer) FindAll(_ context.Context, query domain.TransferQuery) (usecase.TransferListOutput, error) {
+	var data = []usecase.TransferOutput{
+		{ID: "1", AccountOriginID: accountA, AccountDestinationID: accountB, Amount: 1},
+		{ID: "2", AccountOriginID: accountB, AccountDestinationID: accountA, Amount: 2},
+		{ID: "3", AccountOriginID: accountA, AccountDestinationID: accountC, Amount: 3},
+	}
+
+	return usecase.TransferListOutput{Data: data[:query.Pagination.Limit]}, nil
+}
+
+type mockBalance struct {
+	usecase.BalanceUseCase
+}
+
+func (m mockBalance) FindAt(_ context.Context, _ domain.AccountID, at time.Time) (usecase.AccountBalanceOutput, error) {
+	return usecase.AccountBalanceOutput{Balance: 7, At: &at}, nil
+}
+
+func newTestSchema(t *testing.T, calls *[][]domain.AccountID, err error) Schema {
+	v, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	schema, errSchema := NewSchema(
+		mockAccount{calls: calls, err: err},
+		mockTransfer{},
+		mockBalance{},
+		v,
+		Limits{MaxDepth: 5, MaxComplexity: 50},
+	)
+	if errSchema != nil {
+		t.Fatalf("[TestCase 'NewSchema'] Result: '%v' | ExpectedError: '%v'", errSchema, nil)
+	}
+
+	return schema
+}
+
+func encode(value interface{}) string {
+	b, _ := json.Marshal(value)
+	return string(b)
+}
+
+func TestSchema_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		req           Request
+		owner         string
+		scopes        []domain.Scope
+		err           error
+		expectedData  string
+		expectedCodes []string
+		expectedCalls int
+	}{
+		{
+			name: "Transfers resolve accounts in a single batch",
+			req: Request{
+				Query: `{ transfers(limit: 3) { data { id origin { id } destination { id name } } } }`,
+			},
+			expectedData: `{"transfers":{"data":[` +
+				`{"destination":{"id":"` + accountB + `","name":"Test a5cb"},"id":"1","origin":{"id":"` + accountA + `"}},` +
+				`{"destination":{"id":"` + accountA + `","name":"Test 3c09"},"id":"2","origin":{"id":"` + accountB + `"}},` +
+				`{"destination":null,"id":"3","origin":{"id":"` + accountA + `"}}]}}`,
+			expectedCalls: 1,
+		},
+		{
+			name: "Account with historical balance",
+			req: Request{
+				Query:     `query($id: ID!) { account(id: $id) { name balance(at: "2020-06-01T00:00:00Z") { amount at } } }`,
+				Variables: map[string]interface{}{"id": accountA},
+			},
+			expectedData:  `{"account":{"balance":{"amount":7,"at":"2020-06-01T00:00:00Z"},"name":"Test 3c09"}}`,
+			expectedCalls: 1,
+		},
+		{
+			name: "Account balance of the owner",
+			req: Request{
+				Query: `{ account(id: "` + accountA + `") { balance { amount } } }`,
+			},
+			owner:         accountA,
+			expectedData:  `{"account":{"balance":{"amount":10.5}}}`,
+			expectedCalls: 1,
+		},
+		{
+			name: "Account of another owner",
+			req: Request{
+				Query: `{ account(id: "` + accountA + `") { name taxId transfers(limit: 1) { data { id } } } }`,
+			},
+			owner:         accountB,
+			expectedData:  `{"account":null}`,
+			expectedCodes: []string{"forbidden"},
+		},
+		{
+			name: "Account balance of a counterparty",
+			req: Request{
+				Query: `{ transfers(limit: 1) { data { destination { name balance { amount } } } } }`,
+			},
+			owner:         accountA,
+			expectedData:  `{"transfers":{"data":[{"destination":null}]}}`,
+			expectedCodes: []string{"forbidden"},
+			expectedCalls: 1,
+		},
+		{
+			name: "Transfers of another owner",
+			req: Request{
+				Query: `{ transfers(limit: 1, accountId: "` + accountB + `") { data { id } } }`,
+			},
+			owner:         accountA,
+			expectedData:  `null`,
+			expectedCodes: []string{"forbidden"},
+		},
+		{
+			name: "Transfers without the transfers:read scope",
+			req: Request{
+				Query: `{ transfers(limit: 1) { data { id } } }`,
+			},
+			scopes:        []domain.Scope{domain.ScopeAccountsRead},
+			expectedData:  `null`,
+			expectedCodes: []string{"insufficient_scope"},
+		},
+		{
+			name: "Origin account without the accounts:read scope",
+			req: Request{
+				Query: `{ transfers(limit: 1) { data { id origin { id } } } }`,
+			},
+			owner:         accountA,
+			scopes:        []domain.Scope{domain.ScopeTransfersRead},
+			expectedData:  `{"transfers":{"data":[{"id":"1","origin":null}]}}`,
+			expectedCodes: []string{"insufficient_scope"},
+		},
+		{
+			name: "Create transfer without the transfers:write scope",
+			req: Request{
+				Query: `mutation { createTransfer(input: {accountOriginId: "` + accountA + `", accountDestinationId: "` + accountB + `", amount: 100}) { id } }`,
+			},
+			owner:         accountA,
+			scopes:        []domain.Scope{domain.ScopeTransfersRead},
+			expectedData:  `null`,
+			expectedCodes: []string{"insufficient_scope"},
+		},
+		{
+			name:          "Account not found",
+			req:           Request{Query: `{ account(id: "` + accountC + `") { id } }`},
+			expectedData:  `{"account":null}`,
+			expectedCodes: []string{"not_found"},
+			expectedCalls: 1,
+		},
+		{
+			name:          "Account with invalid id",
+			req:           Request{Query: `{ account(id: "invalid") { id } }`},
+			expectedData:  `{"account":null}`,
+			expectedCodes: []string{"invalid_parameter"},
+		},
+		{
+			name:          "Error fetching accounts",
+			req:           Request{Query: `{ transfers(limit: 1) { data { origin { id } } } }`},
+			err:           errors.New("db error"),
+			expectedData:  `{"transfers":{"data":[{"origin":null}]}}`,
+			expectedCodes: []string{"internal_error"},
+			expectedCalls: 1,
+		},
+		{
+			name: "Create account with invalid input",
+			req: Request{
+				Query: `mutation { createAccount(input: {name: "Test", type: COMPANY, balance: 100}) { id } }`,
+			},
+			expectedData:  `null`,
+			expectedCodes: []string{"invalid_input"},
+		},
+		{
+			name: "Create account",
+			req: Request{
+				Query: `mutation { createAccount(input: {name: "Test", cpf: "070.910.584-24", balance: 100}) { taxId balance { amount } } }`,
+			},
+			expectedData: `{"createAccount":{"balance":{"amount":1},"taxId":"07091058424"}}`,
+		},
+		{
+			name:          "Invalid query",
+			req:           Request{Query: `{ account(id: "` + accountA + `") { unknown } }`},
+			expectedData:  `null`,
+			expectedCodes: []string{"invalid_query"},
+		},
+		{
+			name: "Query too deep",
+			req: Request{
+				Query: `{ transfers(limit: 1) { data { origin { transfers(limit: 1) { data { id } } } } } }`,
+			},
+			expectedData:  `null`,
+			expectedCodes: []string{"query_limit_exceeded"},
+		},
+		{
+			name: "Query too complex",
+			req: Request{
+				Query:     `query($limit: Int) { accounts(limit: $limit) { data { id name taxId } } }`,
+				Variables: map[string]interface{}{"limit": float64(20)},
+			},
+			expectedData:  `null`,
+			expectedCodes: []string{"query_limit_exceeded"},
+		},
+		{
+			name: "Introspection is not counted in the limits",
+			req: Request{
+				Query: `{ __schema { types { fields { type { ofType { ofType { ofType { name } } } } } } } }`,
+			},
+			expectedCodes: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var principal = auth.NewPrincipal("backoffice", []domain.Scope{domain.ScopeAdmin})
+			if tt.owner != "" {
+				principal = auth.NewPrincipal(tt.owner, auth.AccountHolderScopes())
+			}
+
+			if tt.scopes != nil {
+				principal = auth.NewPrincipal(tt.owner, tt.scopes)
+			}
+
+			var (
+				calls  [][]domain.AccountID
+				schema = newTestSchema(t, &calls, tt.err)
+				result = schema.Execute(auth.WithPrincipal(context.Background(), principal), tt.req)
+			)
+
+			if tt.expectedData != "" && encode(result.Data) != tt.expectedData {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, encode(result.Data), tt.expectedData)
+			}
+
+			var codes []string
+			for _, err := range result.Errors {
+				codes = append(codes, err.Extensions["code"].(string))
+			}
+
+			if !reflect.DeepEqual(codes, tt.expectedCodes) {
+				t.Errorf("[TestCase '%s'] Codes: '%v' | Expected: '%v' (%v)", tt.name, codes, tt.expectedCodes, result.Errors)
+			}
+
+			if len(calls) != tt.expectedCalls {
+				t.Errorf("[TestCase '%s'] Calls: '%v' | Expected: '%v'", tt.name, len(calls), tt.expectedCalls)
+			}
+		})
+	}
+}
+
+func TestSchema_ExecuteBatchesDistinctAccounts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls  [][]domain.AccountID
+		schema = newTestSchema(t, &calls, nil)
+	)
+
+	var principal = auth.NewPrincipal("backoffice", []domain.Scope{domain.ScopeAdmin})
+	schema.Execute(auth.WithPrincipal(context.Background(), principal), Request{
+		Query: `{ transfers(limit: 3) { data { origin { id } destination { id } } } }`,
+	})
+
+	if len(calls) != 1 {
+		t.Fatalf("[TestCase 'Batch'] Calls: '%v' | Expected: '%v'", len(calls), 1)
+	}
+
+	var IDs []string
+	for _, ID := range calls[0] {
+		IDs = append(IDs, ID.String())
+	}
+	sort.Strings(IDs)
+
+	var expected = []string{accountA, accountB, accountC}
+	if !reflect.DeepEqual(IDs, expected) {
+		t.Errorf("[TestCase 'Batch'] Result: '%v' | Expected: '%v'", IDs, expected)
+	}
+}
+
+func TestSchema_ExecuteHidesInternalErrors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls     [][]domain.AccountID
+		errDriver = errors.New("pq: connection refused on 10.0.0.5:5432")
+		schema    = newTestSchema(t, &calls, errDriver)
+		principal = auth.NewPrincipal("backoffice", []domain.Scope{domain.ScopeAdmin})
+		result    = schema.Execute(auth.WithPrincipal(context.Background(), principal), Request{
+			Query: `{ transfers(limit: 1) { data { origin { id } } } }`,
+		})
+	)
+
+	if len(result.Errors) != 1 || result.Errors[0].Message != "internal server error" {
+		t.Fatalf("[TestCase 'HidesInternalErrors'] Result: '%v' | Expected: '%v'", result.Errors, "internal server error")
+	}
+
+	if cause := Cause(result.Errors[0]); cause != errDriver {
+		t.Errorf("[TestCase 'HidesInternalErrors'] Cause: '%v' | Expected: '%v'", cause, errDriver)
+	}
+}
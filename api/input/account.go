@@ -1,23 +1,58 @@
 package input
 
-import "github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+import (
+	"errors"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+)
 
 //Account armazena a estrutura de dados de entrada da API
 type Account struct {
 	Name    string `json:"name" validate:"required"`
-	CPF     string `json:"cpf" validate:"required"`
+	Type    string `json:"type" validate:"omitempty,oneof=individual company"`
+	CPF     string `json:"cpf"`
+	CNPJ    string `json:"cnpj" validate:"omitempty,cnpj"`
 	Balance int64  `json:"balance" validate:"gt=0,required"`
 }
 
-func (a Account) Validate(validator validator.Validator) []string {
-	var msgs []string
+//HolderType retorna o tipo de titular da conta, sendo pessoa física quando não informado
+func (a Account) HolderType() domain.HolderType {
+	if a.Type == "" {
+		return domain.HolderTypeIndividual
+	}
+
+	return domain.HolderType(a.Type)
+}
+
+//TaxID retorna o documento correspondente ao tipo de titular da conta
+func (a Account) TaxID() string {
+	if a.HolderType().TaxIDType() == domain.TaxIDTypeCNPJ {
+		return domain.CleanTaxID(a.CNPJ)
+	}
 
-	err := validator.Validate(a)
-	if err != nil {
-		for _, msg := range validator.Messages() {
-			msgs = append(msgs, msg)
+	return domain.CleanTaxID(a.CPF)
+}
+
+func (a Account) Validate(v validator.Validator) []validator.FieldError {
+	var (
+		errs            []validator.FieldError
+		errCPFRequired  = errors.New("CPF is a required field")
+		errCNPJRequired = errors.New("CNPJ is a required field")
+	)
+
+	switch a.HolderType().TaxIDType() {
+	case domain.TaxIDTypeCNPJ:
+		if a.CNPJ == "" {
+			errs = append(errs, validator.FieldError{Field: "cnpj", Message: errCNPJRequired.Error()})
+		}
+	default:
+		if a.CPF == "" {
+			errs = append(errs, validator.FieldError{Field: "cpf", Message: errCPFRequired.Error()})
 		}
 	}
 
-	return msgs
+	errs = append(errs, v.Validate(a)...)
+
+	return errs
 }
@@ -0,0 +1,79 @@
+package input
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+)
+
+//APIKey armazena a estrutura de dados de entrada da API
+type APIKey struct {
+	Name      string     `json:"name" validate:"required,max=100"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1"`
+	AccountID string     `json:"account_id" validate:"omitempty,uuid4"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+//DomainScopes converte os escopos informados para o domínio
+func (a APIKey) DomainScopes() []domain.Scope {
+	var scopes = make([]domain.Scope, 0, len(a.Scopes))
+	for _, scope := range a.Scopes {
+		scopes = append(scopes, domain.Scope(scope))
+	}
+
+	return scopes
+}
+
+//Expiration retorna a data de expiração informada, ou o zero de time.Time para uma APIKey sem expiração
+func (a APIKey) Expiration() time.Time {
+	if a.ExpiresAt == nil {
+		return time.Time{}
+	}
+
+	return *a.ExpiresAt
+}
+
+func (a APIKey) Validate(v validator.Validator) []validator.FieldError {
+	var errs []validator.FieldError
+
+	for _, scope := range a.DomainScopes() {
+		if !scope.IsValid() {
+			errs = append(errs, validator.FieldError{
+				Field:   "scopes",
+				Message: fmt.Sprintf("scopes must contain only %v", domain.Scopes()),
+			})
+			break
+		}
+	}
+
+	if a.ExpiresAt != nil && !a.ExpiresAt.After(time.Now()) {
+		errs = append(errs, validator.FieldError{
+			Field:   "expires_at",
+			Message: "expires_at must be in the future",
+		})
+	}
+
+	errs = append(errs, v.Validate(a)...)
+
+	return errs
+}
+
+//RotateAPIKey armazena a estrutura de dados de entrada da API
+type RotateAPIKey struct {
+	OverlapSeconds *int `json:"overlap_seconds" validate:"omitempty,min=0,max=2592000"`
+}
+
+//Overlap retorna o período em que a APIKey rotacionada continua aceita, utilizando defaultOverlap quando não informado
+func (r RotateAPIKey) Overlap(defaultOverlap time.Duration) time.Duration {
+	if r.OverlapSeconds == nil {
+		return defaultOverlap
+	}
+
+	return time.Duration(*r.OverlapSeconds) * time.Second
+}
+
+func (r RotateAPIKey) Validate(v validator.Validator) []validator.FieldError {
+	return v.Validate(r)
+}
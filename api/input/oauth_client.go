@@ -0,0 +1,43 @@
+package input
+
+import (
+	"fmt"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+)
+
+//OAuthClient armazena a estrutura de dados de entrada da API
+type OAuthClient struct {
+	Name      string   `json:"name" validate:"required,max=100"`
+	Scopes    []string `json:"scopes" validate:"required,min=1"`
+	AccountID string   `json:"account_id" validate:"omitempty,uuid4"`
+}
+
+//DomainScopes converte os escopos informados para o domínio
+func (o OAuthClient) DomainScopes() []domain.Scope {
+	var scopes = make([]domain.Scope, 0, len(o.Scopes))
+	for _, scope := range o.Scopes {
+		scopes = append(scopes, domain.Scope(scope))
+	}
+
+	return scopes
+}
+
+func (o OAuthClient) Validate(v validator.Validator) []validator.FieldError {
+	var errs []validator.FieldError
+
+	for _, scope := range o.DomainScopes() {
+		if !scope.IsValid() {
+			errs = append(errs, validator.FieldError{
+				Field:   "scopes",
+				Message: fmt.Sprintf("scopes must contain only %v", domain.Scopes()),
+			})
+			break
+		}
+	}
+
+	errs = append(errs, v.Validate(o)...)
+
+	return errs
+}
@@ -12,24 +12,22 @@ type Transfer struct {
 	Amount               int64  `json:"amount" validate:"gt=0,required"`
 }
 
-func (t Transfer) Validate(validator validator.Validator) []string {
+func (t Transfer) Validate(v validator.Validator) []validator.FieldError {
 	var (
-		msgs              []string
+		errs              []validator.FieldError
 		errAccountsEquals = errors.New("account origin equals destination account")
 		accountIsEquals   = t.AccountOriginID == t.AccountDestinationID
 		accountsIsEmpty   = t.AccountOriginID == "" && t.AccountDestinationID == ""
 	)
 
 	if !accountsIsEmpty && accountIsEquals {
-		msgs = append(msgs, errAccountsEquals.Error())
+		errs = append(errs, validator.FieldError{
+			Field:   "account_destination_id",
+			Message: errAccountsEquals.Error(),
+		})
 	}
 
-	err := validator.Validate(t)
-	if err != nil {
-		for _, msg := range validator.Messages() {
-			msgs = append(msgs, msg)
-		}
-	}
+	errs = append(errs, v.Validate(t)...)
 
-	return msgs
+	return errs
 }
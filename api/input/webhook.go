@@ -0,0 +1,49 @@
+package input
+
+import (
+	"fmt"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+)
+
+//Webhook armazena a estrutura de dados de entrada da API
+type Webhook struct {
+	URL        string   `json:"url" validate:"required,url,webhook_url"`
+	EventTypes []string `json:"event_types" validate:"required,min=1"`
+	AccountID  string   `json:"account_id" validate:"omitempty,uuid4"`
+}
+
+//DomainEventTypes converte os tipos de eventos informados para o domínio
+func (w Webhook) DomainEventTypes() []domain.EventType {
+	var eventTypes = make([]domain.EventType, 0, len(w.EventTypes))
+	for _, eventType := range w.EventTypes {
+		eventTypes = append(eventTypes, domain.EventType(eventType))
+	}
+
+	return eventTypes
+}
+
+func (w Webhook) Validate(v validator.Validator) []validator.FieldError {
+	var errs []validator.FieldError
+
+	for _, eventType := range w.DomainEventTypes() {
+		if !eventType.IsValid() {
+			errs = append(errs, validator.FieldError{
+				Field: "event_types",
+				Message: fmt.Sprintf(
+					"event_types must contain only [%s %s %s %s]",
+					domain.EventAccountCreated,
+					domain.EventBalanceChanged,
+					domain.EventTransferCompleted,
+					domain.EventTransferFailed,
+				),
+			})
+			break
+		}
+	}
+
+	errs = append(errs, v.Validate(w)...)
+
+	return errs
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+)
+
+//Authentication armazena a estrutura de autenticação das requests através de um bearer token
+type Authentication struct {
+	verifier auth.TokenVerifier
+	logger   logger.Logger
+}
+
+//NewAuthentication constrói um Authentication com suas dependências
+func NewAuthentication(verifier auth.TokenVerifier, log logger.Logger) Authentication {
+	return Authentication{verifier: verifier, logger: log}
+}
+
+//Execute rejeita as requests sem um bearer token válido e adiciona o Principal autenticado ao contexto da request
+func (a Authentication) Execute(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	const logKey = "authentication_middleware"
+
+	principal, err := a.authenticate(r)
+	if err != nil {
+		a.logger.WithFields(logger.Fields{
+			"key":         logKey,
+			"url":         r.URL.Path,
+			"http_method": r.Method,
+			"http_status": http.StatusUnauthorized,
+			"error":       err.Error(),
+		}).Infof("request not authenticated")
+
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		_ = response.TranslateError(err).Send(w, r)
+		return
+	}
+
+	next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), principal)))
+}
+
+func (a Authentication) authenticate(r *http.Request) (auth.Principal, error) {
+	token, ok := auth.BearerToken(r.Header.Get("Authorization"))
+	if !ok {
+		return auth.Principal{}, auth.ErrUnauthenticated
+	}
+
+	return a.verifier.Verify(r.Context(), token)
+}
@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+)
+
+type stubVerifier struct{}
+
+func (stubVerifier) Verify(_ context.Context, token string) (auth.Principal, error) {
+	if token != "valid" {
+		return auth.Principal{}, auth.ErrUnauthenticated
+	}
+
+	return auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04680", []domain.Scope{domain.ScopeAdmin}), nil
+}
+
+func TestAuthentication_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		authorization      string
+		expectedStatusCode int
+		expectedSubject    string
+		expectedNext       bool
+	}{
+		{
+			name:               "Valid bearer token",
+			authorization:      "Bearer valid",
+			expectedStatusCode: http.StatusOK,
+			expectedSubject:    "3c096a40-ccba-4b58-93ed-57379ab04680",
+			expectedNext:       true,
+		},
+		{
+			name:               "Invalid bearer token",
+			authorization:      "Bearer invalid",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "Missing authorization header",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "Authorization without bearer scheme",
+			authorization:      "Basic dXNlcjpwYXNz",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	var authentication = NewAuthentication(stubVerifier{}, logger.LoggerMock{})
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/accounts", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			var (
+				rr      = httptest.NewRecorder()
+				called  bool
+				subject string
+			)
+
+			authentication.Execute(rr, req, func(_ http.ResponseWriter, r *http.Request) {
+				called = true
+				principal, _ := auth.FromContext(r.Context())
+				subject = principal.Subject()
+			})
+
+			if called != tt.expectedNext {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, called, tt.expectedNext)
+			}
+
+			if subject != tt.expectedSubject {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, subject, tt.expectedSubject)
+			}
+
+			if rr.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					rr.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if tt.expectedNext {
+				return
+			}
+
+			if result := rr.Header().Get("WWW-Authenticate"); result != "Bearer" {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, "Bearer")
+			}
+
+			var result struct {
+				Code string `json:"code"`
+			}
+			if err := json.NewDecoder(rr.Body).Decode(&result); err != nil {
+				t.Fatal(err)
+			}
+
+			if result.Code != response.CodeUnauthorized {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.Code, response.CodeUnauthorized)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/pkg/errors"
+)
+
+var errHijackUnsupported = errors.New("response writer does not support hijacking")
+
+//OpenAPIValidator armazena a estrutura de validação das requests e responses da API através da especificação OpenAPI
+type OpenAPIValidator struct {
+	router            routers.Router
+	logger            logger.Logger
+	validateResponses bool
+}
+
+//NewOpenAPIValidator constrói um OpenAPIValidator com suas dependências
+func NewOpenAPIValidator(doc *openapi3.Swagger, log logger.Logger, validateResponses bool) (OpenAPIValidator, error) {
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return OpenAPIValidator{}, errors.Wrap(err, "error building openapi router")
+	}
+
+	return OpenAPIValidator{
+		router:            router,
+		logger:            log,
+		validateResponses: validateResponses,
+	}, nil
+}
+
+//Execute rejeita as requests que não seguem a especificação e registra em log as responses que divergem dela
+//
+//Rotas que não constam na especificação seguem sem validação, o roteador da API é quem responde por elas. As
+//credenciais exigidas pela especificação são verificadas pelo middleware Authentication
+func (o OpenAPIValidator) Execute(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	const logKey = "openapi_validator_middleware"
+
+	route, pathParams, err := o.router.FindRoute(r)
+	if err != nil {
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	var input = &openapi3filter.RequestValidationInput{
+		Request:    r,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    &openapi3filter.Options{AuthenticationFunc: openapi3filter.NoopAuthenticationFunc},
+	}
+
+	if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+		o.logger.WithFields(logger.Fields{
+			"key":         logKey,
+			"http_status": http.StatusBadRequest,
+			"error":       err.Error(),
+		}).Infof("request does not match openapi specification")
+
+		_ = requestError(err).Send(w, r)
+		return
+	}
+
+	if !o.validateResponses {
+		next.ServeHTTP(w, r)
+		return
+	}
+
+	var recorder = &responseRecorder{ResponseWriter: w}
+	next.ServeHTTP(recorder, r)
+
+	if recorder.hijacked {
+		return
+	}
+
+	var responseInput = &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 recorder.statusCode(),
+		Header:                 recorder.Header(),
+		Options:                &openapi3filter.Options{ExcludeResponseBody: !recorder.capture},
+	}
+	responseInput.SetBodyBytes(recorder.body.Bytes())
+
+	if err := openapi3filter.ValidateResponse(context.Background(), responseInput); err != nil {
+		o.logger.WithFields(logger.Fields{
+			"key":         logKey,
+			"url":         r.URL.Path,
+			"http_method": r.Method,
+			"http_status": recorder.statusCode(),
+			"error":       err.Error(),
+		}).Errorf("response does not match openapi specification")
+	}
+}
+
+//requestError converte o erro de validação da request no response de error da API
+func requestError(err error) *response.Error {
+	requestErr, ok := err.(*openapi3filter.RequestError)
+	if !ok {
+		return response.NewErrorWithCode(err, response.CodeInvalidInput, http.StatusBadRequest)
+	}
+
+	if requestErr.Parameter != nil {
+		return response.NewErrorFields(
+			[]validator.FieldError{{Field: requestErr.Parameter.Name, Message: requestErr.Error()}},
+			response.CodeInvalidParameter,
+			http.StatusBadRequest,
+		)
+	}
+
+	if _, ok := requestErr.Err.(*openapi3filter.ParseError); ok {
+		return response.NewErrorWithCode(err, response.CodeInvalidJSON, http.StatusBadRequest)
+	}
+
+	var fieldError = validator.FieldError{Field: "body", Message: requestErr.Error()}
+	if schemaErr, ok := requestErr.Err.(*openapi3.SchemaError); ok {
+		if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+			fieldError.Field = strings.Join(pointer, ".")
+		}
+		fieldError.Message = schemaErr.Reason
+	}
+
+	return response.NewErrorFields([]validator.FieldError{fieldError}, response.CodeInvalidInput, http.StatusBadRequest)
+}
+
+//responseRecorder repassa o response ao cliente guardando uma cópia do corpo JSON para a validação
+type responseRecorder struct {
+	http.ResponseWriter
+	status   int
+	capture  bool
+	hijacked bool
+	body     bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status != 0 {
+		return
+	}
+
+	r.status = status
+	r.capture = strings.Contains(r.Header().Get("Content-Type"), "json")
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.WriteHeader(http.StatusOK)
+	}
+
+	if r.capture {
+		r.body.Write(b)
+	}
+
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *responseRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (r *responseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errHijackUnsupported
+	}
+
+	r.hijacked = true
+	return hijacker.Hijack()
+}
+
+func (r *responseRecorder) statusCode() int {
+	if r.status == 0 {
+		return http.StatusOK
+	}
+
+	return r.status
+}
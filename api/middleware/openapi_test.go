@@ -0,0 +1,237 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gsabadini/go-bank-transfer/api/openapi"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+)
+
+func TestOpenAPIValidator_Execute(t *testing.T) {
+	t.Parallel()
+
+	doc, err := openapi.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validator, err := NewOpenAPIValidator(doc, logger.LoggerMock{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name               string
+		method             string
+		target             string
+		body               string
+		expectedStatusCode int
+		expectedCode       string
+		expectedNext       bool
+	}{
+		{
+			name:               "Valid request body",
+			method:             http.MethodPost,
+			target:             "/v1/accounts",
+			body:               `{"name": "Test", "cpf": "070.910.584-24", "balance": 100}`,
+			expectedStatusCode: http.StatusCreated,
+			expectedNext:       true,
+		},
+		{
+			name:               "Valid query parameters",
+			method:             http.MethodGet,
+			target:             "/v1/transfers?limit=10&sort=-amount&status=completed",
+			expectedStatusCode: http.StatusCreated,
+			expectedNext:       true,
+		},
+		{
+			name:               "Route not documented",
+			method:             http.MethodGet,
+			target:             "/v1/unknown",
+			expectedStatusCode: http.StatusCreated,
+			expectedNext:       true,
+		},
+		{
+			name:               "Request body missing required field",
+			method:             http.MethodPost,
+			target:             "/v1/accounts",
+			body:               `{"name": "Test", "cpf": "070.910.584-24"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedCode:       response.CodeInvalidInput,
+		},
+		{
+			name:               "Request body with invalid type",
+			method:             http.MethodPost,
+			target:             "/v1/transfers",
+			body:               `{"account_origin_id": "3c096a40-ccba-4b58-93ed-57379ab04680", "account_destination_id": "3c096a40-ccba-4b58-93ed-57379ab04681", "amount": "100"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedCode:       response.CodeInvalidInput,
+		},
+		{
+			name:               "Malformed request body",
+			method:             http.MethodPost,
+			target:             "/v1/transfers",
+			body:               `{"amount":`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedCode:       response.CodeInvalidJSON,
+		},
+		{
+			name:               "Query parameter out of range",
+			method:             http.MethodGet,
+			target:             "/v1/transfers?limit=1000",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedCode:       response.CodeInvalidParameter,
+		},
+		{
+			name:               "Query parameter not in enum",
+			method:             http.MethodGet,
+			target:             "/v1/transfers?sort=name",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedCode:       response.CodeInvalidParameter,
+		},
+		{
+			name:               "Path parameter with invalid format",
+			method:             http.MethodGet,
+			target:             "/v1/accounts/123",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedCode:       response.CodeInvalidParameter,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequest(tt.method, tt.target, bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+
+			var (
+				rr     = httptest.NewRecorder()
+				called bool
+			)
+
+			validator.Execute(rr, req, func(w http.ResponseWriter, _ *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusCreated)
+			})
+
+			if called != tt.expectedNext {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, called, tt.expectedNext)
+			}
+
+			if rr.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					rr.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if tt.expectedCode == "" {
+				return
+			}
+
+			var result struct {
+				Code string `json:"code"`
+			}
+			if err := json.NewDecoder(rr.Body).Decode(&result); err != nil {
+				t.Fatal(err)
+			}
+
+			if result.Code != tt.expectedCode {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.Code, tt.expectedCode)
+			}
+		})
+	}
+}
+
+type loggerErrorSpy struct {
+	logger.LoggerMock
+	errors *int
+}
+
+func (l loggerErrorSpy) WithFields(_ logger.Fields) logger.Logger {
+	return l
+}
+
+func (l loggerErrorSpy) Errorf(_ string, _ ...interface{}) {
+	*l.errors++
+}
+
+func TestOpenAPIValidator_ExecuteResponse(t *testing.T) {
+	t.Parallel()
+
+	doc, err := openapi.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedErrors int
+	}{
+		{
+			name:           "Response matching specification",
+			body:           `{"data": [], "next_cursor": ""}`,
+			expectedErrors: 0,
+		},
+		{
+			name:           "Response not matching specification",
+			body:           `{"data": "invalid"}`,
+			expectedErrors: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var errors int
+			validator, err := NewOpenAPIValidator(doc, loggerErrorSpy{errors: &errors}, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/v1/accounts", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var rr = httptest.NewRecorder()
+			validator.Execute(rr, req, func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			if rr.Code != http.StatusOK {
+				t.Errorf(
+					"O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					rr.Code,
+					http.StatusOK,
+				)
+			}
+
+			if rr.Body.String() != tt.body {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, rr.Body.String(), tt.body)
+			}
+
+			if errors != tt.expectedErrors {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, errors, tt.expectedErrors)
+			}
+		})
+	}
+}
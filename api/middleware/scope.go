@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+)
+
+//Scope armazena a estrutura de autorização das requests através do escopo exigido pela route
+type Scope struct {
+	scope  domain.Scope
+	logger logger.Logger
+}
+
+//NewScope constrói um Scope com suas dependências, deve ser encadeado após o middleware Authentication
+func NewScope(scope domain.Scope, log logger.Logger) Scope {
+	return Scope{scope: scope, logger: log}
+}
+
+//Execute rejeita as requests cujo Principal não possui o escopo exigido
+func (s Scope) Execute(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	const logKey = "scope_middleware"
+
+	if err := auth.RequireScope(r.Context(), s.scope); err != nil {
+		s.logger.WithFields(logger.Fields{
+			"key":         logKey,
+			"url":         r.URL.Path,
+			"http_method": r.Method,
+			"http_status": http.StatusForbidden,
+			"scope":       s.scope.String(),
+			"error":       err.Error(),
+		}).Infof("request without the required scope")
+
+		_ = response.TranslateError(err).Send(w, r)
+		return
+	}
+
+	next.ServeHTTP(w, r)
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/response"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+)
+
+func TestScope_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		expectedStatusCode int
+		expectedNext       bool
+	}{
+		{
+			name:               "Scope granted",
+			ctx:                auth.WithPrincipal(context.Background(), auth.NewPrincipal("", []domain.Scope{domain.ScopeTransfersWrite})),
+			expectedStatusCode: http.StatusOK,
+			expectedNext:       true,
+		},
+		{
+			name:               "Admin scope",
+			ctx:                auth.WithPrincipal(context.Background(), auth.NewPrincipal("", []domain.Scope{domain.ScopeAdmin})),
+			expectedStatusCode: http.StatusOK,
+			expectedNext:       true,
+		},
+		{
+			name:               "Scope not granted",
+			ctx:                auth.WithPrincipal(context.Background(), auth.NewPrincipal("", []domain.Scope{domain.ScopeTransfersRead})),
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Request not authenticated",
+			ctx:                context.Background(),
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	var scope = NewScope(domain.ScopeTransfersWrite, logger.LoggerMock{})
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				req    = httptest.NewRequest(http.MethodPost, "/v1/transfers", nil).WithContext(tt.ctx)
+				rr     = httptest.NewRecorder()
+				called bool
+			)
+
+			scope.Execute(rr, req, func(_ http.ResponseWriter, _ *http.Request) {
+				called = true
+			})
+
+			if called != tt.expectedNext {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, called, tt.expectedNext)
+			}
+
+			if rr.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					rr.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if tt.expectedNext {
+				return
+			}
+
+			var result struct {
+				Code string `json:"code"`
+			}
+			if err := json.NewDecoder(rr.Body).Decode(&result); err != nil {
+				t.Fatal(err)
+			}
+
+			if result.Code != response.CodeInsufficientScope {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.Code, response.CodeInsufficientScope)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	doc, err := Load()
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Result: '%v' | Expected: '%v'", "Valid specification", err, nil)
+	}
+
+	if len(doc.Paths) == 0 {
+		t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", "Paths defined", len(doc.Paths), "> 0")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	if !json.Valid(JSON()) {
+		t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", "Valid JSON", false, true)
+	}
+}
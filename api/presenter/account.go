@@ -1,6 +1,8 @@
 package presenter
 
 import (
+	"time"
+
 	"github.com/gsabadini/go-bank-transfer/domain"
 	"github.com/gsabadini/go-bank-transfer/usecase"
 )
@@ -17,30 +19,37 @@ func (a accountPresenter) Output(account domain.Account) usecase.AccountOutput {
 	return usecase.AccountOutput{
 		ID:        account.ID().String(),
 		Name:      account.Name(),
-		CPF:       account.CPF(),
+		TaxID:     account.TaxID(),
+		TaxIDType: account.TaxIDType().String(),
 		Balance:   account.Balance().Float64(),
 		CreatedAt: account.CreatedAt(),
 	}
 }
 
 //OutputList
-func (a accountPresenter) OutputList(accounts []domain.Account) []usecase.AccountOutput {
+func (a accountPresenter) OutputList(accounts []domain.Account, next domain.Cursor) usecase.AccountListOutput {
 	var output = make([]usecase.AccountOutput, 0)
 
 	for _, account := range accounts {
 		output = append(output, usecase.AccountOutput{
 			ID:        account.ID().String(),
 			Name:      account.Name(),
-			CPF:       account.CPF(),
+			TaxID:     account.TaxID(),
+			TaxIDType: account.TaxIDType().String(),
 			Balance:   account.Balance().Float64(),
 			CreatedAt: account.CreatedAt(),
 		})
 	}
 
-	return output
+	return usecase.AccountListOutput{Data: output, NextCursor: next.Encode()}
 }
 
 //OutputBalance
 func (a accountPresenter) OutputBalance(balance domain.Money) usecase.AccountBalanceOutput {
 	return usecase.AccountBalanceOutput{Balance: balance.Float64()}
 }
+
+//OutputBalanceAt
+func (a accountPresenter) OutputBalanceAt(balance domain.Money, at time.Time) usecase.AccountBalanceOutput {
+	return usecase.AccountBalanceOutput{Balance: balance.Float64(), At: &at}
+}
@@ -0,0 +1,53 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type apiKeyPresenter struct{}
+
+//NewAPIKeyPresenter
+func NewAPIKeyPresenter() apiKeyPresenter {
+	return apiKeyPresenter{}
+}
+
+//Output
+func (a apiKeyPresenter) Output(key domain.APIKey) usecase.APIKeyOutput {
+	var scopes = make([]string, 0, len(key.Scopes()))
+	for _, scope := range key.Scopes() {
+		scopes = append(scopes, scope.String())
+	}
+
+	return usecase.APIKeyOutput{
+		ID:         key.ID().String(),
+		Name:       key.Name(),
+		Prefix:     key.Prefix(),
+		Scopes:     scopes,
+		AccountID:  key.AccountID().String(),
+		ExpiresAt:  optionalTime(key.ExpiresAt()),
+		LastUsedAt: optionalTime(key.LastUsedAt()),
+		RevokedAt:  optionalTime(key.RevokedAt()),
+		CreatedAt:  key.CreatedAt(),
+	}
+}
+
+//OutputList
+func (a apiKeyPresenter) OutputList(keys []domain.APIKey) []usecase.APIKeyOutput {
+	var output = make([]usecase.APIKeyOutput, 0)
+	for _, key := range keys {
+		output = append(output, a.Output(key))
+	}
+
+	return output
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
@@ -0,0 +1,24 @@
+package presenter
+
+import (
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type balanceCorrectionPresenter struct{}
+
+//NewBalanceCorrectionPresenter
+func NewBalanceCorrectionPresenter() balanceCorrectionPresenter {
+	return balanceCorrectionPresenter{}
+}
+
+//Output
+func (b balanceCorrectionPresenter) Output(correction domain.BalanceCorrection) usecase.BalanceCorrectionOutput {
+	return usecase.BalanceCorrectionOutput{
+		ID:        correction.ID().String(),
+		AccountID: correction.AccountID().String(),
+		Amount:    correction.Amount().Float64(),
+		Status:    correction.Status().String(),
+		CreatedAt: correction.CreatedAt(),
+	}
+}
@@ -0,0 +1,34 @@
+package presenter
+
+import (
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type dailyClosingPresenter struct{}
+
+//NewDailyClosingPresenter
+func NewDailyClosingPresenter() dailyClosingPresenter {
+	return dailyClosingPresenter{}
+}
+
+//Output
+func (d dailyClosingPresenter) Output(closing domain.DailyClosing) usecase.DailyClosingOutput {
+	var discrepancies = make([]usecase.BalanceDiscrepancyOutput, 0)
+
+	for _, discrepancy := range closing.Discrepancies() {
+		discrepancies = append(discrepancies, usecase.BalanceDiscrepancyOutput{
+			AccountID:  discrepancy.AccountID().String(),
+			Expected:   discrepancy.Expected().Float64(),
+			Closing:    discrepancy.Closing().Float64(),
+			Difference: discrepancy.Difference().Float64(),
+		})
+	}
+
+	return usecase.DailyClosingOutput{
+		Date:          closing.Date(),
+		Closed:        len(closing.Snapshots()),
+		AlreadyClosed: len(closing.AlreadyClosed()),
+		Discrepancies: discrepancies,
+	}
+}
@@ -0,0 +1,39 @@
+package presenter
+
+import (
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type oauthClientPresenter struct{}
+
+//NewOAuthClientPresenter
+func NewOAuthClientPresenter() oauthClientPresenter {
+	return oauthClientPresenter{}
+}
+
+//Output
+func (o oauthClientPresenter) Output(client domain.OAuthClient) usecase.OAuthClientOutput {
+	var scopes = make([]string, 0, len(client.Scopes()))
+	for _, scope := range client.Scopes() {
+		scopes = append(scopes, scope.String())
+	}
+
+	return usecase.OAuthClientOutput{
+		ClientID:  client.ID().String(),
+		Name:      client.Name(),
+		Scopes:    scopes,
+		AccountID: client.AccountID().String(),
+		CreatedAt: client.CreatedAt(),
+	}
+}
+
+//OutputList
+func (o oauthClientPresenter) OutputList(clients []domain.OAuthClient) []usecase.OAuthClientOutput {
+	var output = make([]usecase.OAuthClientOutput, 0)
+	for _, client := range clients {
+		output = append(output, o.Output(client))
+	}
+
+	return output
+}
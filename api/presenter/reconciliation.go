@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type reconciliationPresenter struct{}
+
+//NewReconciliationPresenter
+func NewReconciliationPresenter() reconciliationPresenter {
+	return reconciliationPresenter{}
+}
+
+//Output
+func (r reconciliationPresenter) Output(reconciliation domain.Reconciliation) usecase.ReconciliationOutput {
+	var items = make([]usecase.ReconciliationItemOutput, 0)
+
+	for _, item := range reconciliation.Items() {
+		items = append(items, usecase.ReconciliationItemOutput{
+			AccountID:       item.AccountID().String(),
+			StoredBalance:   item.Stored().Float64(),
+			ComputedBalance: item.Computed().Float64(),
+			Difference:      item.Difference().Float64(),
+			CorrectionID:    item.CorrectionID().String(),
+		})
+	}
+
+	return usecase.ReconciliationOutput{
+		GeneratedAt: reconciliation.GeneratedAt(),
+		Checked:     reconciliation.Checked(),
+		Divergent:   len(items),
+		Items:       items,
+	}
+}
@@ -0,0 +1,27 @@
+package presenter
+
+import (
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type signingKeyPresenter struct{}
+
+//NewSigningKeyPresenter
+func NewSigningKeyPresenter() signingKeyPresenter {
+	return signingKeyPresenter{}
+}
+
+//OutputList
+func (s signingKeyPresenter) OutputList(keys []domain.SigningKey) []usecase.SigningKeyOutput {
+	var output = make([]usecase.SigningKeyOutput, 0)
+	for _, key := range keys {
+		output = append(output, usecase.SigningKeyOutput{
+			ID:         key.ID().String(),
+			PrivateKey: key.PrivateKey(),
+			CreatedAt:  key.CreatedAt(),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,38 @@
+package presenter
+
+import (
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type statementPresenter struct{}
+
+//NewStatementPresenter
+func NewStatementPresenter() statementPresenter {
+	return statementPresenter{}
+}
+
+//Output
+func (s statementPresenter) Output(statement domain.Statement) usecase.StatementOutput {
+	var entries = make([]usecase.StatementEntryOutput, 0)
+
+	for _, entry := range statement.Entries() {
+		entries = append(entries, usecase.StatementEntryOutput{
+			ID:                    entry.ID(),
+			Type:                  entry.Type().String(),
+			CounterpartyAccountID: entry.CounterpartyID().String(),
+			Amount:                entry.Amount().Float64(),
+			Balance:               entry.Balance().Float64(),
+			CreatedAt:             entry.CreatedAt(),
+		})
+	}
+
+	return usecase.StatementOutput{
+		AccountID:      statement.AccountID().String(),
+		From:           statement.From(),
+		To:             statement.To(),
+		OpeningBalance: statement.OpeningBalance().Float64(),
+		ClosingBalance: statement.ClosingBalance().Float64(),
+		Entries:        entries,
+	}
+}
@@ -19,12 +19,13 @@ func (tp transferPresenter) Output(transfer domain.Transfer) usecase.TransferOut
 		AccountOriginID:      transfer.AccountOriginID().String(),
 		AccountDestinationID: transfer.AccountDestinationID().String(),
 		Amount:               transfer.Amount().Float64(),
+		Status:               transfer.Status().String(),
 		CreatedAt:            transfer.CreatedAt(),
 	}
 }
 
 //OutputList
-func (tp transferPresenter) OutputList(transfers []domain.Transfer) []usecase.TransferOutput {
+func (tp transferPresenter) OutputList(transfers []domain.Transfer, next domain.Cursor) usecase.TransferListOutput {
 	var output = make([]usecase.TransferOutput, 0)
 
 	for _, transfer := range transfers {
@@ -33,9 +34,10 @@ func (tp transferPresenter) OutputList(transfers []domain.Transfer) []usecase.Tr
 			AccountOriginID:      transfer.AccountOriginID().String(),
 			AccountDestinationID: transfer.AccountDestinationID().String(),
 			Amount:               transfer.Amount().Float64(),
+			Status:               transfer.Status().String(),
 			CreatedAt:            transfer.CreatedAt(),
 		})
 	}
 
-	return output
+	return usecase.TransferListOutput{Data: output, NextCursor: next.Encode()}
 }
@@ -0,0 +1,71 @@
+package presenter
+
+import (
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type webhookPresenter struct{}
+
+//NewWebhookPresenter
+func NewWebhookPresenter() webhookPresenter {
+	return webhookPresenter{}
+}
+
+//Output
+func (w webhookPresenter) Output(subscription domain.WebhookSubscription) usecase.WebhookOutput {
+	var eventTypes = make([]string, 0, len(subscription.EventTypes()))
+	for _, eventType := range subscription.EventTypes() {
+		eventTypes = append(eventTypes, eventType.String())
+	}
+
+	return usecase.WebhookOutput{
+		ID:         subscription.ID().String(),
+		URL:        subscription.URL(),
+		EventTypes: eventTypes,
+		AccountID:  subscription.AccountID().String(),
+		CreatedAt:  subscription.CreatedAt(),
+	}
+}
+
+//OutputList
+func (w webhookPresenter) OutputList(subscriptions []domain.WebhookSubscription) []usecase.WebhookOutput {
+	var output = make([]usecase.WebhookOutput, 0)
+	for _, subscription := range subscriptions {
+		output = append(output, w.Output(subscription))
+	}
+
+	return output
+}
+
+//OutputDelivery
+func (w webhookPresenter) OutputDelivery(delivery domain.WebhookDelivery) usecase.WebhookDeliveryOutput {
+	var output = usecase.WebhookDeliveryOutput{
+		ID:             delivery.ID().String(),
+		SubscriptionID: delivery.SubscriptionID().String(),
+		MessageID:      delivery.MessageID().String(),
+		EventType:      delivery.EventType().String(),
+		Status:         delivery.Status().String(),
+		Attempts:       delivery.Attempts(),
+		LastError:      delivery.LastError(),
+		CreatedAt:      delivery.CreatedAt(),
+		UpdatedAt:      delivery.UpdatedAt(),
+	}
+
+	if delivery.Status() == domain.WebhookDeliveryStatusPending {
+		var nextAttemptAt = delivery.NextAttemptAt()
+		output.NextAttemptAt = &nextAttemptAt
+	}
+
+	return output
+}
+
+//OutputDeliveries
+func (w webhookPresenter) OutputDeliveries(deliveries []domain.WebhookDelivery) []usecase.WebhookDeliveryOutput {
+	var output = make([]usecase.WebhookDeliveryOutput, 0)
+	for _, delivery := range deliveries {
+		output = append(output, w.OutputDelivery(delivery))
+	}
+
+	return output
+}
@@ -0,0 +1,98 @@
+package response
+
+import "net/http"
+
+//Registro de códigos de erro da API.
+//
+//Os códigos são estáveis: uma vez publicados não são renomeados nem reaproveitados, de modo que os clientes
+//possam tratar falhas sem depender das mensagens. Novos códigos devem ser adicionados às constantes abaixo e
+//ao problemTitles, que define o título utilizado no formato problem+json (RFC 7807). O campo type dos
+//problemas é derivado do código através de problemType.
+const (
+	//CodeInvalidJSON indica que o corpo da requisição não é um JSON válido
+	CodeInvalidJSON = "invalid_json"
+
+	//CodeInvalidInput indica que o corpo da requisição não passou nas validações
+	CodeInvalidInput = "invalid_input"
+
+	//CodeInvalidParameter indica que um parâmetro de rota ou query string é inválido
+	CodeInvalidParameter = "invalid_parameter"
+
+	//CodeNotFound indica que o recurso solicitado não existe
+	CodeNotFound = "not_found"
+
+	//CodeAccountAlreadyExists indica a tentativa de criar uma Account com um documento já cadastrado
+	CodeAccountAlreadyExists = "account_already_exists"
+
+	//CodeAccountOriginNotFound indica que a Account de origem de uma Transfer não existe
+	CodeAccountOriginNotFound = "account_origin_not_found"
+
+	//CodeAccountDestinationNotFound indica que a Account de destino de uma Transfer não existe
+	CodeAccountDestinationNotFound = "account_destination_not_found"
+
+	//CodeInsufficientBalance indica que a Account de origem não possui saldo suficiente
+	CodeInsufficientBalance = "insufficient_balance"
+
+	//CodeInvalidQuery indica que a consulta GraphQL não é válida para o schema
+	CodeInvalidQuery = "invalid_query"
+
+	//CodeQueryLimitExceeded indica que a consulta GraphQL excede a profundidade ou a complexidade máximas
+	CodeQueryLimitExceeded = "query_limit_exceeded"
+
+	//CodeUnauthorized indica que a request não possui credenciais válidas
+	CodeUnauthorized = "unauthorized"
+
+	//CodeForbidden indica que as credenciais não permitem operar o recurso solicitado
+	CodeForbidden = "forbidden"
+
+	//CodeInsufficientScope indica que as credenciais não concedem o escopo exigido pela operação
+	CodeInsufficientScope = "insufficient_scope"
+
+	//CodeAPIKeyInactive indica a tentativa de rotacionar uma APIKey revogada ou expirada
+	CodeAPIKeyInactive = "api_key_inactive"
+
+	//CodeTimeout indica que a operação excedeu o tempo limite
+	CodeTimeout = "timeout"
+
+	//CodeStreamingUnsupported indica um stream requisitado em uma conexão que não permite assumi-la, como no HTTP/2
+	CodeStreamingUnsupported = "streaming_unsupported"
+
+	//CodeInternalError indica um erro inesperado
+	CodeInternalError = "internal_error"
+)
+
+//problemTypeBase é o prefixo da URI que identifica o tipo de um problema
+const problemTypeBase = "urn:go-bank-transfer:problem:"
+
+//problemTitles armazena o resumo legível de cada código de erro, que não varia entre ocorrências
+var problemTitles = map[string]string{
+	CodeInvalidJSON:                "Malformed JSON body",
+	CodeInvalidInput:               "Invalid request body",
+	CodeInvalidParameter:           "Invalid request parameter",
+	CodeNotFound:                   "Resource not found",
+	CodeAccountAlreadyExists:       "Account already exists",
+	CodeAccountOriginNotFound:      "Origin account not found",
+	CodeAccountDestinationNotFound: "Destination account not found",
+	CodeInsufficientBalance:        "Insufficient balance",
+	CodeInvalidQuery:               "Invalid GraphQL query",
+	CodeQueryLimitExceeded:         "GraphQL query limit exceeded",
+	CodeUnauthorized:               "Authentication required",
+	CodeForbidden:                  "Access forbidden",
+	CodeInsufficientScope:          "Insufficient scope",
+	CodeAPIKeyInactive:             "API key inactive",
+	CodeTimeout:                    "Request timeout",
+	CodeStreamingUnsupported:       "Streaming requires HTTP/1.1",
+	CodeInternalError:              "Internal server error",
+}
+
+func problemType(code string) string {
+	return problemTypeBase + code
+}
+
+func problemTitle(code string, status int) string {
+	if title, ok := problemTitles[code]; ok {
+		return title
+	}
+
+	return http.StatusText(status)
+}
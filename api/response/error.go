@@ -2,8 +2,11 @@ package response
 
 import (
 	"encoding/json"
-	"github.com/pkg/errors"
 	"net/http"
+
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+
+	"github.com/pkg/errors"
 )
 
 var (
@@ -12,17 +15,28 @@ var (
 
 //Error armazena a estrutura de response com error da API
 type Error struct {
-	statusCode int
-	Errors     []string `json:"errors"`
+	statusCode    int
+	invalidParams []InvalidParam
+	Errors        []string `json:"errors"`
+	Code          string   `json:"code,omitempty"`
 }
 
-//Send envia um response de error
-func (e Error) Send(w http.ResponseWriter) error {
+//Send envia um response de error no formato negociado através do header Accept da request
+func (e Error) Send(w http.ResponseWriter, r *http.Request) error {
+	if acceptsProblem(r) {
+		return e.problem(r).Send(w)
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(e.statusCode)
 	return json.NewEncoder(w).Encode(e)
 }
 
+//StatusCode retorna o HTTP status code do response de error
+func (e Error) StatusCode() int {
+	return e.statusCode
+}
+
 //NewError constrói uma estrutura de response com error
 func NewError(err error, status int) *Error {
 	return &Error{
@@ -31,6 +45,15 @@ func NewError(err error, status int) *Error {
 	}
 }
 
+//NewErrorWithCode constrói uma estrutura de response com error e um código de erro estável
+func NewErrorWithCode(err error, code string, status int) *Error {
+	return &Error{
+		statusCode: status,
+		Errors:     []string{err.Error()},
+		Code:       code,
+	}
+}
+
 //NewErrorMessage constrói uma estrutura de response com mensagens de error
 func NewErrorMessage(messages []string, status int) *Error {
 	return &Error{
@@ -38,3 +61,26 @@ func NewErrorMessage(messages []string, status int) *Error {
 		Errors:     messages,
 	}
 }
+
+//NewErrorFields constrói uma estrutura de response com os erros de validação de cada campo da entrada
+func NewErrorFields(fieldErrors []validator.FieldError, code string, status int) *Error {
+	var (
+		messages      = make([]string, 0, len(fieldErrors))
+		invalidParams = make([]InvalidParam, 0, len(fieldErrors))
+	)
+
+	for _, fieldError := range fieldErrors {
+		messages = append(messages, fieldError.Message)
+		invalidParams = append(invalidParams, InvalidParam{
+			Name:   fieldError.Field,
+			Reason: fieldError.Message,
+		})
+	}
+
+	return &Error{
+		statusCode:    status,
+		invalidParams: invalidParams,
+		Errors:        messages,
+		Code:          code,
+	}
+}
@@ -0,0 +1,57 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+//Códigos de erro do endpoint de token definidos pela RFC 6749, seção 5.2
+const (
+	OAuthInvalidRequest       = "invalid_request"
+	OAuthInvalidClient        = "invalid_client"
+	OAuthInvalidScope         = "invalid_scope"
+	OAuthUnsupportedGrantType = "unsupported_grant_type"
+	OAuthServerError          = "server_error"
+)
+
+//OAuthError armazena a estrutura de response com error do endpoint de token, no formato da RFC 6749
+type OAuthError struct {
+	statusCode  int
+	challenge   string
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+//NewOAuthError constrói uma estrutura de response com error do endpoint de token
+func NewOAuthError(code, description string, status int) *OAuthError {
+	return &OAuthError{
+		statusCode:  status,
+		Code:        code,
+		Description: description,
+	}
+}
+
+//WithChallenge informa o header WWW-Authenticate enviado com o response, exigido quando o cliente se autenticou
+//pelo header Authorization
+func (e *OAuthError) WithChallenge(challenge string) *OAuthError {
+	e.challenge = challenge
+	return e
+}
+
+//StatusCode retorna o HTTP status code do response de error
+func (e OAuthError) StatusCode() int {
+	return e.statusCode
+}
+
+//Send envia o response de error, que assim como os tokens não deve ser armazenado em cache
+func (e OAuthError) Send(w http.ResponseWriter) error {
+	if e.challenge != "" {
+		w.Header().Set("WWW-Authenticate", e.challenge)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(e.statusCode)
+	return json.NewEncoder(w).Encode(e)
+}
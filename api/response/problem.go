@@ -0,0 +1,73 @@
+package response
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+//ProblemContentType é o media type de responses de error no formato RFC 7807
+const ProblemContentType = "application/problem+json"
+
+//Problem armazena a estrutura de response de error no formato RFC 7807 (Problem Details for HTTP APIs)
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	Code          string         `json:"code,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid_params,omitempty"`
+}
+
+//InvalidParam armazena o motivo pelo qual um parâmetro da request é inválido
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+//Send envia um response de error no formato problem+json
+func (p Problem) Send(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	return json.NewEncoder(w).Encode(p)
+}
+
+func (e Error) problem(r *http.Request) Problem {
+	var code = e.Code
+	if code == "" {
+		code = CodeInternalError
+	}
+
+	return Problem{
+		Type:          problemType(code),
+		Title:         problemTitle(code, e.statusCode),
+		Status:        e.statusCode,
+		Detail:        strings.Join(e.Errors, "; "),
+		Instance:      r.URL.RequestURI(),
+		Code:          code,
+		InvalidParams: e.invalidParams,
+	}
+}
+
+//acceptsProblem verifica se o cliente aceita responses de error no formato problem+json
+func acceptsProblem(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil || mediaType != ProblemContentType {
+			continue
+		}
+
+		if q, ok := params["q"]; ok {
+			if weight, err := strconv.ParseFloat(q, 64); err != nil || weight <= 0 {
+				continue
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
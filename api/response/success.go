@@ -1,8 +1,12 @@
 package response
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
+	"strings"
 )
 
 //Success armazena a estrutura de response com sucesso da API
@@ -18,6 +22,27 @@ func (r *Success) Send(w http.ResponseWriter) error {
 	return json.NewEncoder(w).Encode(r.result)
 }
 
+//SendWithETag envia um response de sucesso com ETag, respondendo 304 quando o If-None-Match da request corresponde
+func (r *Success) SendWithETag(w http.ResponseWriter, req *http.Request) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(r.result); err != nil {
+		return err
+	}
+
+	var etag = newETag(body.Bytes())
+	w.Header().Set("ETag", etag)
+
+	if matchesETag(req.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(r.statusCode)
+	_, err := w.Write(body.Bytes())
+	return err
+}
+
 //NewSuccess constrói uma estrutura de response com sucesso
 func NewSuccess(result interface{}, status int) *Success {
 	return &Success{
@@ -25,3 +50,20 @@ func NewSuccess(result interface{}, status int) *Success {
 		result:     result,
 	}
 }
+
+func newETag(body []byte) string {
+	var sum = sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+//matchesETag aplica a comparação fraca do If-None-Match (RFC 7232), aceitando uma lista de ETags ou "*"
+func matchesETag(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
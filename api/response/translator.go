@@ -0,0 +1,110 @@
+package response
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+var (
+	errTimeout = errors.New("request timeout")
+
+	//errInternal substitui a mensagem dos erros desconhecidos, que pode trazer detalhes do database ou de
+	//dependências, o erro original fica apenas no log registrado pela action
+	errInternal = errors.New("internal server error")
+)
+
+//errorTranslation relaciona um erro da aplicação a um HTTP status code e a um código de erro estável
+type errorTranslation struct {
+	match      func(error) (error, bool)
+	statusCode int
+	code       string
+}
+
+//errorCatalog armazena as traduções de erros, os erros mais específicos devem vir antes dos genéricos
+var errorCatalog = []errorTranslation{
+	{
+		match:      is(auth.ErrUnauthenticated),
+		statusCode: http.StatusUnauthorized,
+		code:       CodeUnauthorized,
+	},
+	{
+		match:      is(auth.ErrForbidden),
+		statusCode: http.StatusForbidden,
+		code:       CodeForbidden,
+	},
+	{
+		match:      is(auth.ErrInsufficientScope),
+		statusCode: http.StatusForbidden,
+		code:       CodeInsufficientScope,
+	},
+	{
+		match:      is(domain.ErrAPIKeyInactive),
+		statusCode: http.StatusConflict,
+		code:       CodeAPIKeyInactive,
+	},
+	{
+		match:      is(domain.ErrAccountAlreadyExists),
+		statusCode: http.StatusConflict,
+		code:       CodeAccountAlreadyExists,
+	},
+	{
+		match:      is(domain.ErrAccountOriginNotFound),
+		statusCode: http.StatusUnprocessableEntity,
+		code:       CodeAccountOriginNotFound,
+	},
+	{
+		match:      is(domain.ErrAccountDestinationNotFound),
+		statusCode: http.StatusUnprocessableEntity,
+		code:       CodeAccountDestinationNotFound,
+	},
+	{
+		match:      is(domain.ErrInsufficientBalance),
+		statusCode: http.StatusUnprocessableEntity,
+		code:       CodeInsufficientBalance,
+	},
+	{
+		match:      is(domain.ErrNotFound),
+		statusCode: http.StatusNotFound,
+		code:       CodeNotFound,
+	},
+	{
+		match:      is(ErrParameterInvalid),
+		statusCode: http.StatusBadRequest,
+		code:       CodeInvalidParameter,
+	},
+	{
+		match:      isTimeout,
+		statusCode: http.StatusGatewayTimeout,
+		code:       CodeTimeout,
+	},
+}
+
+//TranslateError constrói um response de error a partir do catálogo de erros, utilizando 500 com uma mensagem genérica
+//para erros desconhecidos
+func TranslateError(err error) *Error {
+	for _, translation := range errorCatalog {
+		if target, ok := translation.match(err); ok {
+			return NewErrorWithCode(target, translation.code, translation.statusCode)
+		}
+	}
+
+	return NewErrorWithCode(errInternal, CodeInternalError, http.StatusInternalServerError)
+}
+
+func is(target error) func(error) (error, bool) {
+	return func(err error) (error, bool) {
+		return target, errors.Is(err, target)
+	}
+}
+
+func isTimeout(err error) (error, bool) {
+	var timeout interface{ Timeout() bool }
+	if errors.As(err, &timeout) && timeout.Timeout() {
+		return errTimeout, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,153 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/input"
+	"github.com/gsabadini/go-bank-transfer/api/rpc/pb"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+//Account armazena as dependências do serviço gRPC de Account
+type Account struct {
+	uc        usecase.AccountUseCase
+	balanceUC usecase.BalanceUseCase
+	validator validator.Validator
+}
+
+//NewAccount constrói um Account com suas dependências
+func NewAccount(uc usecase.AccountUseCase, balanceUC usecase.BalanceUseCase, v validator.Validator) Account {
+	return Account{uc: uc, balanceUC: balanceUC, validator: v}
+}
+
+//CreateAccount cria uma Account, validando a entrada como a API HTTP
+func (a Account) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.Account, error) {
+	var inputAccount = input.Account{
+		Name:    req.GetName(),
+		Type:    holderType(req.GetType()),
+		CPF:     req.GetCpf(),
+		CNPJ:    req.GetCnpj(),
+		Balance: req.GetBalance(),
+	}
+
+	if errs := inputAccount.Validate(a.validator); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+
+	output, err := a.uc.Store(
+		ctx,
+		inputAccount.Name,
+		inputAccount.TaxID(),
+		inputAccount.HolderType().TaxIDType(),
+		domain.Money(inputAccount.Balance),
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toAccount(output), nil
+}
+
+//ListAccounts retorna uma página de Account, o saldo das Account que o Principal não pode operar é omitido
+func (a Account) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	pagination, errs := parsePagination(req.GetLimit(), req.GetCursor())
+	if len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+
+	output, err := a.uc.FindAll(ctx, pagination)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var (
+		principal, _ = auth.FromContext(ctx)
+		res          = &pb.ListAccountsResponse{Data: make([]*pb.Account, 0, len(output.Data)), NextCursor: output.NextCursor}
+	)
+
+	for _, account := range output.Data {
+		var item = toAccount(account)
+		if !principal.CanAccessAccount(domain.AccountID(account.ID)) {
+			item.Balance = 0
+		}
+
+		res.Data = append(res.Data, item)
+	}
+
+	return res, nil
+}
+
+//GetAccount retorna uma Account, restrito ao titular da Account e ao escopo admin
+func (a Account) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.Account, error) {
+	ID, errs := parseAccountID("account_id", req.GetAccountId())
+	if len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+
+	if err := auth.AuthorizeAccount(ctx, ID); err != nil {
+		return nil, translateError(err)
+	}
+
+	output, err := a.uc.FindByID(ctx, ID)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toAccount(output), nil
+}
+
+//GetBalance retorna o saldo atual de uma Account, ou o saldo no instante at quando informado
+func (a Account) GetBalance(ctx context.Context, req *pb.GetBalanceRequest) (*pb.Balance, error) {
+	ID, errs := parseAccountID("account_id", req.GetAccountId())
+	if len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+
+	if err := auth.AuthorizeAccount(ctx, ID); err != nil {
+		return nil, translateError(err)
+	}
+
+	var (
+		output usecase.AccountBalanceOutput
+		err    error
+	)
+
+	if req.GetAt() != nil {
+		at, tsErr := ptypes.Timestamp(req.GetAt())
+		if tsErr != nil {
+			return nil, invalidArgument([]validator.FieldError{{Field: "at", Message: "at is invalid"}})
+		}
+
+		output, err = a.balanceUC.FindAt(ctx, ID, at)
+	} else {
+		output, err = a.uc.FindBalance(ctx, ID)
+	}
+
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var res = &pb.Balance{Balance: cents(output.Balance)}
+	if output.At != nil {
+		res.At = toTimestamp(*output.At)
+	}
+
+	return res, nil
+}
+
+//holderType converte o HolderType do protobuf para o tipo de titular da API HTTP
+func holderType(value pb.HolderType) string {
+	switch value {
+	case pb.HolderType_HOLDER_TYPE_INDIVIDUAL:
+		return string(domain.HolderTypeIndividual)
+	case pb.HolderType_HOLDER_TYPE_COMPANY:
+		return string(domain.HolderTypeCompany)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,232 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/rpc/pb"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockAccount struct {
+	usecase.AccountUseCase
+
+	result usecase.AccountOutput
+	err    error
+}
+
+func (m mockAccount) Store(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ domain.TaxIDType,
+	_ domain.Money,
+) (usecase.AccountOutput, error) {
+	return m.result, m.err
+}
+
+func (m mockAccount) FindByID(_ context.Context, _ domain.AccountID) (usecase.AccountOutput, error) {
+	return m.result, m.err
+}
+
+func (m mockAccount) FindAll(_ context.Context, _ domain.Pagination) (usecase.AccountListOutput, error) {
+	return usecase.AccountListOutput{
+		Data: []usecase.AccountOutput{
+			{ID: "3c096a40-ccba-4b58-93ed-57379ab04680", Balance: 10.5},
+			{ID: "a5cb1ba2-d5ba-4d1b-8a5a-37ab5c2d2fa5", Balance: 20},
+		},
+	}, m.err
+}
+
+type mockBalance struct {
+	at *time.Time
+}
+
+func (m mockBalance) FindAt(_ context.Context, _ domain.AccountID, at time.Time) (usecase.AccountBalanceOutput, error) {
+	*m.at = at
+	return usecase.AccountBalanceOutput{Balance: 10.5, At: &at}, nil
+}
+
+func TestAccount_CreateAccount(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	var createdAt = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		req            *pb.CreateAccountRequest
+		ucMock         usecase.AccountUseCase
+		expected       *pb.Account
+		expectedCode   codes.Code
+		expectedFields []string
+	}{
+		{
+			name: "CreateAccount success",
+			req:  &pb.CreateAccountRequest{Name: "Test", Cpf: "44451598087", Balance: 10050},
+			ucMock: mockAccount{
+				result: usecase.AccountOutput{
+					ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+					Name:      "Test",
+					TaxID:     "44451598087",
+					TaxIDType: "cpf",
+					Balance:   100.5,
+					CreatedAt: createdAt,
+				},
+			},
+			expected: &pb.Account{
+				Id:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+				Name:      "Test",
+				TaxId:     "44451598087",
+				TaxIdType: "cpf",
+				Balance:   10050,
+				CreatedAt: toTimestamp(createdAt),
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "CreateAccount error invalid input",
+			req:            &pb.CreateAccountRequest{Type: pb.HolderType_HOLDER_TYPE_COMPANY, Balance: 10},
+			ucMock:         mockAccount{},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"cnpj", "name"},
+		},
+		{
+			name:         "CreateAccount error account already exists",
+			req:          &pb.CreateAccountRequest{Name: "Test", Cpf: "44451598087", Balance: 10},
+			ucMock:       mockAccount{err: domain.ErrAccountAlreadyExists},
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name:         "CreateAccount generic error",
+			req:          &pb.CreateAccountRequest{Name: "Test", Cpf: "44451598087", Balance: 10},
+			ucMock:       mockAccount{err: errors.New("error")},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var service = NewAccount(tt.ucMock, mockBalance{}, validator)
+
+			result, err := service.CreateAccount(context.Background(), tt.req)
+			if status.Code(err) != tt.expectedCode {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, status.Code(err), tt.expectedCode)
+			}
+
+			if tt.expected != nil && !proto.Equal(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+
+			if tt.expectedFields == nil {
+				return
+			}
+
+			var fields []string
+			for _, detail := range status.Convert(err).Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					for _, violation := range badRequest.GetFieldViolations() {
+						fields = append(fields, violation.GetField())
+					}
+				}
+			}
+
+			if !reflect.DeepEqual(fields, tt.expectedFields) {
+				t.Errorf("[TestCase '%s'] Fields: '%v' | Expected: '%v'", tt.name, fields, tt.expectedFields)
+			}
+		})
+	}
+}
+
+func TestAccount_GetBalance(t *testing.T) {
+	t.Parallel()
+
+	var (
+		at      time.Time
+		when    = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		ts, _   = ptypes.TimestampProto(when)
+		service = NewAccount(mockAccount{}, mockBalance{at: &at}, nil)
+		ctx     = auth.WithPrincipal(
+			context.Background(),
+			auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04680", nil),
+		)
+	)
+
+	result, err := service.GetBalance(ctx, &pb.GetBalanceRequest{
+		AccountId: "3c096a40-ccba-4b58-93ed-57379ab04680",
+		At:        ts,
+	})
+	if err != nil {
+		t.Fatalf("[TestCase 'GetBalance'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	if !at.Equal(when) || result.GetBalance() != 1050 || !proto.Equal(result.GetAt(), ts) {
+		t.Errorf("[TestCase 'GetBalance'] Result: '%v' at '%v'", result, at)
+	}
+
+	_, err = service.GetBalance(ctx, &pb.GetBalanceRequest{AccountId: "error"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("[TestCase 'GetBalance invalid id'] Result: '%v' | Expected: '%v'", status.Code(err), codes.InvalidArgument)
+	}
+
+	_, err = service.GetBalance(ctx, &pb.GetBalanceRequest{AccountId: "a5cb1ba2-d5ba-4d1b-8a5a-37ab5c2d2fa5"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf(
+			"[TestCase 'GetBalance another owner'] Result: '%v' | Expected: '%v'",
+			status.Code(err),
+			codes.PermissionDenied,
+		)
+	}
+}
+
+func TestAccount_GetAccountAuthorization(t *testing.T) {
+	t.Parallel()
+
+	var (
+		service = NewAccount(
+			mockAccount{result: usecase.AccountOutput{ID: "3c096a40-ccba-4b58-93ed-57379ab04680", Balance: 10.5}},
+			nil,
+			nil,
+		)
+		ctx = auth.WithPrincipal(
+			context.Background(),
+			auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04680", nil),
+		)
+	)
+
+	result, err := service.GetAccount(ctx, &pb.GetAccountRequest{AccountId: "3c096a40-ccba-4b58-93ed-57379ab04680"})
+	if err != nil || result.GetBalance() != 1050 {
+		t.Errorf("[TestCase 'GetAccount owner'] Result: '%v' | Error: '%v'", result, err)
+	}
+
+	_, err = service.GetAccount(ctx, &pb.GetAccountRequest{AccountId: "a5cb1ba2-d5ba-4d1b-8a5a-37ab5c2d2fa5"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf(
+			"[TestCase 'GetAccount another owner'] Result: '%v' | Expected: '%v'",
+			status.Code(err),
+			codes.PermissionDenied,
+		)
+	}
+
+	list, err := service.ListAccounts(ctx, &pb.ListAccountsRequest{})
+	if err != nil {
+		t.Fatalf("[TestCase 'ListAccounts'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	if list.GetData()[0].GetBalance() != 1050 || list.GetData()[1].GetBalance() != 0 {
+		t.Errorf("[TestCase 'ListAccounts another owner balance'] Result: '%v'", list.GetData())
+	}
+}
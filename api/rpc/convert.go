@@ -0,0 +1,87 @@
+package rpc
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/rpc/pb"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+//cents converte um valor em reais dos outputs dos casos de uso para centavos
+func cents(value float64) int64 {
+	return int64(math.Round(value * 100))
+}
+
+//toTimestamp converte um time.Time em um Timestamp, nil para o instante zero
+func toTimestamp(t time.Time) *timestamp.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil
+	}
+
+	return ts
+}
+
+func toAccount(output usecase.AccountOutput) *pb.Account {
+	return &pb.Account{
+		Id:        output.ID,
+		Name:      output.Name,
+		TaxId:     output.TaxID,
+		TaxIdType: output.TaxIDType,
+		Balance:   cents(output.Balance),
+		CreatedAt: toTimestamp(output.CreatedAt),
+	}
+}
+
+func toTransfer(output usecase.TransferOutput) *pb.Transfer {
+	return &pb.Transfer{
+		Id:                   output.ID,
+		AccountOriginId:      output.AccountOriginID,
+		AccountDestinationId: output.AccountDestinationID,
+		Amount:               cents(output.Amount),
+		Status:               output.Status,
+		CreatedAt:            toTimestamp(output.CreatedAt),
+	}
+}
+
+//parsePagination valida o limit e o cursor de uma listagem
+func parsePagination(limit int32, cursor string) (domain.Pagination, []validator.FieldError) {
+	var errs = make([]validator.FieldError, 0)
+
+	if limit < 0 || limit > domain.MaxPageLimit {
+		errs = append(errs, validator.FieldError{
+			Field:   "limit",
+			Message: fmt.Sprintf("limit must be a number between 1 and %d", domain.MaxPageLimit),
+		})
+	}
+
+	after, err := domain.DecodeCursor(cursor)
+	if err != nil {
+		errs = append(errs, validator.FieldError{
+			Field:   "cursor",
+			Message: "cursor is invalid",
+		})
+	}
+
+	return domain.NewPagination(after, int(limit)), errs
+}
+
+//parseAccountID valida o identificador de uma Account informado no campo field
+func parseAccountID(field string, value string) (domain.AccountID, []validator.FieldError) {
+	if !domain.IsValidUUID(value) {
+		return "", []validator.FieldError{{Field: field, Message: fmt.Sprintf("%s must be a valid UUID", field)}}
+	}
+
+	return domain.AccountID(value), nil
+}
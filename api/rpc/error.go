@@ -0,0 +1,98 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//errorTranslation relaciona um erro da aplicação a um código de status gRPC
+type errorTranslation struct {
+	target error
+	code   codes.Code
+}
+
+//errorCatalog armazena as traduções de erros, os erros mais específicos devem vir antes dos genéricos
+var errorCatalog = []errorTranslation{
+	{target: auth.ErrUnauthenticated, code: codes.Unauthenticated},
+	{target: auth.ErrForbidden, code: codes.PermissionDenied},
+	{target: auth.ErrInsufficientScope, code: codes.PermissionDenied},
+	{target: domain.ErrAccountAlreadyExists, code: codes.AlreadyExists},
+	{target: domain.ErrAccountOriginNotFound, code: codes.FailedPrecondition},
+	{target: domain.ErrAccountDestinationNotFound, code: codes.FailedPrecondition},
+	{target: domain.ErrInsufficientBalance, code: codes.FailedPrecondition},
+	{target: domain.ErrNotFound, code: codes.NotFound},
+	{target: domain.ErrInvalidCursor, code: codes.InvalidArgument},
+	{target: context.DeadlineExceeded, code: codes.DeadlineExceeded},
+}
+
+//translateError converte um erro da aplicação em um status gRPC, utilizando Internal com uma mensagem genérica para
+//erros desconhecidos
+func translateError(err error) error {
+	for _, translation := range errorCatalog {
+		if errors.Is(err, translation.target) {
+			return status.Error(translation.code, translation.target.Error())
+		}
+	}
+
+	var timeout interface{ Timeout() bool }
+	if errors.As(err, &timeout) && timeout.Timeout() {
+		return status.Error(codes.DeadlineExceeded, "request timeout")
+	}
+
+	return internalError{cause: err}
+}
+
+//internalError é um erro desconhecido respondido como Internal com uma mensagem genérica, já que a mensagem original
+//pode trazer detalhes do database ou de dependências, o erro original fica apenas no log
+type internalError struct {
+	cause error
+}
+
+func (e internalError) Error() string {
+	return e.GRPCStatus().Err().Error()
+}
+
+//GRPCStatus retorna o status enviado ao cliente
+func (e internalError) GRPCStatus() *status.Status {
+	return status.New(codes.Internal, "internal server error")
+}
+
+func (e internalError) Unwrap() error {
+	return e.cause
+}
+
+//invalidArgument constrói um status InvalidArgument com os campos inválidos nos detalhes
+func invalidArgument(errs []validator.FieldError) error {
+	var (
+		message    = "invalid input"
+		violations = make([]*errdetails.BadRequest_FieldViolation, 0, len(errs))
+	)
+
+	for _, err := range errs {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       err.Field,
+			Description: err.Message,
+		})
+	}
+
+	if len(errs) > 0 {
+		message = errs[0].Message
+	}
+
+	st, err := status.New(codes.InvalidArgument, message).WithDetails(&errdetails.BadRequest{
+		FieldViolations: violations,
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, message)
+	}
+
+	return st.Err()
+}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTranslateError(t *testing.T) {
+	t.Parallel()
+
+	var errDriver = errors.New("pq: connection refused on 10.0.0.5:5432")
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:            "Error of the catalog",
+			err:             domain.ErrNotFound,
+			expectedCode:    codes.NotFound,
+			expectedMessage: domain.ErrNotFound.Error(),
+		},
+		{
+			name:            "Timeout error",
+			err:             context.DeadlineExceeded,
+			expectedCode:    codes.DeadlineExceeded,
+			expectedMessage: context.DeadlineExceeded.Error(),
+		},
+		{
+			name:            "Unknown error does not expose its message",
+			err:             errDriver,
+			expectedCode:    codes.Internal,
+			expectedMessage: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		var err = translateError(tt.err)
+
+		if status.Code(err) != tt.expectedCode || status.Convert(err).Message() != tt.expectedMessage {
+			t.Errorf(
+				"[TestCase '%s'] Result: '%v' '%v' | Expected: '%v' '%v'",
+				tt.name,
+				status.Code(err),
+				status.Convert(err).Message(),
+				tt.expectedCode,
+				tt.expectedMessage,
+			)
+		}
+	}
+
+	if err := translateError(errDriver); !errors.Is(err, errDriver) {
+		t.Errorf("[TestCase 'Unknown error cause'] Result: '%v' | Expected: '%v'", err, errDriver)
+	}
+}
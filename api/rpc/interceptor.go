@@ -0,0 +1,131 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+//NewLoggerInterceptor cria logs de entrada e saída das chamadas gRPC e converte panics em Internal
+func NewLoggerInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
+	const (
+		requestKey  = "rpc_request"
+		responseKey = "rpc_response"
+	)
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (res interface{}, err error) {
+		start := time.Now()
+
+		log.WithFields(logger.Fields{
+			"key":    requestKey,
+			"method": info.FullMethod,
+		}).Infof("started handling request")
+
+		defer func() {
+			if r := recover(); r != nil {
+				log.WithFields(logger.Fields{
+					"key":    responseKey,
+					"method": info.FullMethod,
+					"panic":  fmt.Sprint(r),
+				}).Errorf("panic when handling request")
+
+				res, err = nil, status.Error(codes.Internal, "internal error")
+			}
+
+			var fields = logger.Fields{
+				"key":           responseKey,
+				"method":        info.FullMethod,
+				"rpc_status":    status.Code(err).String(),
+				"response_time": time.Since(start).Seconds(),
+			}
+
+			var internal internalError
+			if errors.As(err, &internal) {
+				fields["error"] = internal.cause.Error()
+			} else if err != nil {
+				fields["error"] = status.Convert(err).Message()
+			}
+
+			log.WithFields(fields).Infof("completed handling request")
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+//methodScopes são os escopos exigidos por cada método, os métodos que não constam exigem o escopo admin
+var methodScopes = map[string]domain.Scope{
+	"/bank.v1.AccountService/CreateAccount":   domain.ScopeAccountsWrite,
+	"/bank.v1.AccountService/ListAccounts":    domain.ScopeAccountsRead,
+	"/bank.v1.AccountService/GetAccount":      domain.ScopeAccountsRead,
+	"/bank.v1.AccountService/GetBalance":      domain.ScopeAccountsRead,
+	"/bank.v1.TransferService/CreateTransfer": domain.ScopeTransfersWrite,
+	"/bank.v1.TransferService/ListTransfers":  domain.ScopeTransfersRead,
+}
+
+//NewAuthInterceptor autentica as chamadas gRPC através de um bearer token no metadata authorization, exige o escopo
+//do método e adiciona o Principal ao contexto, os serviços de health check e reflection seguem sem autenticação
+func NewAuthInterceptor(verifier auth.TokenVerifier) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if isPublicMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		principal, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, translateError(err)
+		}
+
+		if !principal.HasScope(methodScope(info.FullMethod)) {
+			return nil, translateError(auth.ErrInsufficientScope)
+		}
+
+		return handler(auth.WithPrincipal(ctx, principal), req)
+	}
+}
+
+func authenticate(ctx context.Context, verifier auth.TokenVerifier) (auth.Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	for _, value := range md.Get("authorization") {
+		if token, ok := auth.BearerToken(value); ok {
+			return verifier.Verify(ctx, token)
+		}
+	}
+
+	return auth.Principal{}, auth.ErrUnauthenticated
+}
+
+func methodScope(method string) domain.Scope {
+	if scope, ok := methodScopes[method]; ok {
+		return scope
+	}
+
+	return domain.ScopeAdmin
+}
+
+func isPublicMethod(method string) bool {
+	return strings.HasPrefix(method, "/grpc.health.v1.Health/") ||
+		strings.HasPrefix(method, "/grpc.reflection.")
+}
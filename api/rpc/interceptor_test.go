@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/domain"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type stubVerifier struct{}
+
+func (stubVerifier) Verify(_ context.Context, token string) (auth.Principal, error) {
+	if token != "valid" {
+		return auth.Principal{}, auth.ErrUnauthenticated
+	}
+
+	return auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04680", []domain.Scope{domain.ScopeAccountsRead}), nil
+}
+
+func TestNewAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		method          string
+		authorization   string
+		expectedCode    codes.Code
+		expectedSubject string
+	}{
+		{
+			name:            "Valid bearer token",
+			method:          "/bank.v1.AccountService/GetBalance",
+			authorization:   "Bearer valid",
+			expectedCode:    codes.OK,
+			expectedSubject: "3c096a40-ccba-4b58-93ed-57379ab04680",
+		},
+		{
+			name:          "Invalid bearer token",
+			method:        "/bank.v1.AccountService/GetBalance",
+			authorization: "Bearer invalid",
+			expectedCode:  codes.Unauthenticated,
+		},
+		{
+			name:         "Missing authorization metadata",
+			method:       "/bank.v1.TransferService/CreateTransfer",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:          "Authorization without bearer scheme",
+			method:        "/bank.v1.TransferService/CreateTransfer",
+			authorization: "Basic dXNlcjpwYXNz",
+			expectedCode:  codes.Unauthenticated,
+		},
+		{
+			name:          "Method scope not granted",
+			method:        "/bank.v1.TransferService/CreateTransfer",
+			authorization: "Bearer valid",
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:          "Unknown method requires the admin scope",
+			method:        "/bank.v1.AdminService/Purge",
+			authorization: "Bearer valid",
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:         "Health check is public",
+			method:       "/grpc.health.v1.Health/Check",
+			expectedCode: codes.OK,
+		},
+	}
+
+	var interceptor = NewAuthInterceptor(stubVerifier{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ctx = context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			var subject string
+			_, err := interceptor(
+				ctx,
+				nil,
+				&grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					principal, _ := auth.FromContext(ctx)
+					subject = principal.Subject()
+					return nil, nil
+				},
+			)
+
+			if status.Code(err) != tt.expectedCode {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, status.Code(err), tt.expectedCode)
+			}
+
+			if subject != tt.expectedSubject {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, subject, tt.expectedSubject)
+			}
+		})
+	}
+}
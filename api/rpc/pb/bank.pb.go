@@ -0,0 +1,1040 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: bank.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// HolderType identifica o titular da conta, pessoa física quando não informado.
+type HolderType int32
+
+const (
+	HolderType_HOLDER_TYPE_UNSPECIFIED HolderType = 0
+	HolderType_HOLDER_TYPE_INDIVIDUAL  HolderType = 1
+	HolderType_HOLDER_TYPE_COMPANY     HolderType = 2
+)
+
+var HolderType_name = map[int32]string{
+	0: "HOLDER_TYPE_UNSPECIFIED",
+	1: "HOLDER_TYPE_INDIVIDUAL",
+	2: "HOLDER_TYPE_COMPANY",
+}
+
+var HolderType_value = map[string]int32{
+	"HOLDER_TYPE_UNSPECIFIED": 0,
+	"HOLDER_TYPE_INDIVIDUAL":  1,
+	"HOLDER_TYPE_COMPANY":     2,
+}
+
+func (x HolderType) String() string {
+	return proto.EnumName(HolderType_name, int32(x))
+}
+
+func (HolderType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a6371916d5cb63b4, []int{0}
+}
+
+// Account armazena uma conta, com valores em centavos.
+type Account struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TaxId                string               `protobuf:"bytes,3,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
+	TaxIdType            string               `protobuf:"bytes,4,opt,name=tax_id_type,json=taxIdType,proto3" json:"tax_id_type,omitempty"`
+	Balance              int64                `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6371916d5cb63b4, []int{0}
+}
+
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account.Unmarshal(m, b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return xxx_messageInfo_Account.Size(m)
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Account) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Account) GetTaxId() string {
+	if m != nil {
+		return m.TaxId
+	}
+	return ""
+}
+
+func (m *Account) GetTaxIdType() string {
+	if m != nil {
+		return m.TaxIdType
+	}
+	return ""
+}
+
+func (m *Account) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *Account) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+// CreateAccountRequest informa o CPF para pessoa física e o CNPJ para pessoa jurídica.
+type CreateAccountRequest struct {
+	Name                 string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 HolderType `protobuf:"varint,2,opt,name=type,proto3,enum=bank.v1.HolderType" json:"type,omitempty"`
+	Cpf                  string     `protobuf:"bytes,3,opt,name=cpf,proto3" json:"cpf,omitempty"`
+	Cnpj                 string     `protobuf:"bytes,4,opt,name=cnpj,proto3" json:"cnpj,omitempty"`
+	Balance              int64      `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CreateAccountRequest) Reset()         { *m = CreateAccountRequest{} }
+func (m *CreateAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAccountRequest) ProtoMessage()    {}
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6371916d5cb63b4, []int{1}
+}
+
+func (m *CreateAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAccountRequest.Unmarshal(m, b)
+}
+func (m *CreateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccountRequest.Merge(m, src)
+}
+func (m *CreateAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAccountRequest.Size(m)
+}
+func (m *CreateAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccountRequest proto.InternalMessageInfo
+
+func (m *CreateAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateAccountRequest) GetType() HolderType {
+	if m != nil {
+		return m.Type
+	}
+	return HolderType_HOLDER_TYPE_UNSPECIFIED
+}
+
+func (m *CreateAccountRequest) GetCpf() string {
+	if m != nil {
+		return m.Cpf
+	}
+	return ""
+}
+
+func (m *CreateAccountRequest) GetCnpj() string {
+	if m != nil {
+		return m.Cnpj
+	}
+	return ""
+}
+
+func (m *CreateAccountRequest) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type ListAccountsRequest struct {
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAccountsRequest) Reset()         { *m = ListAccountsRequest{} }
+func (m *ListAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsRequest) ProtoMessage()    {}
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6371916d5cb63b4, []int{2}
+}
+
+func (m *ListAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsRequest.Unmarshal(m, b)
+}
+func (m *ListAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsRequest.Merge(m, src)
+}
+func (m *ListAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsRequest.Size(m)
+}
+func (m *ListAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsRequest proto.InternalMessageInfo
+
+func (m *ListAccountsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListAccountsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ListAccountsResponse struct {
+	Data                 []*Account `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor           string     `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListAccountsResponse) Reset()         { *m = ListAccountsResponse{} }
+func (m *ListAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsResponse) ProtoMessage()    {}
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6371916d5cb63b4, []int{3}
+}
+
+func (m *ListAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsResponse.Unmarshal(m, b)
+}
+func (m *ListAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsResponse.Merge(m, src)
+}
+func (m *ListAccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsResponse.Size(m)
+}
+func (m *ListAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+
+func (m *ListAccountsResponse) GetData() []*Account {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ListAccountsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type GetAccountRequest struct {
+	AccountId            string   `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
+func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountRequest) ProtoMessage()    {}
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6371916d5cb63b4, []int{4}
+}
+
+func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountRequest.Unmarshal(m, b)
+}
+func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+}
+func (m *GetAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountRequest.Size(m)
+}
+func (m *GetAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+
+func (m *GetAccountRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+// GetBalanceRequest retorna o saldo atual, ou o saldo no instante informado em at.
+type GetBalanceRequest struct {
+	AccountId            string               `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	At                   *timestamp.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetBalanceRequest) Reset()         { *m = GetBalanceRequest{} }
+func (m *GetBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBalanceRequest) ProtoMessage()    {}
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6371916d5cb63b4, []int{5}
+}
+
+func (m *GetBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBalanceRequest.Unmarshal(m, b)
+}
+func (m *GetBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBalanceRequest.Merge(m, src)
+}
+func (m *GetBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBalanceRequest.Size(m)
+}
+func (m *GetBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBalanceRequest proto.InternalMessageInfo
+
+func (m *GetBalanceRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+func (m *GetBalanceRequest) GetAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.At
+	}
+	return nil
+}
+
+// Balance armazena um saldo em centavos.
+type Balance struct {
+	Balance              int64                `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	At                   *timestamp.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Balance) Reset()         { *m = Balance{} }
+func (m *Balance) String() string { return proto.CompactTextString(m) }
+func (*Balance) ProtoMessage()    {}
+func (*Balance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6371916d5cb63b4, []int{6}
+}
+
+func (m *Balance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Balance.Unmarshal(m, b)
+}
+func (m *Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Balance.Marshal(b, m, deterministic)
+}
+func (m *Balance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Balance.Merge(m, src)
+}
+func (m *Balance) XXX_Size() int {
+	return xxx_messageInfo_Balance.Size(m)
+}
+func (m *Balance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Balance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Balance proto.InternalMessageInfo
+
+func (m *Balance) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *Balance) GetAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.At
+	}
+	return nil
+}
+
+// Transfer armazena uma transferência, com valores em centavos.
+type Transfer struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountOriginId      string               `protobuf:"bytes,2,opt,name=account_origin_id,json=accountOriginId,proto3" json:"account_origin_id,omitempty"`
+	AccountDestinationId string               `protobuf:"bytes,3,opt,name=account_destination_id,json=accountDestinationId,proto3" json:"account_destination_id,omitempty"`
+	Amount               int64                `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status               string               `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Transfer) Reset()         { *m = Transfer{} }
+func (m *Transfer) String() string { return proto.CompactTextString(m) }
+func (*Transfer) ProtoMessage()    {}
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6371916d5cb63b4, []int{7}
+}
+
+func (m *Transfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Transfer.Unmarshal(m, b)
+}
+func (m *Transfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Transfer.Marshal(b, m, deterministic)
+}
+func (m *Transfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transfer.Merge(m, src)
+}
+func (m *Transfer) XXX_Size() int {
+	return xxx_messageInfo_Transfer.Size(m)
+}
+func (m *Transfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transfer proto.InternalMessageInfo
+
+func (m *Transfer) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Transfer) GetAccountOriginId() string {
+	if m != nil {
+		return m.AccountOriginId
+	}
+	return ""
+}
+
+func (m *Transfer) GetAccountDestinationId() string {
+	if m != nil {
+		return m.AccountDestinationId
+	}
+	return ""
+}
+
+func (m *Transfer) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Transfer) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Transfer) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type CreateTransferRequest struct {
+	AccountOriginId      string   `protobuf:"bytes,1,opt,name=account_origin_id,json=accountOriginId,proto3" json:"account_origin_id,omitempty"`
+	AccountDestinationId string   `protobuf:"bytes,2,opt,name=account_destination_id,json=accountDestinationId,proto3" json:"account_destination_id,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTransferRequest) Reset()         { *m = CreateTransferRequest{} }
+func (m *CreateTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTransferRequest) ProtoMessage()    {}
+func (*CreateTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6371916d5cb63b4, []int{8}
+}
+
+func (m *CreateTransferRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTransferRequest.Unmarshal(m, b)
+}
+func (m *CreateTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTransferRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTransferRequest.Merge(m, src)
+}
+func (m *CreateTransferRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTransferRequest.Size(m)
+}
+func (m *CreateTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTransferRequest proto.InternalMessageInfo
+
+func (m *CreateTransferRequest) GetAccountOriginId() string {
+	if m != nil {
+		return m.AccountOriginId
+	}
+	return ""
+}
+
+func (m *CreateTransferRequest) GetAccountDestinationId() string {
+	if m != nil {
+		return m.AccountDestinationId
+	}
+	return ""
+}
+
+func (m *CreateTransferRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// ListTransfersRequest filtra as Transfer enviadas ou recebidas por account_id, ordenadas por created_at.
+type ListTransfersRequest struct {
+	AccountId            string   `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTransfersRequest) Reset()         { *m = ListTransfersRequest{} }
+func (m *ListTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTransfersRequest) ProtoMessage()    {}
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6371916d5cb63b4, []int{9}
+}
+
+func (m *ListTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTransfersRequest.Unmarshal(m, b)
+}
+func (m *ListTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTransfersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTransfersRequest.Merge(m, src)
+}
+func (m *ListTransfersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTransfersRequest.Size(m)
+}
+func (m *ListTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTransfersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTransfersRequest proto.InternalMessageInfo
+
+func (m *ListTransfersRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+func (m *ListTransfersRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListTransfersRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ListTransfersResponse struct {
+	Data                 []*Transfer `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor           string      `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListTransfersResponse) Reset()         { *m = ListTransfersResponse{} }
+func (m *ListTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTransfersResponse) ProtoMessage()    {}
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6371916d5cb63b4, []int{10}
+}
+
+func (m *ListTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTransfersResponse.Unmarshal(m, b)
+}
+func (m *ListTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTransfersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTransfersResponse.Merge(m, src)
+}
+func (m *ListTransfersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTransfersResponse.Size(m)
+}
+func (m *ListTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTransfersResponse proto.InternalMessageInfo
+
+func (m *ListTransfersResponse) GetData() []*Transfer {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ListTransfersResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("bank.v1.HolderType", HolderType_name, HolderType_value)
+	proto.RegisterType((*Account)(nil), "bank.v1.Account")
+	proto.RegisterType((*CreateAccountRequest)(nil), "bank.v1.CreateAccountRequest")
+	proto.RegisterType((*ListAccountsRequest)(nil), "bank.v1.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "bank.v1.ListAccountsResponse")
+	proto.RegisterType((*GetAccountRequest)(nil), "bank.v1.GetAccountRequest")
+	proto.RegisterType((*GetBalanceRequest)(nil), "bank.v1.GetBalanceRequest")
+	proto.RegisterType((*Balance)(nil), "bank.v1.Balance")
+	proto.RegisterType((*Transfer)(nil), "bank.v1.Transfer")
+	proto.RegisterType((*CreateTransferRequest)(nil), "bank.v1.CreateTransferRequest")
+	proto.RegisterType((*ListTransfersRequest)(nil), "bank.v1.ListTransfersRequest")
+	proto.RegisterType((*ListTransfersResponse)(nil), "bank.v1.ListTransfersResponse")
+}
+
+func init() { proto.RegisterFile("bank.proto", fileDescriptor_a6371916d5cb63b4) }
+
+var fileDescriptor_a6371916d5cb63b4 = []byte{
+	// 777 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdd, 0x6e, 0xe2, 0x46,
+	0x14, 0xae, 0xcd, 0x5f, 0x39, 0x34, 0x84, 0x4c, 0x48, 0x62, 0xb9, 0x4d, 0x82, 0x50, 0xab, 0xa2,
+	0x48, 0x01, 0x95, 0xb4, 0x17, 0x6d, 0x6f, 0x4a, 0x80, 0x36, 0x56, 0x53, 0x88, 0x1c, 0x52, 0x29,
+	0x95, 0x1a, 0x6b, 0x6c, 0x4f, 0xe8, 0xb4, 0x60, 0xbb, 0xf6, 0x10, 0x25, 0x8f, 0xb1, 0xda, 0x8b,
+	0x7d, 0x83, 0x7d, 0x87, 0x7d, 0xa2, 0x7d, 0x8d, 0x95, 0xc7, 0x63, 0x83, 0x81, 0x68, 0x89, 0xf6,
+	0xce, 0x73, 0xce, 0x9c, 0xe3, 0xef, 0xfb, 0xe6, 0x7c, 0x07, 0xc0, 0xc4, 0xce, 0x7f, 0x4d, 0xcf,
+	0x77, 0x99, 0x8b, 0x0a, 0xfc, 0xfb, 0xe1, 0x3b, 0xf5, 0x78, 0xec, 0xba, 0xe3, 0x09, 0x69, 0xf1,
+	0xb0, 0x39, 0xbb, 0x6f, 0x31, 0x3a, 0x25, 0x01, 0xc3, 0x53, 0x2f, 0xba, 0x59, 0x7f, 0x27, 0x41,
+	0xa1, 0x63, 0x59, 0xee, 0xcc, 0x61, 0xa8, 0x0c, 0x32, 0xb5, 0x15, 0xa9, 0x26, 0x35, 0x8a, 0xba,
+	0x4c, 0x6d, 0x84, 0x20, 0xeb, 0xe0, 0x29, 0x51, 0x64, 0x1e, 0xe1, 0xdf, 0x68, 0x0f, 0xf2, 0x0c,
+	0x3f, 0x1a, 0xd4, 0x56, 0x32, 0x3c, 0x9a, 0x63, 0xf8, 0x51, 0xb3, 0xd1, 0x11, 0x94, 0xa2, 0xb0,
+	0xc1, 0x9e, 0x3c, 0xa2, 0x64, 0x79, 0xae, 0xc8, 0x73, 0xa3, 0x27, 0x8f, 0x20, 0x05, 0x0a, 0x26,
+	0x9e, 0x60, 0xc7, 0x22, 0x4a, 0xae, 0x26, 0x35, 0x32, 0x7a, 0x7c, 0x44, 0x3f, 0x02, 0x58, 0x3e,
+	0xc1, 0x8c, 0xd8, 0x06, 0x66, 0x4a, 0xbe, 0x26, 0x35, 0x4a, 0x6d, 0xb5, 0x19, 0xc1, 0x6e, 0xc6,
+	0xb0, 0x9b, 0xa3, 0x18, 0xb6, 0x5e, 0x14, 0xb7, 0x3b, 0xac, 0xfe, 0x5a, 0x82, 0x6a, 0x97, 0x9f,
+	0x04, 0x03, 0x9d, 0xfc, 0x3f, 0x23, 0x01, 0x4b, 0x80, 0x4b, 0x0b, 0xc0, 0xbf, 0x85, 0x2c, 0x87,
+	0x16, 0x92, 0x29, 0xb7, 0x77, 0x9b, 0x42, 0xa1, 0xe6, 0x85, 0x3b, 0xb1, 0x89, 0x1f, 0x82, 0xd4,
+	0xf9, 0x05, 0x54, 0x81, 0x8c, 0xe5, 0xdd, 0x0b, 0x7a, 0xe1, 0x67, 0xd8, 0xce, 0x72, 0xbc, 0x7f,
+	0x05, 0x2b, 0xfe, 0xfd, 0x3c, 0xa1, 0x7a, 0x17, 0x76, 0x2f, 0x69, 0xc0, 0x04, 0xa4, 0x20, 0xc6,
+	0x54, 0x85, 0xdc, 0x84, 0x4e, 0x29, 0xe3, 0xa0, 0x72, 0x7a, 0x74, 0x40, 0xfb, 0x90, 0xb7, 0x66,
+	0x7e, 0xe0, 0xfa, 0x42, 0x64, 0x71, 0xaa, 0xff, 0x0d, 0xd5, 0x74, 0x93, 0xc0, 0x73, 0x9d, 0x80,
+	0xa0, 0xaf, 0x21, 0x6b, 0x63, 0x86, 0x15, 0xa9, 0x96, 0x69, 0x94, 0xda, 0x95, 0x84, 0x45, 0x2c,
+	0x00, 0xcf, 0xa2, 0x63, 0x28, 0x39, 0xe4, 0x91, 0x19, 0xa9, 0xd6, 0x10, 0x86, 0xba, 0x51, 0xfb,
+	0x36, 0xec, 0xfc, 0x46, 0xd8, 0x92, 0x6a, 0x87, 0x00, 0x38, 0x8a, 0x18, 0xc9, 0x18, 0x14, 0x45,
+	0x44, 0xb3, 0xeb, 0x77, 0xbc, 0xe6, 0x3c, 0x62, 0xb9, 0x59, 0x0d, 0x3a, 0x01, 0x19, 0x33, 0x45,
+	0xfe, 0xe8, 0xa3, 0xca, 0x98, 0xd5, 0x87, 0x50, 0x10, 0xcd, 0x17, 0xc5, 0x95, 0xd2, 0xd3, 0xf2,
+	0x92, 0x86, 0xef, 0x25, 0xf8, 0x7c, 0xe4, 0x63, 0x27, 0xb8, 0x27, 0xfe, 0xca, 0x6c, 0x9f, 0xc0,
+	0x4e, 0x0c, 0xdc, 0xf5, 0xe9, 0x98, 0x3a, 0x21, 0xfe, 0x48, 0xa8, 0x6d, 0x91, 0x18, 0xf2, 0xb8,
+	0x66, 0xa3, 0xef, 0x61, 0x3f, 0xbe, 0x6b, 0x93, 0x80, 0x51, 0x07, 0x33, 0xea, 0x3a, 0x73, 0x0f,
+	0x54, 0x45, 0xb6, 0x37, 0x4f, 0x6a, 0x76, 0xf8, 0xb4, 0x78, 0x1a, 0x86, 0xf9, 0xdc, 0x64, 0x74,
+	0x71, 0x0a, 0xe3, 0x01, 0xc3, 0x6c, 0x16, 0xf0, 0xc1, 0x29, 0xea, 0xe2, 0xf4, 0x29, 0x46, 0x78,
+	0x25, 0xc1, 0x5e, 0x64, 0x84, 0x98, 0x6f, 0xfc, 0x3e, 0x6b, 0x69, 0x4a, 0x2f, 0xa5, 0x29, 0x6f,
+	0x44, 0x33, 0xb3, 0x48, 0xb3, 0x6e, 0x45, 0x13, 0x1c, 0x03, 0x0a, 0x36, 0x9c, 0x98, 0xc4, 0x26,
+	0xf2, 0x7a, 0x9b, 0x64, 0x52, 0x36, 0x31, 0x60, 0x6f, 0xe9, 0x27, 0xc2, 0x27, 0xdf, 0xa4, 0x7c,
+	0xb2, 0x93, 0xf8, 0x24, 0xd1, 0x67, 0x33, 0xa3, 0x9c, 0xdc, 0x01, 0xcc, 0x17, 0x04, 0xfa, 0x12,
+	0x0e, 0x2e, 0x86, 0x97, 0xbd, 0xbe, 0x6e, 0x8c, 0x6e, 0xaf, 0xfa, 0xc6, 0xcd, 0xe0, 0xfa, 0xaa,
+	0xdf, 0xd5, 0x7e, 0xd5, 0xfa, 0xbd, 0xca, 0x67, 0x48, 0x85, 0xfd, 0xc5, 0xa4, 0x36, 0xe8, 0x69,
+	0x7f, 0x6a, 0xbd, 0x9b, 0xce, 0x65, 0x45, 0x42, 0x07, 0xb0, 0xbb, 0x98, 0xeb, 0x0e, 0xff, 0xb8,
+	0xea, 0x0c, 0x6e, 0x2b, 0x72, 0xfb, 0x8d, 0x0c, 0x65, 0x61, 0xc3, 0x6b, 0xe2, 0x3f, 0x50, 0x8b,
+	0xa0, 0x5f, 0x60, 0x2b, 0xb5, 0xd4, 0xd0, 0x61, 0x82, 0x7e, 0xdd, 0xb2, 0x53, 0x57, 0x96, 0x00,
+	0xfa, 0x1d, 0xbe, 0x58, 0x5c, 0x1e, 0xe8, 0xab, 0xe4, 0xc6, 0x9a, 0xc5, 0xa4, 0x1e, 0x3e, 0x93,
+	0x15, 0x4a, 0xfe, 0x04, 0x30, 0x5f, 0x15, 0x48, 0x4d, 0x2e, 0xaf, 0xec, 0x8f, 0x35, 0x40, 0xa2,
+	0xda, 0xd8, 0xd5, 0xa9, 0xda, 0xf4, 0x1e, 0x59, 0xa8, 0x15, 0x89, 0xf6, 0x5b, 0x09, 0xb6, 0xe3,
+	0xd7, 0x8a, 0xa5, 0xe9, 0x42, 0x39, 0x3d, 0xe6, 0xe8, 0x68, 0x49, 0x9b, 0xa5, 0xf9, 0x57, 0x57,
+	0x5f, 0x1e, 0x0d, 0x60, 0x2b, 0x35, 0x33, 0x28, 0x2d, 0xc0, 0xf2, 0xc0, 0xaa, 0x47, 0xcf, 0xa5,
+	0x23, 0x81, 0xce, 0x7f, 0xf8, 0xeb, 0x6c, 0x4c, 0xd9, 0x3f, 0x33, 0xb3, 0x69, 0xb9, 0xd3, 0xd6,
+	0x38, 0xc0, 0x26, 0xb6, 0xa9, 0x43, 0x5b, 0x63, 0xf7, 0x34, 0x2c, 0x3c, 0x65, 0xa2, 0xa2, 0x85,
+	0x3d, 0xda, 0xf2, 0x3d, 0xab, 0xe5, 0x99, 0x3f, 0x7b, 0xa6, 0x99, 0xe7, 0x96, 0x3e, 0xfb, 0x30,
+	0x00, 0xb5, 0x22, 0x0c, 0x95, 0xb7, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AccountServiceClient is the client API for AccountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AccountServiceClient interface {
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*Balance, error)
+}
+
+type accountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountServiceClient(cc grpc.ClientConnInterface) AccountServiceClient {
+	return &accountServiceClient{cc}
+}
+
+func (c *accountServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/bank.v1.AccountService/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/bank.v1.AccountService/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/bank.v1.AccountService/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*Balance, error) {
+	out := new(Balance)
+	err := c.cc.Invoke(ctx, "/bank.v1.AccountService/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountServiceServer is the server API for AccountService service.
+type AccountServiceServer interface {
+	CreateAccount(context.Context, *CreateAccountRequest) (*Account, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
+	GetBalance(context.Context, *GetBalanceRequest) (*Balance, error)
+}
+
+// UnimplementedAccountServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAccountServiceServer struct {
+}
+
+func (*UnimplementedAccountServiceServer) CreateAccount(ctx context.Context, req *CreateAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (*UnimplementedAccountServiceServer) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (*UnimplementedAccountServiceServer) GetAccount(ctx context.Context, req *GetAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (*UnimplementedAccountServiceServer) GetBalance(ctx context.Context, req *GetBalanceRequest) (*Balance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+
+func RegisterAccountServiceServer(s *grpc.Server, srv AccountServiceServer) {
+	s.RegisterService(&_AccountService_serviceDesc, srv)
+}
+
+func _AccountService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bank.v1.AccountService/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bank.v1.AccountService/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bank.v1.AccountService/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bank.v1.AccountService/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AccountService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bank.v1.AccountService",
+	HandlerType: (*AccountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAccount",
+			Handler:    _AccountService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _AccountService_ListAccounts_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _AccountService_GetAccount_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _AccountService_GetBalance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bank.proto",
+}
+
+// TransferServiceClient is the client API for TransferService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TransferServiceClient interface {
+	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*Transfer, error)
+	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+}
+
+type transferServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransferServiceClient(cc grpc.ClientConnInterface) TransferServiceClient {
+	return &transferServiceClient{cc}
+}
+
+func (c *transferServiceClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, "/bank.v1.TransferService/CreateTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
+	out := new(ListTransfersResponse)
+	err := c.cc.Invoke(ctx, "/bank.v1.TransferService/ListTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransferServiceServer is the server API for TransferService service.
+type TransferServiceServer interface {
+	CreateTransfer(context.Context, *CreateTransferRequest) (*Transfer, error)
+	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+}
+
+// UnimplementedTransferServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTransferServiceServer struct {
+}
+
+func (*UnimplementedTransferServiceServer) CreateTransfer(ctx context.Context, req *CreateTransferRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (*UnimplementedTransferServiceServer) ListTransfers(ctx context.Context, req *ListTransfersRequest) (*ListTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
+}
+
+func RegisterTransferServiceServer(s *grpc.Server, srv TransferServiceServer) {
+	s.RegisterService(&_TransferService_serviceDesc, srv)
+}
+
+func _TransferService_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bank.v1.TransferService/CreateTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).ListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bank.v1.TransferService/ListTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TransferService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bank.v1.TransferService",
+	HandlerType: (*TransferServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _TransferService_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "ListTransfers",
+			Handler:    _TransferService_ListTransfers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bank.proto",
+}
@@ -0,0 +1,87 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/input"
+	"github.com/gsabadini/go-bank-transfer/api/rpc/pb"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+//Transfer armazena as dependências do serviço gRPC de Transfer
+type Transfer struct {
+	uc        usecase.TransferUseCase
+	validator validator.Validator
+}
+
+//NewTransfer constrói um Transfer com suas dependências
+func NewTransfer(uc usecase.TransferUseCase, v validator.Validator) Transfer {
+	return Transfer{uc: uc, validator: v}
+}
+
+//CreateTransfer cria uma Transfer entre duas Account, validando a entrada como a API HTTP
+func (t Transfer) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.Transfer, error) {
+	var inputTransfer = input.Transfer{
+		AccountOriginID:      req.GetAccountOriginId(),
+		AccountDestinationID: req.GetAccountDestinationId(),
+		Amount:               req.GetAmount(),
+	}
+
+	if errs := inputTransfer.Validate(t.validator); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+
+	if err := auth.AuthorizeAccount(ctx, domain.AccountID(inputTransfer.AccountOriginID)); err != nil {
+		return nil, translateError(err)
+	}
+
+	output, err := t.uc.Store(
+		ctx,
+		domain.AccountID(inputTransfer.AccountOriginID),
+		domain.AccountID(inputTransfer.AccountDestinationID),
+		domain.Money(inputTransfer.Amount),
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toTransfer(output), nil
+}
+
+//ListTransfers retorna uma página de Transfer, filtrando pela Account quando informada e limitada às Transfer da
+//Account do titular sem o escopo admin
+func (t Transfer) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
+	pagination, errs := parsePagination(req.GetLimit(), req.GetCursor())
+
+	var query = domain.TransferQuery{Sort: domain.TransferSortCreatedAt, Pagination: pagination}
+	if req.GetAccountId() != "" {
+		ID, accountErrs := parseAccountID("account_id", req.GetAccountId())
+		errs = append(errs, accountErrs...)
+		query.Filter.AccountID = ID
+	}
+
+	if len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+
+	filter, err := auth.AuthorizeTransferFilter(ctx, query.Filter)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	query.Filter = filter
+
+	output, err := t.uc.FindAll(ctx, query)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var res = &pb.ListTransfersResponse{Data: make([]*pb.Transfer, 0, len(output.Data)), NextCursor: output.NextCursor}
+	for _, transfer := range output.Data {
+		res.Data = append(res.Data, toTransfer(transfer))
+	}
+
+	return res, nil
+}
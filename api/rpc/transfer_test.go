@@ -0,0 +1,173 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/rpc/pb"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockTransfer struct {
+	usecase.TransferUseCase
+
+	query *domain.TransferQuery
+	err   error
+}
+
+func (m mockTransfer) Store(
+	_ context.Context,
+	_ domain.AccountID,
+	_ domain.AccountID,
+	_ domain.Money,
+) (usecase.TransferOutput, error) {
+	return usecase.TransferOutput{Amount: 1.5}, m.err
+}
+
+func (m mockTransfer) FindAll(_ context.Context, query domain.TransferQuery) (usecase.TransferListOutput, error) {
+	*m.query = query
+	return usecase.TransferListOutput{Data: []usecase.TransferOutput{{ID: "1"}}, NextCursor: "next"}, m.err
+}
+
+func TestTransfer_CreateTransfer(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+
+	var req = &pb.CreateTransferRequest{
+		AccountOriginId:      "3c096a40-ccba-4b58-93ed-57379ab04680",
+		AccountDestinationId: "a5cb1ba2-d5ba-4d1b-8a5a-37ab5c2d2fa5",
+		Amount:               150,
+	}
+
+	tests := []struct {
+		name         string
+		req          *pb.CreateTransferRequest
+		owner        string
+		err          error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "CreateTransfer success",
+			req:          req,
+			expectedCode: codes.OK,
+		},
+		{
+			name: "CreateTransfer error same accounts",
+			req: &pb.CreateTransferRequest{
+				AccountOriginId:      req.AccountOriginId,
+				AccountDestinationId: req.AccountOriginId,
+				Amount:               150,
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "CreateTransfer error insufficient balance",
+			req:          req,
+			err:          errors.Wrap(domain.ErrInsufficientBalance, "error"),
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "CreateTransfer error account origin not found",
+			req:          req,
+			err:          domain.ErrAccountOriginNotFound,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "CreateTransfer error origin account of another owner",
+			req:          req,
+			owner:        req.AccountDestinationId,
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var owner = tt.req.AccountOriginId
+			if tt.owner != "" {
+				owner = tt.owner
+			}
+
+			var (
+				service = NewTransfer(mockTransfer{err: tt.err}, validator)
+				ctx     = auth.WithPrincipal(context.Background(), auth.NewPrincipal(owner, nil))
+			)
+
+			result, err := service.CreateTransfer(ctx, tt.req)
+			if status.Code(err) != tt.expectedCode {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, status.Code(err), tt.expectedCode)
+			}
+
+			if err == nil && result.GetAmount() != 150 {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.GetAmount(), 150)
+			}
+		})
+	}
+}
+
+func TestTransfer_ListTransfers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query   domain.TransferQuery
+		service = NewTransfer(mockTransfer{query: &query}, nil)
+		ctx     = auth.WithPrincipal(
+			context.Background(),
+			auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04680", auth.AccountHolderScopes()),
+		)
+	)
+
+	result, err := service.ListTransfers(ctx, &pb.ListTransfersRequest{
+		AccountId: "3c096a40-ccba-4b58-93ed-57379ab04680",
+		Limit:     10,
+	})
+	if err != nil {
+		t.Fatalf("[TestCase 'ListTransfers'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	if query.Filter.AccountID != "3c096a40-ccba-4b58-93ed-57379ab04680" || query.Pagination.Limit != 10 {
+		t.Errorf("[TestCase 'ListTransfers'] Query: '%v'", query)
+	}
+
+	if len(result.GetData()) != 1 || result.GetNextCursor() != "next" {
+		t.Errorf("[TestCase 'ListTransfers'] Result: '%v'", result)
+	}
+
+	_, err = service.ListTransfers(ctx, &pb.ListTransfersRequest{Limit: -1, Cursor: "invalid"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("[TestCase 'ListTransfers invalid'] Result: '%v' | Expected: '%v'", status.Code(err), codes.InvalidArgument)
+	}
+
+	query = domain.TransferQuery{}
+	if _, err = service.ListTransfers(ctx, &pb.ListTransfersRequest{Limit: 10}); err != nil {
+		t.Fatalf("[TestCase 'ListTransfers holder account'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	if query.Filter.AccountID != "3c096a40-ccba-4b58-93ed-57379ab04680" {
+		t.Errorf("[TestCase 'ListTransfers holder account'] Query: '%v'", query)
+	}
+
+	query = domain.TransferQuery{}
+	_, err = service.ListTransfers(ctx, &pb.ListTransfersRequest{
+		AccountId: "3c096a40-ccba-4b58-93ed-57379ab04681",
+		Limit:     10,
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf(
+			"[TestCase 'ListTransfers another owner'] Result: '%v' | Expected: '%v'",
+			status.Code(err),
+			codes.PermissionDenied,
+		)
+	}
+
+	if query.Pagination.Limit != 0 {
+		t.Errorf("[TestCase 'ListTransfers another owner'] the use case must not be called: '%v'", query)
+	}
+}
@@ -16,14 +16,24 @@ var (
 
 	//ErrUpdateBalance é um erro ao atualizar o saldo de uma conta
 	ErrUpdateBalance = errors.New("error update account balance")
+
+	//ErrAccountAlreadyExists é um erro de Account já cadastrada para o mesmo documento
+	ErrAccountAlreadyExists = errors.New("account already exists")
+
+	//ErrAccountOriginNotFound é um erro de Account de origem não encontrada
+	ErrAccountOriginNotFound = errors.New("account origin not found")
+
+	//ErrAccountDestinationNotFound é um erro de Account de destino não encontrada
+	ErrAccountDestinationNotFound = errors.New("account destination not found")
 )
 
 //AccountRepository expõe os métodos disponíveis para as abstrações do repositório de Account
 type AccountRepository interface {
 	Store(context.Context, Account) (Account, error)
 	UpdateBalance(context.Context, AccountID, Money) error
-	FindAll(context.Context) ([]Account, error)
+	FindAll(context.Context, Pagination) ([]Account, error)
 	FindByID(context.Context, AccountID) (Account, error)
+	FindByIDs(context.Context, []AccountID) ([]Account, error)
 	FindBalance(context.Context, AccountID) (Account, error)
 }
 
@@ -35,13 +45,51 @@ func (a AccountID) String() string {
 	return string(a)
 }
 
+//HolderType define o tipo de titular de uma Account
+type HolderType string
+
+const (
+	//HolderTypeIndividual representa um titular pessoa física
+	HolderTypeIndividual HolderType = "individual"
+
+	//HolderTypeCompany representa um titular pessoa jurídica
+	HolderTypeCompany HolderType = "company"
+)
+
+//TaxIDType retorna o tipo de documento que identifica o titular
+func (h HolderType) TaxIDType() TaxIDType {
+	if h == HolderTypeCompany {
+		return TaxIDTypeCNPJ
+	}
+
+	return TaxIDTypeCPF
+}
+
+//TaxIDType define o tipo de documento fiscal do titular de uma Account
+type TaxIDType string
+
+const (
+	//TaxIDTypeCPF é o documento de pessoas físicas
+	TaxIDTypeCPF TaxIDType = "cpf"
+
+	//TaxIDTypeCNPJ é o documento de pessoas jurídicas
+	TaxIDTypeCNPJ TaxIDType = "cnpj"
+)
+
+//String converte o tipo TaxIDType para uma string
+func (t TaxIDType) String() string {
+	return string(t)
+}
+
 //Account armazena a estrutura de uma conta
 type Account struct {
-	id        AccountID
-	name      string
-	cpf       string
-	balance   Money
-	createdAt time.Time
+	id             AccountID
+	name           string
+	taxID          string
+	taxIDType      TaxIDType
+	balance        Money
+	initialBalance Money
+	createdAt      time.Time
 }
 
 //NewAccount cria um Account somento com o Balance
@@ -50,13 +98,23 @@ func NewAccountBalance(balance Money) Account {
 }
 
 //NewAccount cria um Account
-func NewAccount(ID AccountID, name, CPF string, balance Money, createdAt time.Time) Account {
+func NewAccount(
+	ID AccountID,
+	name string,
+	taxID string,
+	taxIDType TaxIDType,
+	balance Money,
+	initialBalance Money,
+	createdAt time.Time,
+) Account {
 	return Account{
-		id:        ID,
-		name:      name,
-		cpf:       CPF,
-		balance:   balance,
-		createdAt: createdAt,
+		id:             ID,
+		name:           name,
+		taxID:          taxID,
+		taxIDType:      taxIDType,
+		balance:        balance,
+		initialBalance: initialBalance,
+		createdAt:      createdAt,
 	}
 }
 
@@ -65,6 +123,11 @@ func (a *Account) Deposit(amount Money) {
 	a.balance += amount
 }
 
+//Correct soma ao Balance o valor, com sinal, de uma BalanceCorrection
+func (a *Account) Correct(correction BalanceCorrection) {
+	a.balance += correction.Amount()
+}
+
 //Withdraw remove um valor no Balance
 func (a *Account) Withdraw(amount Money) error {
 	if a.balance < amount {
@@ -86,9 +149,14 @@ func (a Account) Name() string {
 	return a.name
 }
 
-//CPF
-func (a Account) CPF() string {
-	return a.cpf
+//TaxID
+func (a Account) TaxID() string {
+	return a.taxID
+}
+
+//TaxIDType
+func (a Account) TaxIDType() TaxIDType {
+	return a.taxIDType
 }
 
 //Balance
@@ -96,6 +164,11 @@ func (a Account) Balance() Money {
 	return a.balance
 }
 
+//InitialBalance retorna o saldo depositado na abertura da Account
+func (a Account) InitialBalance() Money {
+	return a.initialBalance
+}
+
 //CreatedAt
 func (a Account) CreatedAt() time.Time {
 	return a.createdAt
@@ -151,11 +151,13 @@ func TestNewAccount(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		ID        AccountID
-		name      string
-		CPF       string
-		balance   Money
-		createdAt time.Time
+		ID             AccountID
+		name           string
+		taxID          string
+		taxIDType      TaxIDType
+		balance        Money
+		initialBalance Money
+		createdAt      time.Time
 	}
 
 	tests := []struct {
@@ -166,11 +168,13 @@ func TestNewAccount(t *testing.T) {
 		{
 			name: "Create Account instance",
 			args: args{
-				ID:        "",
-				name:      "",
-				CPF:       "",
-				balance:   0,
-				createdAt: time.Time{},
+				ID:             "",
+				name:           "",
+				taxID:          "",
+				taxIDType:      "",
+				balance:        0,
+				initialBalance: 0,
+				createdAt:      time.Time{},
 			},
 			expected: Account{},
 		},
@@ -178,7 +182,15 @@ func TestNewAccount(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := NewAccount(tt.args.ID, tt.args.name, tt.args.CPF, tt.args.balance, tt.args.createdAt)
+			result := NewAccount(
+				tt.args.ID,
+				tt.args.name,
+				tt.args.taxID,
+				tt.args.taxIDType,
+				tt.args.balance,
+				tt.args.initialBalance,
+				tt.args.createdAt,
+			)
 
 			if !reflect.DeepEqual(result, tt.expected) {
 				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+//ErrAPIKeyInactive é um erro de uso de uma APIKey revogada ou expirada
+var ErrAPIKeyInactive = errors.New("api key is revoked or expired")
+
+//APIKeyRepository expõe os métodos disponíveis para as abstrações do repositório de APIKey
+type APIKeyRepository interface {
+	Store(context.Context, APIKey) (APIKey, error)
+	Update(context.Context, APIKey) error
+	UpdateLastUsedAt(context.Context, APIKeyID, time.Time) error
+	FindAll(context.Context) ([]APIKey, error)
+	FindByID(context.Context, APIKeyID) (APIKey, error)
+	FindByHash(context.Context, string) (APIKey, error)
+}
+
+//APIKeyID define o tipo identificador de uma APIKey
+type APIKeyID string
+
+//String converte o tipo APIKeyID para uma string
+func (a APIKeyID) String() string {
+	return string(a)
+}
+
+//APIKey armazena uma credencial de longa duração de acesso à API, da qual apenas o hash é persistido
+//
+//Quando accountID é informado, a APIKey opera a Account como sua titular. As datas zeradas indicam que a APIKey
+//não expira, nunca foi usada ou não foi revogada
+type APIKey struct {
+	id         APIKeyID
+	name       string
+	prefix     string
+	hash       string
+	scopes     []Scope
+	accountID  AccountID
+	expiresAt  time.Time
+	lastUsedAt time.Time
+	revokedAt  time.Time
+	createdAt  time.Time
+}
+
+//NewAPIKey cria uma APIKey
+func NewAPIKey(
+	ID APIKeyID,
+	name string,
+	prefix string,
+	hash string,
+	scopes []Scope,
+	accountID AccountID,
+	expiresAt time.Time,
+	lastUsedAt time.Time,
+	revokedAt time.Time,
+	createdAt time.Time,
+) APIKey {
+	return APIKey{
+		id:         ID,
+		name:       name,
+		prefix:     prefix,
+		hash:       hash,
+		scopes:     scopes,
+		accountID:  accountID,
+		expiresAt:  expiresAt,
+		lastUsedAt: lastUsedAt,
+		revokedAt:  revokedAt,
+		createdAt:  createdAt,
+	}
+}
+
+//IsActive informa se a APIKey pode ser usada no instante informado
+func (a APIKey) IsActive(now time.Time) bool {
+	if !a.revokedAt.IsZero() {
+		return false
+	}
+
+	return a.expiresAt.IsZero() || now.Before(a.expiresAt)
+}
+
+//Revoke revoga a APIKey, que deixa de ser aceita imediatamente
+func (a *APIKey) Revoke(now time.Time) {
+	if a.revokedAt.IsZero() {
+		a.revokedAt = now
+	}
+}
+
+//ExpireAfter antecipa a expiração da APIKey, mantendo-a válida por mais overlap enquanto os clientes adotam a nova
+//chave de uma rotação. A expiração nunca é adiada
+func (a *APIKey) ExpireAfter(now time.Time, overlap time.Duration) {
+	var expiresAt = now.Add(overlap)
+	if a.expiresAt.IsZero() || expiresAt.Before(a.expiresAt) {
+		a.expiresAt = expiresAt
+	}
+}
+
+//Lifetime retorna a duração da validade da APIKey a partir da criação, zero quando não expira
+func (a APIKey) Lifetime() time.Duration {
+	if a.expiresAt.IsZero() {
+		return 0
+	}
+
+	return a.expiresAt.Sub(a.createdAt)
+}
+
+//ID retorna o identificador da APIKey
+func (a APIKey) ID() APIKeyID {
+	return a.id
+}
+
+//Name retorna a descrição da APIKey
+func (a APIKey) Name() string {
+	return a.name
+}
+
+//Prefix retorna o início da chave, que permite identificá-la sem expô-la
+func (a APIKey) Prefix() string {
+	return a.prefix
+}
+
+//Hash retorna o hash da chave
+func (a APIKey) Hash() string {
+	return a.hash
+}
+
+//Scopes retorna os escopos concedidos à APIKey
+func (a APIKey) Scopes() []Scope {
+	return a.scopes
+}
+
+//AccountID retorna a Account operada pela APIKey, vazio quando nenhuma
+func (a APIKey) AccountID() AccountID {
+	return a.accountID
+}
+
+//ExpiresAt retorna a data de expiração da APIKey
+func (a APIKey) ExpiresAt() time.Time {
+	return a.expiresAt
+}
+
+//LastUsedAt retorna a data do último uso da APIKey
+func (a APIKey) LastUsedAt() time.Time {
+	return a.lastUsedAt
+}
+
+//RevokedAt retorna a data de revogação da APIKey
+func (a APIKey) RevokedAt() time.Time {
+	return a.revokedAt
+}
+
+//CreatedAt retorna a data de criação da APIKey
+func (a APIKey) CreatedAt() time.Time {
+	return a.createdAt
+}
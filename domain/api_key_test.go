@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAPIKey_IsActive(t *testing.T) {
+	var now = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		revokedAt time.Time
+		expected  bool
+	}{
+		{
+			name:     "Key without expiration",
+			expected: true,
+		},
+		{
+			name:      "Key before expiration",
+			expiresAt: now.Add(time.Second),
+			expected:  true,
+		},
+		{
+			name:      "Expired key",
+			expiresAt: now,
+			expected:  false,
+		},
+		{
+			name:      "Revoked key",
+			revokedAt: now.Add(-time.Hour),
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		var key = NewAPIKey("1", "batch", "gbk_", "hash", nil, "", tt.expiresAt, time.Time{}, tt.revokedAt, now)
+
+		if result := key.IsActive(now); result != tt.expected {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+		}
+	}
+}
+
+func TestAPIKey_ExpireAfter(t *testing.T) {
+	var now = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		overlap   time.Duration
+		expected  time.Time
+	}{
+		{
+			name:     "Key without expiration",
+			overlap:  time.Hour,
+			expected: now.Add(time.Hour),
+		},
+		{
+			name:      "Key expiring after the overlap",
+			expiresAt: now.Add(48 * time.Hour),
+			overlap:   time.Hour,
+			expected:  now.Add(time.Hour),
+		},
+		{
+			name:      "Key expiring before the overlap",
+			expiresAt: now.Add(time.Minute),
+			overlap:   time.Hour,
+			expected:  now.Add(time.Minute),
+		},
+		{
+			name:     "Rotation without overlap",
+			expected: now,
+		},
+	}
+
+	for _, tt := range tests {
+		var key = NewAPIKey("1", "batch", "gbk_", "hash", nil, "", tt.expiresAt, time.Time{}, time.Time{}, now)
+
+		key.ExpireAfter(now, tt.overlap)
+
+		if !key.ExpiresAt().Equal(tt.expected) {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, key.ExpiresAt(), tt.expected)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	//ErrBalanceCorrectionAlreadyExists é um erro de BalanceCorrection pendente já aberta para a Account
+	ErrBalanceCorrectionAlreadyExists = errors.New("pending balance correction already exists")
+
+	//ErrBalanceCorrectionNotPending é um erro de aprovação ou recusa de uma BalanceCorrection já decidida
+	ErrBalanceCorrectionNotPending = errors.New("balance correction is not pending")
+)
+
+//BalanceCorrectionRepository expõe os métodos disponíveis para as abstrações do repositório de BalanceCorrection
+type BalanceCorrectionRepository interface {
+	Store(context.Context, BalanceCorrection) (BalanceCorrection, error)
+	UpdateStatus(context.Context, BalanceCorrectionID, BalanceCorrectionStatus) error
+	FindByID(context.Context, BalanceCorrectionID) (BalanceCorrection, error)
+	FindPendingByAccountID(context.Context, AccountID) (BalanceCorrection, error)
+}
+
+//BalanceCorrectionID define o tipo identificador de uma BalanceCorrection
+type BalanceCorrectionID string
+
+//String converte o tipo BalanceCorrectionID para uma string
+func (b BalanceCorrectionID) String() string {
+	return string(b)
+}
+
+//BalanceCorrectionStatus define a situação de uma BalanceCorrection
+type BalanceCorrectionStatus string
+
+const (
+	//BalanceCorrectionStatusPending representa uma BalanceCorrection aguardando aprovação
+	BalanceCorrectionStatusPending BalanceCorrectionStatus = "pending"
+
+	//BalanceCorrectionStatusApproved representa uma BalanceCorrection aprovada e aplicada ao Balance
+	BalanceCorrectionStatusApproved BalanceCorrectionStatus = "approved"
+
+	//BalanceCorrectionStatusRejected representa uma BalanceCorrection recusada
+	BalanceCorrectionStatusRejected BalanceCorrectionStatus = "rejected"
+)
+
+//String converte o tipo BalanceCorrectionStatus para uma string
+func (b BalanceCorrectionStatus) String() string {
+	return string(b)
+}
+
+//BalanceCorrection armazena um lançamento de ajuste do Balance de uma Account, que só é aplicado após aprovação
+type BalanceCorrection struct {
+	id        BalanceCorrectionID
+	accountID AccountID
+	amount    Money
+	status    BalanceCorrectionStatus
+	createdAt time.Time
+}
+
+//NewBalanceCorrection cria uma BalanceCorrection
+func NewBalanceCorrection(
+	ID BalanceCorrectionID,
+	accountID AccountID,
+	amount Money,
+	status BalanceCorrectionStatus,
+	createdAt time.Time,
+) BalanceCorrection {
+	return BalanceCorrection{
+		id:        ID,
+		accountID: accountID,
+		amount:    amount,
+		status:    status,
+		createdAt: createdAt,
+	}
+}
+
+//Approve aprova a BalanceCorrection, retornando ErrBalanceCorrectionNotPending quando ela já foi decidida
+func (b *BalanceCorrection) Approve() error {
+	return b.decide(BalanceCorrectionStatusApproved)
+}
+
+//Reject recusa a BalanceCorrection, retornando ErrBalanceCorrectionNotPending quando ela já foi decidida
+func (b *BalanceCorrection) Reject() error {
+	return b.decide(BalanceCorrectionStatusRejected)
+}
+
+func (b *BalanceCorrection) decide(status BalanceCorrectionStatus) error {
+	if b.status != BalanceCorrectionStatusPending {
+		return ErrBalanceCorrectionNotPending
+	}
+
+	b.status = status
+	return nil
+}
+
+//ID
+func (b BalanceCorrection) ID() BalanceCorrectionID {
+	return b.id
+}
+
+//AccountID
+func (b BalanceCorrection) AccountID() AccountID {
+	return b.accountID
+}
+
+//Amount retorna o valor, com sinal, a ser somado ao Balance da Account
+func (b BalanceCorrection) Amount() Money {
+	return b.amount
+}
+
+//Status
+func (b BalanceCorrection) Status() BalanceCorrectionStatus {
+	return b.status
+}
+
+//CreatedAt
+func (b BalanceCorrection) CreatedAt() time.Time {
+	return b.createdAt
+}
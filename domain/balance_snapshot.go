@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	//ErrBalanceSnapshotAlreadyExists é um erro de BalanceSnapshot já registrado para a Account no dia
+	ErrBalanceSnapshotAlreadyExists = errors.New("balance snapshot already exists")
+
+	//ErrDayNotClosed é um erro de fechamento de um dia que ainda não terminou
+	ErrDayNotClosed = errors.New("day has not ended yet")
+)
+
+//BalanceSnapshotRepository expõe os métodos disponíveis para as abstrações do repositório de BalanceSnapshot
+type BalanceSnapshotRepository interface {
+	Store(context.Context, BalanceSnapshot) (BalanceSnapshot, error)
+	FindLatest(context.Context, AccountID, time.Time) (BalanceSnapshot, error)
+}
+
+//BalanceSnapshot armazena o saldo de fechamento de uma Account em um dia
+type BalanceSnapshot struct {
+	accountID AccountID
+	date      time.Time
+	balance   Money
+	createdAt time.Time
+}
+
+//NewBalanceSnapshot cria um BalanceSnapshot, o dia é considerado em UTC
+func NewBalanceSnapshot(accountID AccountID, date time.Time, balance Money, createdAt time.Time) BalanceSnapshot {
+	return BalanceSnapshot{
+		accountID: accountID,
+		date:      TruncateDay(date),
+		balance:   balance,
+		createdAt: createdAt,
+	}
+}
+
+//TruncateDay retorna o início do dia em UTC
+func TruncateDay(t time.Time) time.Time {
+	var utc = t.UTC()
+	return time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+//AccountID
+func (b BalanceSnapshot) AccountID() AccountID {
+	return b.accountID
+}
+
+//Date retorna o dia do fechamento
+func (b BalanceSnapshot) Date() time.Time {
+	return b.date
+}
+
+//ClosedAt retorna o instante, exclusivo, até o qual as movimentações estão incluídas no saldo
+func (b BalanceSnapshot) ClosedAt() time.Time {
+	return b.date.Add(24 * time.Hour)
+}
+
+//Balance retorna o saldo de fechamento do dia
+func (b BalanceSnapshot) Balance() Money {
+	return b.balance
+}
+
+//CreatedAt
+func (b BalanceSnapshot) CreatedAt() time.Time {
+	return b.createdAt
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewBalanceSnapshot(t *testing.T) {
+	tests := []struct {
+		name             string
+		date             time.Time
+		expectedDate     time.Time
+		expectedClosedAt time.Time
+	}{
+		{
+			name:             "Date in UTC",
+			date:             time.Date(2020, 3, 31, 23, 59, 0, 0, time.UTC),
+			expectedDate:     time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC),
+			expectedClosedAt: time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Date in another timezone",
+			date:             time.Date(2020, 3, 31, 22, 0, 0, 0, time.FixedZone("BRT", -3*60*60)),
+			expectedDate:     time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+			expectedClosedAt: time.Date(2020, 4, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result = NewBalanceSnapshot("3c096a40-ccba-4b58-93ed-57379ab04680", tt.date, 100, time.Time{})
+
+			if !result.Date().Equal(tt.expectedDate) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.Date(), tt.expectedDate)
+			}
+
+			if !result.ClosedAt().Equal(tt.expectedClosedAt) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.ClosedAt(), tt.expectedClosedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package domain
+
+import "strings"
+
+const cnpjLength = 14
+
+var (
+	cnpjFirstDigitWeights  = []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	cnpjSecondDigitWeights = []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+)
+
+//IsValidCNPJ verifica se um CNPJ é válido, aceitando tanto o formato numérico quanto o alfanumérico
+func IsValidCNPJ(cnpj string) bool {
+	cnpj = CleanTaxID(cnpj)
+	if len(cnpj) != cnpjLength {
+		return false
+	}
+
+	for i, c := range cnpj {
+		var (
+			isDigit  = c >= '0' && c <= '9'
+			isLetter = c >= 'A' && c <= 'Z'
+		)
+
+		//somente a raiz e a ordem podem conter letras, os dígitos verificadores são sempre numéricos
+		if !isDigit && !(isLetter && i < len(cnpjFirstDigitWeights)) {
+			return false
+		}
+	}
+
+	if strings.Count(cnpj, cnpj[:1]) == cnpjLength {
+		return false
+	}
+
+	var (
+		first  = cnpjCheckDigit(cnpj, cnpjFirstDigitWeights)
+		second = cnpjCheckDigit(cnpj, cnpjSecondDigitWeights)
+	)
+
+	return cnpj[12] == first && cnpj[13] == second
+}
+
+//CleanTaxID remove a máscara de um documento e normaliza as letras para maiúsculas
+func CleanTaxID(taxID string) string {
+	return strings.ToUpper(strings.NewReplacer(".", "", "-", "", "/", "", " ", "").Replace(taxID))
+}
+
+func cnpjCheckDigit(cnpj string, weights []int) byte {
+	var sum int
+	for i, weight := range weights {
+		//o valor de cada caractere é o seu código ASCII menos 48, o que mantém os dígitos com seu valor numérico
+		sum += int(cnpj[i]-'0') * weight
+	}
+
+	var rest = sum % 11
+	if rest < 2 {
+		return '0'
+	}
+
+	return byte('0' + 11 - rest)
+}
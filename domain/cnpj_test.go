@@ -0,0 +1,76 @@
+package domain
+
+import "testing"
+
+func TestIsValidCNPJ(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		cnpj string
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected bool
+	}{
+		{
+			name:     "Valid numeric CNPJ",
+			args:     args{cnpj: "11222333000181"},
+			expected: true,
+		},
+		{
+			name:     "Valid numeric CNPJ with mask",
+			args:     args{cnpj: "11.444.777/0001-61"},
+			expected: true,
+		},
+		{
+			name:     "Valid alphanumeric CNPJ with mask",
+			args:     args{cnpj: "12.ABC.345/01DE-35"},
+			expected: true,
+		},
+		{
+			name:     "Valid alphanumeric CNPJ lowercase",
+			args:     args{cnpj: "12abc34501de35"},
+			expected: true,
+		},
+		{
+			name:     "Invalid check digits",
+			args:     args{cnpj: "11222333000182"},
+			expected: false,
+		},
+		{
+			name:     "Invalid alphanumeric check digits",
+			args:     args{cnpj: "12ABC34501DE53"},
+			expected: false,
+		},
+		{
+			name:     "Invalid letter in check digits",
+			args:     args{cnpj: "12ABC34501DE3A"},
+			expected: false,
+		},
+		{
+			name:     "Invalid repeated digits",
+			args:     args{cnpj: "00000000000000"},
+			expected: false,
+		},
+		{
+			name:     "Invalid length",
+			args:     args{cnpj: "1122233300018"},
+			expected: false,
+		},
+		{
+			name:     "Invalid characters",
+			args:     args{cnpj: "11222333#00181"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := IsValidCNPJ(tt.args.cnpj); result != tt.expected {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package domain
+
+import "time"
+
+//BalanceDiscrepancy armazena a divergência entre o saldo de fechamento de uma Account e o esperado pelo histórico
+type BalanceDiscrepancy struct {
+	accountID AccountID
+	expected  Money
+	closing   Money
+}
+
+//NewBalanceDiscrepancy cria um BalanceDiscrepancy
+func NewBalanceDiscrepancy(accountID AccountID, expected Money, closing Money) BalanceDiscrepancy {
+	return BalanceDiscrepancy{
+		accountID: accountID,
+		expected:  expected,
+		closing:   closing,
+	}
+}
+
+//AccountID
+func (b BalanceDiscrepancy) AccountID() AccountID {
+	return b.accountID
+}
+
+//Expected retorna o fechamento anterior somado às movimentações do dia
+func (b BalanceDiscrepancy) Expected() Money {
+	return b.expected
+}
+
+//Closing retorna o saldo de fechamento registrado
+func (b BalanceDiscrepancy) Closing() Money {
+	return b.closing
+}
+
+//Difference retorna quanto o saldo de fechamento excede o esperado
+func (b BalanceDiscrepancy) Difference() Money {
+	return b.closing - b.expected
+}
+
+//DailyClosing armazena o resultado do fechamento dos saldos de um dia
+type DailyClosing struct {
+	date          time.Time
+	snapshots     []BalanceSnapshot
+	alreadyClosed []AccountID
+	discrepancies []BalanceDiscrepancy
+}
+
+//NewDailyClosing cria um DailyClosing para o dia de date em UTC
+func NewDailyClosing(date time.Time) DailyClosing {
+	return DailyClosing{
+		date:          TruncateDay(date),
+		snapshots:     make([]BalanceSnapshot, 0),
+		alreadyClosed: make([]AccountID, 0),
+		discrepancies: make([]BalanceDiscrepancy, 0),
+	}
+}
+
+//Close registra o BalanceSnapshot de uma Account e a divergência quando o fechamento difere do esperado
+func (d *DailyClosing) Close(snapshot BalanceSnapshot, expected Money) {
+	d.snapshots = append(d.snapshots, snapshot)
+
+	if snapshot.Balance() != expected {
+		d.discrepancies = append(
+			d.discrepancies,
+			NewBalanceDiscrepancy(snapshot.AccountID(), expected, snapshot.Balance()),
+		)
+	}
+}
+
+//Skip registra uma Account que já possuía BalanceSnapshot no dia
+func (d *DailyClosing) Skip(accountID AccountID) {
+	d.alreadyClosed = append(d.alreadyClosed, accountID)
+}
+
+//Date retorna o dia do fechamento
+func (d DailyClosing) Date() time.Time {
+	return d.date
+}
+
+//ClosedAt retorna o instante, exclusivo, até o qual as movimentações estão incluídas no fechamento
+func (d DailyClosing) ClosedAt() time.Time {
+	return d.date.Add(24 * time.Hour)
+}
+
+//Snapshots retorna os BalanceSnapshot registrados no fechamento
+func (d DailyClosing) Snapshots() []BalanceSnapshot {
+	return d.snapshots
+}
+
+//AlreadyClosed retorna as Account ignoradas por já possuírem BalanceSnapshot no dia
+func (d DailyClosing) AlreadyClosed() []AccountID {
+	return d.alreadyClosed
+}
+
+//Discrepancies retorna as Account cujo fechamento difere do fechamento anterior somado às movimentações do dia
+func (d DailyClosing) Discrepancies() []BalanceDiscrepancy {
+	return d.discrepancies
+}
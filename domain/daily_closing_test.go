@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDailyClosing_Close(t *testing.T) {
+	var (
+		date    = time.Date(2020, 3, 31, 15, 0, 0, 0, time.UTC)
+		closing = NewDailyClosing(date)
+	)
+
+	closing.Close(NewBalanceSnapshot("3c096a40-ccba-4b58-93ed-57379ab04680", date, 100, time.Time{}), 100)
+	closing.Close(NewBalanceSnapshot("3c096a40-ccba-4b58-93ed-57379ab04699", date, 150, time.Time{}), 200)
+	closing.Skip("3c096a40-ccba-4b58-93ed-57379ab04611")
+
+	if !closing.Date().Equal(time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Date: '%v' | Expected: '%v'", closing.Date(), "2020-03-31")
+	}
+
+	if !closing.ClosedAt().Equal(time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ClosedAt: '%v' | Expected: '%v'", closing.ClosedAt(), "2020-04-01")
+	}
+
+	if len(closing.Snapshots()) != 2 {
+		t.Errorf("Snapshots: '%v' | Expected: '%v'", len(closing.Snapshots()), 2)
+	}
+
+	var expectedSkipped = []AccountID{"3c096a40-ccba-4b58-93ed-57379ab04611"}
+	if !reflect.DeepEqual(closing.AlreadyClosed(), expectedSkipped) {
+		t.Errorf("AlreadyClosed: '%v' | Expected: '%v'", closing.AlreadyClosed(), expectedSkipped)
+	}
+
+	var expectedDiscrepancies = []BalanceDiscrepancy{
+		NewBalanceDiscrepancy("3c096a40-ccba-4b58-93ed-57379ab04699", 200, 150),
+	}
+	if !reflect.DeepEqual(closing.Discrepancies(), expectedDiscrepancies) {
+		t.Errorf("Discrepancies: '%v' | Expected: '%v'", closing.Discrepancies(), expectedDiscrepancies)
+	}
+
+	if closing.Discrepancies()[0].Difference() != -50 {
+		t.Errorf("Difference: '%v' | Expected: '%v'", closing.Discrepancies()[0].Difference(), -50)
+	}
+}
@@ -0,0 +1,211 @@
+package domain
+
+import "time"
+
+//EventType define o tipo de um evento de domínio
+type EventType string
+
+const (
+	//EventAccountCreated representa a criação de uma Account
+	EventAccountCreated EventType = "account.created"
+
+	//EventBalanceChanged representa a alteração do Balance de uma Account
+	EventBalanceChanged EventType = "balance.changed"
+
+	//EventTransferCompleted representa uma Transfer concluída
+	EventTransferCompleted EventType = "transfer.completed"
+
+	//EventTransferFailed representa uma Transfer que não pôde ser concluída
+	EventTransferFailed EventType = "transfer.failed"
+)
+
+//String converte o tipo EventType para uma string
+func (e EventType) String() string {
+	return string(e)
+}
+
+//IsValid informa se o EventType corresponde a um dos eventos de domínio publicados
+func (e EventType) IsValid() bool {
+	switch e {
+	case EventAccountCreated, EventBalanceChanged, EventTransferCompleted, EventTransferFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+//Event é uma abstração para os fatos ocorridos no domínio
+type Event interface {
+	Type() EventType
+	AccountIDs() []AccountID
+	OccurredAt() time.Time
+}
+
+//AccountCreated armazena o evento de criação de uma Account
+type AccountCreated struct {
+	account Account
+}
+
+//NewAccountCreated cria um AccountCreated
+func NewAccountCreated(account Account) AccountCreated {
+	return AccountCreated{account: account}
+}
+
+//Type
+func (a AccountCreated) Type() EventType {
+	return EventAccountCreated
+}
+
+//AccountIDs retorna a Account criada
+func (a AccountCreated) AccountIDs() []AccountID {
+	return []AccountID{a.account.ID()}
+}
+
+//OccurredAt
+func (a AccountCreated) OccurredAt() time.Time {
+	return a.account.CreatedAt()
+}
+
+//Account
+func (a AccountCreated) Account() Account {
+	return a.account
+}
+
+//BalanceChanged armazena o evento de alteração do Balance de uma Account
+type BalanceChanged struct {
+	accountID  AccountID
+	previous   Money
+	current    Money
+	occurredAt time.Time
+}
+
+//NewBalanceChanged cria um BalanceChanged
+func NewBalanceChanged(accountID AccountID, previous Money, current Money, occurredAt time.Time) BalanceChanged {
+	return BalanceChanged{
+		accountID:  accountID,
+		previous:   previous,
+		current:    current,
+		occurredAt: occurredAt,
+	}
+}
+
+//Type
+func (b BalanceChanged) Type() EventType {
+	return EventBalanceChanged
+}
+
+//AccountIDs retorna a Account alterada
+func (b BalanceChanged) AccountIDs() []AccountID {
+	return []AccountID{b.accountID}
+}
+
+//OccurredAt
+func (b BalanceChanged) OccurredAt() time.Time {
+	return b.occurredAt
+}
+
+//AccountID
+func (b BalanceChanged) AccountID() AccountID {
+	return b.accountID
+}
+
+//Previous retorna o Balance antes da alteração
+func (b BalanceChanged) Previous() Money {
+	return b.previous
+}
+
+//Current retorna o Balance após a alteração
+func (b BalanceChanged) Current() Money {
+	return b.current
+}
+
+//TransferCompleted armazena o evento de conclusão de uma Transfer
+type TransferCompleted struct {
+	transfer Transfer
+}
+
+//NewTransferCompleted cria um TransferCompleted
+func NewTransferCompleted(transfer Transfer) TransferCompleted {
+	return TransferCompleted{transfer: transfer}
+}
+
+//Type
+func (t TransferCompleted) Type() EventType {
+	return EventTransferCompleted
+}
+
+//AccountIDs retorna as Account de origem e de destino
+func (t TransferCompleted) AccountIDs() []AccountID {
+	return []AccountID{t.transfer.AccountOriginID(), t.transfer.AccountDestinationID()}
+}
+
+//OccurredAt
+func (t TransferCompleted) OccurredAt() time.Time {
+	return t.transfer.CreatedAt()
+}
+
+//Transfer
+func (t TransferCompleted) Transfer() Transfer {
+	return t.transfer
+}
+
+//TransferFailed armazena o evento de uma Transfer que não pôde ser concluída
+type TransferFailed struct {
+	accountOriginID      AccountID
+	accountDestinationID AccountID
+	amount               Money
+	reason               error
+	occurredAt           time.Time
+}
+
+//NewTransferFailed cria um TransferFailed
+func NewTransferFailed(
+	accountOriginID AccountID,
+	accountDestinationID AccountID,
+	amount Money,
+	reason error,
+	occurredAt time.Time,
+) TransferFailed {
+	return TransferFailed{
+		accountOriginID:      accountOriginID,
+		accountDestinationID: accountDestinationID,
+		amount:               amount,
+		reason:               reason,
+		occurredAt:           occurredAt,
+	}
+}
+
+//Type
+func (t TransferFailed) Type() EventType {
+	return EventTransferFailed
+}
+
+//AccountIDs retorna as Account de origem e de destino
+func (t TransferFailed) AccountIDs() []AccountID {
+	return []AccountID{t.accountOriginID, t.accountDestinationID}
+}
+
+//OccurredAt
+func (t TransferFailed) OccurredAt() time.Time {
+	return t.occurredAt
+}
+
+//AccountOriginID
+func (t TransferFailed) AccountOriginID() AccountID {
+	return t.accountOriginID
+}
+
+//AccountDestinationID
+func (t TransferFailed) AccountDestinationID() AccountID {
+	return t.accountDestinationID
+}
+
+//Amount
+func (t TransferFailed) Amount() Money {
+	return t.amount
+}
+
+//Reason retorna o erro que impediu a conclusão da Transfer
+func (t TransferFailed) Reason() error {
+	return t.reason
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEvent_AccountIDs(t *testing.T) {
+	var occurredAt = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		event              Event
+		expectedType       EventType
+		expectedAccountIDs []AccountID
+	}{
+		{
+			name: "AccountCreated",
+			event: NewAccountCreated(NewAccount(
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				"Test",
+				"02815517078",
+				TaxIDTypeCPF,
+				100,
+				100,
+				occurredAt,
+			)),
+			expectedType:       EventAccountCreated,
+			expectedAccountIDs: []AccountID{"3c096a40-ccba-4b58-93ed-57379ab04680"},
+		},
+		{
+			name:               "BalanceChanged",
+			event:              NewBalanceChanged("3c096a40-ccba-4b58-93ed-57379ab04680", 100, 50, occurredAt),
+			expectedType:       EventBalanceChanged,
+			expectedAccountIDs: []AccountID{"3c096a40-ccba-4b58-93ed-57379ab04680"},
+		},
+		{
+			name: "TransferCompleted",
+			event: NewTransferCompleted(NewTransfer(
+				"b51cd6c7-a55c-491e-9140-91903fe66fa9",
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				"3c096a40-ccba-4b58-93ed-57379ab04699",
+				50,
+				TransferStatusCompleted,
+				occurredAt,
+			)),
+			expectedType: EventTransferCompleted,
+			expectedAccountIDs: []AccountID{
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				"3c096a40-ccba-4b58-93ed-57379ab04699",
+			},
+		},
+		{
+			name: "TransferFailed",
+			event: NewTransferFailed(
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				"3c096a40-ccba-4b58-93ed-57379ab04699",
+				50,
+				ErrInsufficientBalance,
+				occurredAt,
+			),
+			expectedType: EventTransferFailed,
+			expectedAccountIDs: []AccountID{
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				"3c096a40-ccba-4b58-93ed-57379ab04699",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.event.Type() != tt.expectedType {
+				t.Errorf("[TestCase '%s'] Type: '%v' | Expected: '%v'", tt.name, tt.event.Type(), tt.expectedType)
+			}
+
+			if !reflect.DeepEqual(tt.event.AccountIDs(), tt.expectedAccountIDs) {
+				t.Errorf("[TestCase '%s'] AccountIDs: '%v' | Expected: '%v'", tt.name, tt.event.AccountIDs(), tt.expectedAccountIDs)
+			}
+
+			if !tt.event.OccurredAt().Equal(occurredAt) {
+				t.Errorf("[TestCase '%s'] OccurredAt: '%v' | Expected: '%v'", tt.name, tt.event.OccurredAt(), occurredAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	//ErrInvalidClient é um erro de autenticação de um OAuthClient inexistente ou com o secret incorreto
+	ErrInvalidClient = errors.New("invalid client credentials")
+
+	//ErrInvalidScope é um erro de solicitação de escopos não concedidos ao OAuthClient
+	ErrInvalidScope = errors.New("scope not allowed for the client")
+)
+
+//OAuthClientRepository expõe os métodos disponíveis para as abstrações do repositório de OAuthClient
+type OAuthClientRepository interface {
+	Store(context.Context, OAuthClient) (OAuthClient, error)
+	Delete(context.Context, OAuthClientID) error
+	FindAll(context.Context) ([]OAuthClient, error)
+	FindByID(context.Context, OAuthClientID) (OAuthClient, error)
+}
+
+//OAuthClientID define o tipo identificador de um OAuthClient
+type OAuthClientID string
+
+//String converte o tipo OAuthClientID para uma string
+func (o OAuthClientID) String() string {
+	return string(o)
+}
+
+//OAuthClient armazena o cadastro de um parceiro que obtém tokens de acesso através do grant client_credentials,
+//do qual apenas o hash do secret é persistido
+//
+//Quando accountID é informado, os tokens emitidos operam a Account como sua titular
+type OAuthClient struct {
+	id         OAuthClientID
+	name       string
+	secretHash string
+	scopes     []Scope
+	accountID  AccountID
+	createdAt  time.Time
+}
+
+//NewOAuthClient cria um OAuthClient
+func NewOAuthClient(
+	ID OAuthClientID,
+	name string,
+	secretHash string,
+	scopes []Scope,
+	accountID AccountID,
+	createdAt time.Time,
+) OAuthClient {
+	return OAuthClient{
+		id:         ID,
+		name:       name,
+		secretHash: secretHash,
+		scopes:     scopes,
+		accountID:  accountID,
+		createdAt:  createdAt,
+	}
+}
+
+//GrantScopes retorna os escopos do token solicitado, todos os escopos do OAuthClient quando nenhum é solicitado
+//
+//Retorna ErrInvalidScope quando algum escopo solicitado não foi concedido ao OAuthClient, o escopo admin concede
+//todos os demais
+func (o OAuthClient) GrantScopes(requested []Scope) ([]Scope, error) {
+	if len(requested) == 0 {
+		return o.scopes, nil
+	}
+
+	for _, scope := range requested {
+		if !o.hasScope(scope) {
+			return nil, ErrInvalidScope
+		}
+	}
+
+	return requested, nil
+}
+
+func (o OAuthClient) hasScope(scope Scope) bool {
+	for _, s := range o.scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+
+	return false
+}
+
+//ID retorna o id do OAuthClient, utilizado como client_id
+func (o OAuthClient) ID() OAuthClientID {
+	return o.id
+}
+
+//Name retorna o nome do OAuthClient
+func (o OAuthClient) Name() string {
+	return o.name
+}
+
+//SecretHash retorna o hash do secret do OAuthClient
+func (o OAuthClient) SecretHash() string {
+	return o.secretHash
+}
+
+//Scopes retorna os escopos concedidos ao OAuthClient
+func (o OAuthClient) Scopes() []Scope {
+	return o.scopes
+}
+
+//AccountID retorna a Account operada pelo OAuthClient
+func (o OAuthClient) AccountID() AccountID {
+	return o.accountID
+}
+
+//CreatedAt retorna a data de criação do OAuthClient
+func (o OAuthClient) CreatedAt() time.Time {
+	return o.createdAt
+}
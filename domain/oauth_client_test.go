@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOAuthClient_GrantScopes(t *testing.T) {
+	tests := []struct {
+		name           string
+		clientScopes   []Scope
+		requested      []Scope
+		expectedScopes []Scope
+		expectedError  error
+	}{
+		{
+			name:           "Without requested scopes",
+			clientScopes:   []Scope{ScopeAccountsRead, ScopeTransfersWrite},
+			expectedScopes: []Scope{ScopeAccountsRead, ScopeTransfersWrite},
+		},
+		{
+			name:           "Subset of the client scopes",
+			clientScopes:   []Scope{ScopeAccountsRead, ScopeTransfersWrite},
+			requested:      []Scope{ScopeTransfersWrite},
+			expectedScopes: []Scope{ScopeTransfersWrite},
+		},
+		{
+			name:           "Admin client",
+			clientScopes:   []Scope{ScopeAdmin},
+			requested:      []Scope{ScopeWebhooksRead},
+			expectedScopes: []Scope{ScopeWebhooksRead},
+		},
+		{
+			name:          "Scope not granted to the client",
+			clientScopes:  []Scope{ScopeAccountsRead},
+			requested:     []Scope{ScopeAccountsRead, ScopeAdmin},
+			expectedError: ErrInvalidScope,
+		},
+	}
+
+	for _, tt := range tests {
+		var client = NewOAuthClient("1", "partner", "hash", tt.clientScopes, "", time.Time{})
+
+		scopes, err := client.GrantScopes(tt.requested)
+		if err != tt.expectedError {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, err, tt.expectedError)
+		}
+
+		if !reflect.DeepEqual(scopes, tt.expectedScopes) {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, scopes, tt.expectedScopes)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+//OutboxRepository expõe os métodos disponíveis para as abstrações do repositório de OutboxMessage
+type OutboxRepository interface {
+	Store(context.Context, ...OutboxMessage) error
+	FindPending(context.Context, int) ([]OutboxMessage, error)
+	FindSentSince(context.Context, time.Time, int) ([]OutboxMessage, error)
+	MarkSent(context.Context, OutboxMessageID, time.Time) error
+	RecordFailure(context.Context, OutboxMessageID, string) error
+	MarkFailed(context.Context, OutboxMessageID, string, time.Time) error
+	DeleteSentBefore(context.Context, time.Time) (int64, error)
+}
+
+//OutboxMessageID define o tipo identificador de uma OutboxMessage
+type OutboxMessageID string
+
+//String converte o tipo OutboxMessageID para uma string
+func (o OutboxMessageID) String() string {
+	return string(o)
+}
+
+//OutboxMessage armazena um Event serializado, gravado na mesma transação que o originou e aguardando publicação
+//
+//Mensagens gravadas juntas compartilham o createdAt e são ordenadas pela position. Uma mensagem que esgota as
+//tentativas de publicação é marcada como falha e deixa de ser buscada como pendente
+type OutboxMessage struct {
+	id         OutboxMessageID
+	eventType  EventType
+	accountIDs []AccountID
+	payload    []byte
+	position   int
+	attempts   int
+	createdAt  time.Time
+	sentAt     time.Time
+}
+
+//NewOutboxMessage cria uma OutboxMessage
+func NewOutboxMessage(
+	ID OutboxMessageID,
+	eventType EventType,
+	accountIDs []AccountID,
+	payload []byte,
+	position int,
+	createdAt time.Time,
+	sentAt time.Time,
+) OutboxMessage {
+	return OutboxMessage{
+		id:         ID,
+		eventType:  eventType,
+		accountIDs: accountIDs,
+		payload:    payload,
+		position:   position,
+		createdAt:  createdAt,
+		sentAt:     sentAt,
+	}
+}
+
+//WithAttempts retorna uma cópia da OutboxMessage com a quantidade de publicações que já falharam
+func (o OutboxMessage) WithAttempts(attempts int) OutboxMessage {
+	o.attempts = attempts
+	return o
+}
+
+//ID retorna o identificador da OutboxMessage
+func (o OutboxMessage) ID() OutboxMessageID {
+	return o.id
+}
+
+//EventType retorna o tipo do Event serializado
+func (o OutboxMessage) EventType() EventType {
+	return o.eventType
+}
+
+//AccountIDs retorna as Account envolvidas no Event, usadas para manter a ordem de publicação por Account
+func (o OutboxMessage) AccountIDs() []AccountID {
+	return o.accountIDs
+}
+
+//Payload retorna o Event serializado
+func (o OutboxMessage) Payload() []byte {
+	return o.payload
+}
+
+//Position retorna a ordem da OutboxMessage entre as gravadas no mesmo instante
+func (o OutboxMessage) Position() int {
+	return o.position
+}
+
+//Attempts retorna a quantidade de publicações da OutboxMessage que já falharam
+func (o OutboxMessage) Attempts() int {
+	return o.attempts
+}
+
+//CreatedAt retorna a data de gravação da OutboxMessage
+func (o OutboxMessage) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+//SentAt retorna a data de publicação da OutboxMessage, zero enquanto pendente
+func (o OutboxMessage) SentAt() time.Time {
+	return o.sentAt
+}
+
+//IsSent informa se a OutboxMessage já foi publicada
+func (o OutboxMessage) IsSent() bool {
+	return !o.sentAt.IsZero()
+}
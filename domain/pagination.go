@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	//DefaultPageLimit é a quantidade de itens por página quando nenhum limite é informado
+	DefaultPageLimit = 20
+
+	//MaxPageLimit é a quantidade máxima de itens por página
+	MaxPageLimit = 100
+
+	cursorSeparator = "|"
+)
+
+//ErrInvalidCursor é um erro de cursor de paginação malformado
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+//Cursor identifica o último item de uma página pelos campos ordenáveis e pelo ID, usado como desempate
+type Cursor struct {
+	createdAt time.Time
+	amount    Money
+	id        string
+}
+
+//NewCursor cria um Cursor a partir da data de criação e do identificador de um item
+func NewCursor(createdAt time.Time, ID string) Cursor {
+	return Cursor{createdAt: createdAt, id: ID}
+}
+
+//WithAmount retorna uma cópia do Cursor com o valor do item, usado na ordenação por amount
+func (c Cursor) WithAmount(amount Money) Cursor {
+	c.amount = amount
+	return c
+}
+
+//DecodeCursor converte um cursor opaco recebido do cliente em um Cursor
+func DecodeCursor(value string) (Cursor, error) {
+	if value == "" {
+		return Cursor{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var parts = strings.SplitN(string(raw), cursorSeparator, 3)
+	if len(parts) != 3 || parts[2] == "" {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	amount, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return NewCursor(createdAt, parts[2]).WithAmount(Money(amount)), nil
+}
+
+//Encode converte o Cursor em uma string opaca, vazia quando não há próxima página
+func (c Cursor) Encode() string {
+	if c.IsZero() {
+		return ""
+	}
+
+	var raw = strings.Join([]string{
+		c.createdAt.UTC().Format(time.RFC3339Nano),
+		strconv.FormatInt(c.amount.Int64(), 10),
+		c.id,
+	}, cursorSeparator)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+//IsZero informa se o Cursor aponta para o início da listagem
+func (c Cursor) IsZero() bool {
+	return c.id == ""
+}
+
+//CreatedAt retorna a data de criação do último item da página
+func (c Cursor) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+//Amount retorna o valor do último item da página
+func (c Cursor) Amount() Money {
+	return c.amount
+}
+
+//ID retorna o identificador do último item da página
+func (c Cursor) ID() string {
+	return c.id
+}
+
+//Pagination armazena os parâmetros de uma listagem paginada
+type Pagination struct {
+	After Cursor
+	Limit int
+}
+
+//NewPagination cria uma Pagination, utilizando o limite padrão quando limit não é informado
+func NewPagination(after Cursor, limit int) Pagination {
+	if limit <= 0 {
+		limit = DefaultPageLimit
+	}
+
+	return Pagination{After: after, Limit: limit}
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCursor_Encode(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor Cursor
+	}{
+		{
+			name:   "Cursor with nanoseconds",
+			cursor: NewCursor(time.Date(2020, 6, 1, 12, 30, 0, 123456789, time.UTC), "3c096a40-ccba-4b58-93ed-57379ab04680"),
+		},
+		{
+			name:   "Cursor without fractional seconds",
+			cursor: NewCursor(time.Date(2020, 6, 1, 12, 30, 0, 0, time.UTC), "b51cd6c7-a55c-491e-9140-91903fe66fa9"),
+		},
+		{
+			name:   "Cursor with amount",
+			cursor: NewCursor(time.Date(2020, 6, 1, 12, 30, 0, 0, time.UTC), "b51cd6c7-a55c-491e-9140-91903fe66fa9").WithAmount(2999),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := DecodeCursor(tt.cursor.Encode())
+			if err != nil {
+				t.Errorf("[TestCase '%s'] Unexpected error: '%v'", tt.name, err)
+			}
+
+			if !reflect.DeepEqual(result, tt.cursor) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expected      Cursor
+		expectedError error
+	}{
+		{
+			name:     "Empty cursor",
+			value:    "",
+			expected: Cursor{},
+		},
+		{
+			name:          "Invalid base64",
+			value:         "%%%",
+			expected:      Cursor{},
+			expectedError: ErrInvalidCursor,
+		},
+		{
+			name:          "Missing ID",
+			value:         "MjAyMC0wNi0wMVQxMjozMDowMFo",
+			expected:      Cursor{},
+			expectedError: ErrInvalidCursor,
+		},
+		{
+			name:          "Invalid date",
+			value:         "aW52YWxpZHwwfDNjMDk2YTQw",
+			expected:      Cursor{},
+			expectedError: ErrInvalidCursor,
+		},
+		{
+			name:          "Invalid amount",
+			value:         "MjAyMC0wNi0wMVQxMjozMDowMFp8YWJjfDNjMDk2YTQw",
+			expected:      Cursor{},
+			expectedError: ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := DecodeCursor(tt.value)
+			if err != tt.expectedError {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package domain
+
+import "time"
+
+//ReconciliationItem armazena a divergência entre o Balance de uma Account e o recalculado pelo histórico
+type ReconciliationItem struct {
+	accountID    AccountID
+	stored       Money
+	computed     Money
+	correctionID BalanceCorrectionID
+}
+
+//AccountID
+func (r ReconciliationItem) AccountID() AccountID {
+	return r.accountID
+}
+
+//Stored retorna o Balance armazenado na Account
+func (r ReconciliationItem) Stored() Money {
+	return r.stored
+}
+
+//Computed retorna o saldo inicial somado às Transfer da Account
+func (r ReconciliationItem) Computed() Money {
+	return r.computed
+}
+
+//Difference retorna quanto o Balance armazenado excede o recalculado
+func (r ReconciliationItem) Difference() Money {
+	return r.stored - r.computed
+}
+
+//CorrectionID retorna a BalanceCorrection aberta para a divergência, vazio quando nenhuma foi aberta
+func (r ReconciliationItem) CorrectionID() BalanceCorrectionID {
+	return r.correctionID
+}
+
+//Reconciliation armazena o resultado da conciliação dos saldos das Account com o histórico de Transfer
+type Reconciliation struct {
+	generatedAt time.Time
+	checked     int
+	items       []ReconciliationItem
+}
+
+//NewReconciliation cria uma Reconciliation com o histórico considerado até generatedAt
+func NewReconciliation(generatedAt time.Time) Reconciliation {
+	return Reconciliation{
+		generatedAt: generatedAt,
+		items:       make([]ReconciliationItem, 0),
+	}
+}
+
+//Check registra o saldo de uma Account e retorna se ele diverge do recalculado
+func (r *Reconciliation) Check(accountID AccountID, stored Money, computed Money) bool {
+	r.checked++
+
+	if stored == computed {
+		return false
+	}
+
+	r.items = append(r.items, ReconciliationItem{
+		accountID: accountID,
+		stored:    stored,
+		computed:  computed,
+	})
+
+	return true
+}
+
+//Correct associa a BalanceCorrection aberta ao item divergente da mesma Account
+func (r *Reconciliation) Correct(correction BalanceCorrection) {
+	for i := range r.items {
+		if r.items[i].accountID == correction.AccountID() {
+			r.items[i].correctionID = correction.ID()
+		}
+	}
+}
+
+//GeneratedAt retorna o instante até o qual o histórico foi considerado
+func (r Reconciliation) GeneratedAt() time.Time {
+	return r.generatedAt
+}
+
+//Checked retorna a quantidade de Account verificadas
+func (r Reconciliation) Checked() int {
+	return r.checked
+}
+
+//Items retorna as Account com Balance divergente do histórico
+func (r Reconciliation) Items() []ReconciliationItem {
+	return r.items
+}
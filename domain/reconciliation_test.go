@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReconciliation_Check(t *testing.T) {
+	var reconciliation = NewReconciliation(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	if reconciliation.Check("3c096a40-ccba-4b58-93ed-57379ab04680", 100, 100) {
+		t.Errorf("Check: '%v' | Expected: '%v'", true, false)
+	}
+
+	if !reconciliation.Check("3c096a40-ccba-4b58-93ed-57379ab04699", 150, 100) {
+		t.Errorf("Check: '%v' | Expected: '%v'", false, true)
+	}
+
+	reconciliation.Correct(NewBalanceCorrection(
+		"b51cd6c7-a55c-491e-9140-91903fe66fa9",
+		"3c096a40-ccba-4b58-93ed-57379ab04699",
+		-50,
+		BalanceCorrectionStatusPending,
+		time.Time{},
+	))
+
+	if reconciliation.Checked() != 2 {
+		t.Errorf("Checked: '%v' | Expected: '%v'", reconciliation.Checked(), 2)
+	}
+
+	if len(reconciliation.Items()) != 1 {
+		t.Fatalf("Items: '%v' | Expected: '%v'", len(reconciliation.Items()), 1)
+	}
+
+	var item = reconciliation.Items()[0]
+	if item.AccountID() != "3c096a40-ccba-4b58-93ed-57379ab04699" {
+		t.Errorf("AccountID: '%v' | Expected: '%v'", item.AccountID(), "3c096a40-ccba-4b58-93ed-57379ab04699")
+	}
+
+	if item.Difference() != 50 {
+		t.Errorf("Difference: '%v' | Expected: '%v'", item.Difference(), 50)
+	}
+
+	if item.CorrectionID() != "b51cd6c7-a55c-491e-9140-91903fe66fa9" {
+		t.Errorf("CorrectionID: '%v' | Expected: '%v'", item.CorrectionID(), "b51cd6c7-a55c-491e-9140-91903fe66fa9")
+	}
+}
@@ -0,0 +1,56 @@
+package domain
+
+//Scope define uma permissão concedida a uma credencial de acesso à API
+type Scope string
+
+const (
+	//ScopeAccountsRead permite consultar Account, saldos, extratos e eventos
+	ScopeAccountsRead Scope = "accounts:read"
+
+	//ScopeAccountsWrite permite criar Account
+	ScopeAccountsWrite Scope = "accounts:write"
+
+	//ScopeTransfersRead permite consultar Transfer
+	ScopeTransfersRead Scope = "transfers:read"
+
+	//ScopeTransfersWrite permite criar Transfer
+	ScopeTransfersWrite Scope = "transfers:write"
+
+	//ScopeWebhooksRead permite consultar WebhookSubscription e as suas entregas
+	ScopeWebhooksRead Scope = "webhooks:read"
+
+	//ScopeWebhooksWrite permite cadastrar, alterar e remover WebhookSubscription e reenviar as suas entregas
+	ScopeWebhooksWrite Scope = "webhooks:write"
+
+	//ScopeAdmin concede todos os escopos, a administração das credenciais e o acesso a qualquer Account
+	ScopeAdmin Scope = "admin"
+)
+
+//Scopes retorna todos os escopos válidos
+func Scopes() []Scope {
+	return []Scope{
+		ScopeAccountsRead,
+		ScopeAccountsWrite,
+		ScopeTransfersRead,
+		ScopeTransfersWrite,
+		ScopeWebhooksRead,
+		ScopeWebhooksWrite,
+		ScopeAdmin,
+	}
+}
+
+//String converte o tipo Scope para uma string
+func (s Scope) String() string {
+	return string(s)
+}
+
+//IsValid informa se o Scope é um dos escopos da API
+func (s Scope) IsValid() bool {
+	for _, scope := range Scopes() {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
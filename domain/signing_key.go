@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+	"crypto/rsa"
+	"time"
+)
+
+//SigningKeyRepository expõe os métodos disponíveis para as abstrações do repositório de SigningKey, as buscas
+//retornam as chaves da mais recente para a mais antiga
+type SigningKeyRepository interface {
+	Store(context.Context, SigningKey) (SigningKey, error)
+	FindCreatedAfter(context.Context, time.Time) ([]SigningKey, error)
+}
+
+//SigningKeyID define o tipo identificador de uma SigningKey, publicado como kid no JWKS
+type SigningKeyID string
+
+//String converte o tipo SigningKeyID para uma string
+func (s SigningKeyID) String() string {
+	return string(s)
+}
+
+//SigningKey armazena uma chave RSA de assinatura dos tokens de acesso emitidos pela API
+//
+//A chave mais recente assina os novos tokens até completar o período de rotação, e continua publicada enquanto os
+//tokens assinados por ela ainda são válidos
+type SigningKey struct {
+	id         SigningKeyID
+	privateKey *rsa.PrivateKey
+	createdAt  time.Time
+}
+
+//NewSigningKey cria uma SigningKey
+func NewSigningKey(ID SigningKeyID, privateKey *rsa.PrivateKey, createdAt time.Time) SigningKey {
+	return SigningKey{
+		id:         ID,
+		privateKey: privateKey,
+		createdAt:  createdAt,
+	}
+}
+
+//ID retorna o id da SigningKey
+func (s SigningKey) ID() SigningKeyID {
+	return s.id
+}
+
+//PrivateKey retorna a chave privada da SigningKey
+func (s SigningKey) PrivateKey() *rsa.PrivateKey {
+	return s.privateKey
+}
+
+//CreatedAt retorna a data de criação da SigningKey
+func (s SigningKey) CreatedAt() time.Time {
+	return s.createdAt
+}
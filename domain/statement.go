@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+//StatementEntryType define o tipo de um lançamento do extrato
+type StatementEntryType string
+
+const (
+	//StatementEntryInitialDeposit representa o saldo inicial informado na abertura da Account
+	StatementEntryInitialDeposit StatementEntryType = "initial_deposit"
+
+	//StatementEntryTransferIn representa uma Transfer recebida pela Account
+	StatementEntryTransferIn StatementEntryType = "transfer_in"
+
+	//StatementEntryTransferOut representa uma Transfer enviada pela Account
+	StatementEntryTransferOut StatementEntryType = "transfer_out"
+)
+
+//String converte o tipo StatementEntryType para uma string
+func (s StatementEntryType) String() string {
+	return string(s)
+}
+
+//StatementEntry armazena a estrutura de um lançamento do extrato
+type StatementEntry struct {
+	id             string
+	entryType      StatementEntryType
+	counterpartyID AccountID
+	amount         Money
+	balance        Money
+	createdAt      time.Time
+}
+
+//ID retorna o identificador da movimentação que originou o lançamento
+func (s StatementEntry) ID() string {
+	return s.id
+}
+
+//Type retorna o tipo do lançamento
+func (s StatementEntry) Type() StatementEntryType {
+	return s.entryType
+}
+
+//CounterpartyID retorna a Account do outro lado da Transfer
+func (s StatementEntry) CounterpartyID() AccountID {
+	return s.counterpartyID
+}
+
+//Amount retorna o valor do lançamento com sinal sob a perspectiva da Account
+func (s StatementEntry) Amount() Money {
+	return s.amount
+}
+
+//Balance retorna o saldo da Account após o lançamento
+func (s StatementEntry) Balance() Money {
+	return s.balance
+}
+
+//CreatedAt retorna a data do lançamento
+func (s StatementEntry) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+//Statement armazena a estrutura do extrato de uma Account em um período
+type Statement struct {
+	accountID      AccountID
+	from           time.Time
+	to             time.Time
+	openingBalance Money
+	closingBalance Money
+	entries        []StatementEntry
+}
+
+//NewStatement constrói o extrato de uma Account no período [from, to)
+//
+//previousMovements é o saldo líquido das Transfer anteriores a from e transfers
+//são as Transfer da Account dentro do período
+func NewStatement(
+	account Account,
+	from time.Time,
+	to time.Time,
+	previousMovements Money,
+	transfers []Transfer,
+) Statement {
+	var (
+		statement = Statement{
+			accountID: account.ID(),
+			from:      from,
+			to:        to,
+			entries:   make([]StatementEntry, 0, len(transfers)+1),
+		}
+		balance Money
+	)
+
+	if from.After(account.CreatedAt()) {
+		balance = account.InitialBalance() + previousMovements
+	} else if account.CreatedAt().Before(to) {
+		statement.entries = append(statement.entries, StatementEntry{
+			id:        account.ID().String(),
+			entryType: StatementEntryInitialDeposit,
+			amount:    account.InitialBalance(),
+			balance:   account.InitialBalance(),
+			createdAt: account.CreatedAt(),
+		})
+	}
+
+	statement.openingBalance = balance
+	if len(statement.entries) > 0 {
+		balance = statement.entries[0].balance
+	}
+
+	var sorted = make([]Transfer, len(transfers))
+	copy(sorted, transfers)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].CreatedAt().Equal(sorted[j].CreatedAt()) {
+			return sorted[i].ID() < sorted[j].ID()
+		}
+
+		return sorted[i].CreatedAt().Before(sorted[j].CreatedAt())
+	})
+
+	for _, transfer := range sorted {
+		var entry = StatementEntry{
+			id:        transfer.ID().String(),
+			createdAt: transfer.CreatedAt(),
+		}
+
+		if transfer.AccountDestinationID() == account.ID() {
+			entry.entryType = StatementEntryTransferIn
+			entry.counterpartyID = transfer.AccountOriginID()
+			entry.amount = transfer.Amount()
+		} else {
+			entry.entryType = StatementEntryTransferOut
+			entry.counterpartyID = transfer.AccountDestinationID()
+			entry.amount = -transfer.Amount()
+		}
+
+		balance += entry.amount
+		entry.balance = balance
+
+		statement.entries = append(statement.entries, entry)
+	}
+
+	statement.closingBalance = balance
+
+	return statement
+}
+
+//AccountID retorna a Account do extrato
+func (s Statement) AccountID() AccountID {
+	return s.accountID
+}
+
+//From retorna o início do período do extrato
+func (s Statement) From() time.Time {
+	return s.from
+}
+
+//To retorna o fim do período do extrato, exclusivo
+func (s Statement) To() time.Time {
+	return s.to
+}
+
+//OpeningBalance retorna o saldo da Account no início do período
+func (s Statement) OpeningBalance() Money {
+	return s.openingBalance
+}
+
+//ClosingBalance retorna o saldo da Account no fim do período
+func (s Statement) ClosingBalance() Money {
+	return s.closingBalance
+}
+
+//Entries retorna os lançamentos do extrato em ordem cronológica
+func (s Statement) Entries() []StatementEntry {
+	return s.entries
+}
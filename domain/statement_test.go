@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewStatement(t *testing.T) {
+	var (
+		createdAt = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		account   = NewAccount(
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			"Test",
+			"08098565895",
+			TaxIDTypeCPF,
+			250,
+			100,
+			createdAt,
+		)
+		transferIn = NewTransfer(
+			"b51cd6c7-a55c-491e-9140-91903fe66fa9",
+			"3c096a40-ccba-4b58-93ed-57379ab04699",
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			200,
+			TransferStatusCompleted,
+			createdAt.Add(24*time.Hour),
+		)
+		transferOut = NewTransfer(
+			"c71cd6c7-a55c-491e-9140-91903fe66fa0",
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			"3c096a40-ccba-4b58-93ed-57379ab04699",
+			50,
+			TransferStatusCompleted,
+			createdAt.Add(48*time.Hour),
+		)
+	)
+
+	type args struct {
+		from              time.Time
+		to                time.Time
+		previousMovements Money
+		transfers         []Transfer
+	}
+
+	type expected struct {
+		openingBalance Money
+		closingBalance Money
+		entries        []StatementEntry
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "Period starting before account creation includes initial deposit",
+			args: args{
+				from:      createdAt.Add(-24 * time.Hour),
+				to:        createdAt.Add(72 * time.Hour),
+				transfers: []Transfer{transferOut, transferIn},
+			},
+			expected: expected{
+				openingBalance: 0,
+				closingBalance: 250,
+				entries: []StatementEntry{
+					{
+						id:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+						entryType: StatementEntryInitialDeposit,
+						amount:    100,
+						balance:   100,
+						createdAt: createdAt,
+					},
+					{
+						id:             "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+						entryType:      StatementEntryTransferIn,
+						counterpartyID: "3c096a40-ccba-4b58-93ed-57379ab04699",
+						amount:         200,
+						balance:        300,
+						createdAt:      createdAt.Add(24 * time.Hour),
+					},
+					{
+						id:             "c71cd6c7-a55c-491e-9140-91903fe66fa0",
+						entryType:      StatementEntryTransferOut,
+						counterpartyID: "3c096a40-ccba-4b58-93ed-57379ab04699",
+						amount:         -50,
+						balance:        250,
+						createdAt:      createdAt.Add(48 * time.Hour),
+					},
+				},
+			},
+		},
+		{
+			name: "Period after account creation starts from previous movements",
+			args: args{
+				from:              createdAt.Add(36 * time.Hour),
+				to:                createdAt.Add(72 * time.Hour),
+				previousMovements: 200,
+				transfers:         []Transfer{transferOut},
+			},
+			expected: expected{
+				openingBalance: 300,
+				closingBalance: 250,
+				entries: []StatementEntry{
+					{
+						id:             "c71cd6c7-a55c-491e-9140-91903fe66fa0",
+						entryType:      StatementEntryTransferOut,
+						counterpartyID: "3c096a40-ccba-4b58-93ed-57379ab04699",
+						amount:         -50,
+						balance:        250,
+						createdAt:      createdAt.Add(48 * time.Hour),
+					},
+				},
+			},
+		},
+		{
+			name: "Period without movements",
+			args: args{
+				from:              createdAt.Add(72 * time.Hour),
+				to:                createdAt.Add(96 * time.Hour),
+				previousMovements: 150,
+			},
+			expected: expected{
+				openingBalance: 250,
+				closingBalance: 250,
+				entries:        []StatementEntry{},
+			},
+		},
+		{
+			name: "Period ending before account creation",
+			args: args{
+				from: createdAt.Add(-48 * time.Hour),
+				to:   createdAt.Add(-24 * time.Hour),
+			},
+			expected: expected{
+				openingBalance: 0,
+				closingBalance: 0,
+				entries:        []StatementEntry{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewStatement(account, tt.args.from, tt.args.to, tt.args.previousMovements, tt.args.transfers)
+
+			if result.OpeningBalance() != tt.expected.openingBalance {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.OpeningBalance(), tt.expected.openingBalance)
+			}
+
+			if result.ClosingBalance() != tt.expected.closingBalance {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.ClosingBalance(), tt.expected.closingBalance)
+			}
+
+			if !reflect.DeepEqual(result.Entries(), tt.expected.entries) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.Entries(), tt.expected.entries)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "context"
+
+//Transactor é uma abstração para executar as operações de diferentes repositórios em uma mesma transação
+//
+//O contexto recebido por fn carrega a transação e deve ser repassado aos repositórios, o retorno de um erro
+//desfaz todas as operações realizadas
+type Transactor interface {
+	WithTransaction(ctx context.Context, fn func(context.Context) error) error
+}
@@ -8,7 +8,9 @@ import (
 //TransferRepository expõe os métodos disponíveis para as abstrações do repositório de Transfer
 type TransferRepository interface {
 	Store(context.Context, Transfer) (Transfer, error)
-	FindAll(context.Context) ([]Transfer, error)
+	FindAll(context.Context, TransferQuery) ([]Transfer, error)
+	FindByAccountID(context.Context, AccountID, time.Time, time.Time) ([]Transfer, error)
+	NetAmountByAccountID(context.Context, AccountID, time.Time, time.Time) (Money, error)
 }
 
 //TransferID define o tipo identificador de uma Transfer
@@ -19,12 +21,36 @@ func (t TransferID) String() string {
 	return string(t)
 }
 
+//TransferStatus define a situação de uma Transfer
+type TransferStatus string
+
+const (
+	//TransferStatusCompleted representa uma Transfer com os saldos das Account já atualizados
+	TransferStatusCompleted TransferStatus = "completed"
+)
+
+//String converte o tipo TransferStatus para uma string
+func (t TransferStatus) String() string {
+	return string(t)
+}
+
+//IsValid informa se o TransferStatus é uma das situações conhecidas
+func (t TransferStatus) IsValid() bool {
+	switch t {
+	case TransferStatusCompleted:
+		return true
+	}
+
+	return false
+}
+
 //Transfer armazena a estrutura de transferência
 type Transfer struct {
 	id                   TransferID
 	accountOriginID      AccountID
 	accountDestinationID AccountID
 	amount               Money
+	status               TransferStatus
 	createdAt            time.Time
 }
 
@@ -34,6 +60,7 @@ func NewTransfer(
 	accountOriginID AccountID,
 	accountDestinationID AccountID,
 	amount Money,
+	status TransferStatus,
 	createdAt time.Time,
 ) Transfer {
 	return Transfer{
@@ -41,6 +68,7 @@ func NewTransfer(
 		accountOriginID:      accountOriginID,
 		accountDestinationID: accountDestinationID,
 		amount:               amount,
+		status:               status,
 		createdAt:            createdAt,
 	}
 }
@@ -65,6 +93,11 @@ func (t Transfer) Amount() Money {
 	return t.amount
 }
 
+//Status
+func (t Transfer) Status() TransferStatus {
+	return t.status
+}
+
 //CreatedAt
 func (t Transfer) CreatedAt() time.Time {
 	return t.createdAt
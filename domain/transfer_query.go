@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+//ErrInvalidSort é um erro de campo de ordenação não permitido
+var ErrInvalidSort = errors.New("invalid sort")
+
+//TransferSort define a ordenação de uma listagem de Transfer, o prefixo "-" indica ordem decrescente
+type TransferSort string
+
+const (
+	//TransferSortCreatedAt ordena as Transfer da mais antiga para a mais recente
+	TransferSortCreatedAt TransferSort = "created_at"
+
+	//TransferSortCreatedAtDesc ordena as Transfer da mais recente para a mais antiga
+	TransferSortCreatedAtDesc TransferSort = "-created_at"
+
+	//TransferSortAmount ordena as Transfer do menor para o maior valor
+	TransferSortAmount TransferSort = "amount"
+
+	//TransferSortAmountDesc ordena as Transfer do maior para o menor valor
+	TransferSortAmountDesc TransferSort = "-amount"
+)
+
+//ParseTransferSort converte o parâmetro de ordenação em um TransferSort permitido, utilizando created_at por padrão
+func ParseTransferSort(value string) (TransferSort, error) {
+	switch sort := TransferSort(value); sort {
+	case "":
+		return TransferSortCreatedAt, nil
+	case TransferSortCreatedAt, TransferSortCreatedAtDesc, TransferSortAmount, TransferSortAmountDesc:
+		return sort, nil
+	}
+
+	return "", ErrInvalidSort
+}
+
+//Field retorna o campo ordenado
+func (t TransferSort) Field() string {
+	return strings.TrimPrefix(string(t), "-")
+}
+
+//Descending informa se a ordenação é decrescente
+func (t TransferSort) Descending() bool {
+	return strings.HasPrefix(string(t), "-")
+}
+
+//TransferFilter armazena os critérios de busca de Transfer, campos com valor zero não filtram
+//
+//AccountID busca Transfer enviadas ou recebidas pela Account, CreatedFrom é inclusivo e CreatedTo exclusivo
+type TransferFilter struct {
+	AccountID            AccountID
+	AccountOriginID      AccountID
+	AccountDestinationID AccountID
+	MinAmount            Money
+	MaxAmount            Money
+	CreatedFrom          time.Time
+	CreatedTo            time.Time
+	Status               TransferStatus
+}
+
+//TransferQuery armazena os critérios, a ordenação e a paginação de uma listagem de Transfer
+type TransferQuery struct {
+	Filter     TransferFilter
+	Sort       TransferSort
+	Pagination Pagination
+}
@@ -0,0 +1,56 @@
+package domain
+
+import "testing"
+
+func TestParseTransferSort(t *testing.T) {
+	tests := []struct {
+		name               string
+		value              string
+		expected           TransferSort
+		expectedField      string
+		expectedDescending bool
+		expectedError      error
+	}{
+		{
+			name:          "Default sort",
+			value:         "",
+			expected:      TransferSortCreatedAt,
+			expectedField: "created_at",
+		},
+		{
+			name:               "Descending amount",
+			value:              "-amount",
+			expected:           TransferSortAmountDesc,
+			expectedField:      "amount",
+			expectedDescending: true,
+		},
+		{
+			name:          "Field not allowed",
+			value:         "account_origin_id",
+			expected:      "",
+			expectedField: "",
+			expectedError: ErrInvalidSort,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseTransferSort(tt.value)
+			if err != tt.expectedError {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if result != tt.expected {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+
+			if result.Field() != tt.expectedField {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.Field(), tt.expectedField)
+			}
+
+			if result.Descending() != tt.expectedDescending {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.Descending(), tt.expectedDescending)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ func TestNewTransfer(t *testing.T) {
 		accountOriginID      AccountID
 		accountDestinationID AccountID
 		amount               Money
+		status               TransferStatus
 		createdAt            time.Time
 	}
 
@@ -27,6 +28,7 @@ func TestNewTransfer(t *testing.T) {
 				accountOriginID:      "",
 				accountDestinationID: "",
 				amount:               0,
+				status:               "",
 				createdAt:            time.Time{},
 			},
 			expected: Transfer{},
@@ -40,6 +42,7 @@ func TestNewTransfer(t *testing.T) {
 				tt.args.accountOriginID,
 				tt.args.accountDestinationID,
 				tt.args.amount,
+				tt.args.status,
 				tt.args.createdAt,
 			)
 
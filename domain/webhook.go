@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+//WebhookSubscriptionRepository expõe os métodos disponíveis para as abstrações do repositório de WebhookSubscription
+type WebhookSubscriptionRepository interface {
+	Store(context.Context, WebhookSubscription) (WebhookSubscription, error)
+	Update(context.Context, WebhookSubscription) error
+	Delete(context.Context, WebhookSubscriptionID) error
+	FindAll(context.Context) ([]WebhookSubscription, error)
+	FindByID(context.Context, WebhookSubscriptionID) (WebhookSubscription, error)
+}
+
+//WebhookSubscriptionID define o tipo identificador de uma WebhookSubscription
+type WebhookSubscriptionID string
+
+//String converte o tipo WebhookSubscriptionID para uma string
+func (w WebhookSubscriptionID) String() string {
+	return string(w)
+}
+
+//WebhookSubscription armazena o cadastro de uma URL que recebe os eventos de domínio dos tipos informados
+//
+//Quando accountID é informado, apenas os eventos que envolvem a Account são entregues
+type WebhookSubscription struct {
+	id         WebhookSubscriptionID
+	url        string
+	secret     string
+	eventTypes []EventType
+	accountID  AccountID
+	createdAt  time.Time
+}
+
+//NewWebhookSubscription cria uma WebhookSubscription
+func NewWebhookSubscription(
+	ID WebhookSubscriptionID,
+	url string,
+	secret string,
+	eventTypes []EventType,
+	accountID AccountID,
+	createdAt time.Time,
+) WebhookSubscription {
+	return WebhookSubscription{
+		id:         ID,
+		url:        url,
+		secret:     secret,
+		eventTypes: eventTypes,
+		accountID:  accountID,
+		createdAt:  createdAt,
+	}
+}
+
+//Matches informa se um evento do tipo informado, envolvendo as Account informadas, deve ser entregue
+func (w WebhookSubscription) Matches(eventType EventType, accountIDs []AccountID) bool {
+	var subscribed bool
+	for _, t := range w.eventTypes {
+		if t == eventType {
+			subscribed = true
+			break
+		}
+	}
+
+	if !subscribed {
+		return false
+	}
+
+	if w.accountID == "" {
+		return true
+	}
+
+	for _, ID := range accountIDs {
+		if ID == w.accountID {
+			return true
+		}
+	}
+
+	return false
+}
+
+//ID retorna o identificador da WebhookSubscription
+func (w WebhookSubscription) ID() WebhookSubscriptionID {
+	return w.id
+}
+
+//URL retorna o endereço que recebe as entregas
+func (w WebhookSubscription) URL() string {
+	return w.url
+}
+
+//Secret retorna a chave utilizada para assinar as entregas
+func (w WebhookSubscription) Secret() string {
+	return w.secret
+}
+
+//EventTypes retorna os tipos de eventos entregues
+func (w WebhookSubscription) EventTypes() []EventType {
+	return w.eventTypes
+}
+
+//AccountID retorna a Account cujos eventos são entregues, vazio para todas
+func (w WebhookSubscription) AccountID() AccountID {
+	return w.accountID
+}
+
+//CreatedAt retorna a data de criação da WebhookSubscription
+func (w WebhookSubscription) CreatedAt() time.Time {
+	return w.createdAt
+}
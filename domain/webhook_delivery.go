@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+//ErrWebhookDeliveryAlreadyExists é um erro de entrega já registrada para a mesma WebhookSubscription e OutboxMessage
+var ErrWebhookDeliveryAlreadyExists = errors.New("webhook delivery already exists")
+
+//WebhookDeliveryRepository expõe os métodos disponíveis para as abstrações do repositório de WebhookDelivery
+type WebhookDeliveryRepository interface {
+	Store(context.Context, WebhookDelivery) (WebhookDelivery, error)
+	Update(context.Context, WebhookDelivery) error
+	FindByID(context.Context, WebhookDeliveryID) (WebhookDelivery, error)
+	FindBySubscriptionID(context.Context, WebhookSubscriptionID, WebhookDeliveryStatus, int) ([]WebhookDelivery, error)
+	FindDue(context.Context, time.Time, int) ([]WebhookDelivery, error)
+}
+
+//WebhookDeliveryID define o tipo identificador de uma WebhookDelivery
+type WebhookDeliveryID string
+
+//String converte o tipo WebhookDeliveryID para uma string
+func (w WebhookDeliveryID) String() string {
+	return string(w)
+}
+
+//WebhookDeliveryStatus define a situação de uma WebhookDelivery
+type WebhookDeliveryStatus string
+
+const (
+	//WebhookDeliveryStatusPending representa uma entrega aguardando a próxima tentativa
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending"
+
+	//WebhookDeliveryStatusSucceeded representa uma entrega aceita pelo destino
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+
+	//WebhookDeliveryStatusDead representa uma entrega que esgotou as tentativas e só é refeita manualmente
+	WebhookDeliveryStatusDead WebhookDeliveryStatus = "dead"
+)
+
+//String converte o tipo WebhookDeliveryStatus para uma string
+func (w WebhookDeliveryStatus) String() string {
+	return string(w)
+}
+
+//IsValid informa se o WebhookDeliveryStatus é conhecido
+func (w WebhookDeliveryStatus) IsValid() bool {
+	switch w {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusDead:
+		return true
+	default:
+		return false
+	}
+}
+
+//WebhookRetryPolicy armazena a quantidade máxima de tentativas e o intervalo exponencial entre elas
+type WebhookRetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+//Delay retorna a espera após a tentativa informada: Backoff dobrado a cada tentativa, limitado a MaxBackoff
+func (w WebhookRetryPolicy) Delay(attempt int) time.Duration {
+	var delay = w.Backoff
+	for i := 1; i < attempt && (w.MaxBackoff <= 0 || delay < w.MaxBackoff); i++ {
+		delay *= 2
+	}
+
+	if w.MaxBackoff > 0 && delay > w.MaxBackoff {
+		return w.MaxBackoff
+	}
+
+	return delay
+}
+
+//WebhookDelivery armazena a entrega de uma OutboxMessage para uma WebhookSubscription
+type WebhookDelivery struct {
+	id             WebhookDeliveryID
+	subscriptionID WebhookSubscriptionID
+	messageID      OutboxMessageID
+	eventType      EventType
+	payload        []byte
+	status         WebhookDeliveryStatus
+	attempts       int
+	nextAttemptAt  time.Time
+	lastError      string
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+//NewWebhookDelivery cria uma WebhookDelivery
+func NewWebhookDelivery(
+	ID WebhookDeliveryID,
+	subscriptionID WebhookSubscriptionID,
+	messageID OutboxMessageID,
+	eventType EventType,
+	payload []byte,
+	status WebhookDeliveryStatus,
+	attempts int,
+	nextAttemptAt time.Time,
+	lastError string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) WebhookDelivery {
+	return WebhookDelivery{
+		id:             ID,
+		subscriptionID: subscriptionID,
+		messageID:      messageID,
+		eventType:      eventType,
+		payload:        payload,
+		status:         status,
+		attempts:       attempts,
+		nextAttemptAt:  nextAttemptAt,
+		lastError:      lastError,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
+
+//Succeed registra uma tentativa aceita pelo destino
+func (w *WebhookDelivery) Succeed(at time.Time) {
+	w.attempts++
+	w.status = WebhookDeliveryStatusSucceeded
+	w.lastError = ""
+	w.updatedAt = at
+}
+
+//Fail registra uma tentativa recusada, agendando a próxima conforme a política ou movendo a entrega para dead
+//quando as tentativas se esgotam
+func (w *WebhookDelivery) Fail(reason string, at time.Time, policy WebhookRetryPolicy) {
+	w.attempts++
+	w.lastError = reason
+	w.updatedAt = at
+
+	if w.attempts >= policy.MaxAttempts {
+		w.status = WebhookDeliveryStatusDead
+		return
+	}
+
+	w.status = WebhookDeliveryStatusPending
+	w.nextAttemptAt = at.Add(policy.Delay(w.attempts))
+}
+
+//Replay reinicia as tentativas da entrega, que volta a ser enviada imediatamente
+func (w *WebhookDelivery) Replay(at time.Time) {
+	w.status = WebhookDeliveryStatusPending
+	w.attempts = 0
+	w.nextAttemptAt = at
+	w.updatedAt = at
+}
+
+//ID retorna o identificador da WebhookDelivery
+func (w WebhookDelivery) ID() WebhookDeliveryID {
+	return w.id
+}
+
+//SubscriptionID retorna a WebhookSubscription de destino
+func (w WebhookDelivery) SubscriptionID() WebhookSubscriptionID {
+	return w.subscriptionID
+}
+
+//MessageID retorna a OutboxMessage entregue
+func (w WebhookDelivery) MessageID() OutboxMessageID {
+	return w.messageID
+}
+
+//EventType retorna o tipo do evento entregue
+func (w WebhookDelivery) EventType() EventType {
+	return w.eventType
+}
+
+//Payload retorna o evento serializado
+func (w WebhookDelivery) Payload() []byte {
+	return w.payload
+}
+
+//Status retorna a situação da entrega
+func (w WebhookDelivery) Status() WebhookDeliveryStatus {
+	return w.status
+}
+
+//Attempts retorna a quantidade de tentativas realizadas
+func (w WebhookDelivery) Attempts() int {
+	return w.attempts
+}
+
+//NextAttemptAt retorna a data a partir da qual a entrega pendente é tentada novamente
+func (w WebhookDelivery) NextAttemptAt() time.Time {
+	return w.nextAttemptAt
+}
+
+//LastError retorna o motivo da última tentativa recusada
+func (w WebhookDelivery) LastError() string {
+	return w.lastError
+}
+
+//CreatedAt retorna a data de criação da WebhookDelivery
+func (w WebhookDelivery) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+//UpdatedAt retorna a data da última alteração da WebhookDelivery
+func (w WebhookDelivery) UpdatedAt() time.Time {
+	return w.updatedAt
+}
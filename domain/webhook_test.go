@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWebhookSubscription_Matches(t *testing.T) {
+	tests := []struct {
+		name         string
+		subscription WebhookSubscription
+		eventType    EventType
+		accountIDs   []AccountID
+		expected     bool
+	}{
+		{
+			name: "Subscribed event type for any account",
+			subscription: NewWebhookSubscription(
+				"1", "http://localhost/hook", "secret", []EventType{EventTransferCompleted}, "", time.Time{},
+			),
+			eventType:  EventTransferCompleted,
+			accountIDs: []AccountID{"a", "b"},
+			expected:   true,
+		},
+		{
+			name: "Event type not subscribed",
+			subscription: NewWebhookSubscription(
+				"1", "http://localhost/hook", "secret", []EventType{EventTransferCompleted}, "", time.Time{},
+			),
+			eventType:  EventBalanceChanged,
+			accountIDs: []AccountID{"a"},
+			expected:   false,
+		},
+		{
+			name: "Subscribed account involved in the event",
+			subscription: NewWebhookSubscription(
+				"1", "http://localhost/hook", "secret", []EventType{EventTransferCompleted}, "b", time.Time{},
+			),
+			eventType:  EventTransferCompleted,
+			accountIDs: []AccountID{"a", "b"},
+			expected:   true,
+		},
+		{
+			name: "Subscribed account not involved in the event",
+			subscription: NewWebhookSubscription(
+				"1", "http://localhost/hook", "secret", []EventType{EventTransferCompleted}, "c", time.Time{},
+			),
+			eventType:  EventTransferCompleted,
+			accountIDs: []AccountID{"a", "b"},
+			expected:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.subscription.Matches(tt.eventType, tt.accountIDs); result != tt.expected {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWebhookRetryPolicy_Delay(t *testing.T) {
+	var policy = WebhookRetryPolicy{MaxAttempts: 10, Backoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	tests := []struct {
+		attempt  int
+		expected time.Duration
+	}{
+		{attempt: 1, expected: time.Second},
+		{attempt: 2, expected: 2 * time.Second},
+		{attempt: 4, expected: 8 * time.Second},
+		{attempt: 5, expected: 10 * time.Second},
+		{attempt: 60, expected: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if result := policy.Delay(tt.attempt); result != tt.expected {
+			t.Errorf("[TestCase 'attempt %d'] Result: '%v' | Expected: '%v'", tt.attempt, result, tt.expected)
+		}
+	}
+}
+
+func TestWebhookDelivery_Fail(t *testing.T) {
+	var (
+		at       = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		policy   = WebhookRetryPolicy{MaxAttempts: 3, Backoff: time.Minute}
+		delivery = NewWebhookDelivery(
+			"1", "2", "3", EventTransferCompleted, []byte("{}"), WebhookDeliveryStatusPending, 0, at, "", at, at,
+		)
+	)
+
+	delivery.Fail("unexpected status 500", at, policy)
+	if delivery.Status() != WebhookDeliveryStatusPending || !delivery.NextAttemptAt().Equal(at.Add(time.Minute)) {
+		t.Errorf("[TestCase 'first failure'] Status: '%v' | NextAttemptAt: '%v'", delivery.Status(), delivery.NextAttemptAt())
+	}
+
+	delivery.Fail("unexpected status 500", at, policy)
+	if delivery.Status() != WebhookDeliveryStatusPending || !delivery.NextAttemptAt().Equal(at.Add(2*time.Minute)) {
+		t.Errorf("[TestCase 'second failure'] Status: '%v' | NextAttemptAt: '%v'", delivery.Status(), delivery.NextAttemptAt())
+	}
+
+	delivery.Fail("unexpected status 500", at, policy)
+	if delivery.Status() != WebhookDeliveryStatusDead || delivery.Attempts() != 3 {
+		t.Errorf("[TestCase 'last failure'] Status: '%v' | Attempts: '%v'", delivery.Status(), delivery.Attempts())
+	}
+
+	delivery.Replay(at.Add(time.Hour))
+	if delivery.Status() != WebhookDeliveryStatusPending || delivery.Attempts() != 0 ||
+		!delivery.NextAttemptAt().Equal(at.Add(time.Hour)) {
+		t.Errorf("[TestCase 'replay'] Status: '%v' | Attempts: '%v'", delivery.Status(), delivery.Attempts())
+	}
+}
@@ -0,0 +1,102 @@
+package infrastructure
+
+import (
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultOAuthIssuer      = "go-bank-transfer"
+	defaultOAuthTokenTTL    = 10 * time.Minute
+	defaultOAuthKeyRotation = 24 * time.Hour
+)
+
+var (
+	errOAuthIssuerConflict = errors.New("OAUTH_ISSUER must differ from JWT_ISSUER")
+	errInvalidDuration     = errors.New("duration must be positive")
+)
+
+//oauthConfig armazena a configuração dos tokens emitidos pelo endpoint de token
+type oauthConfig struct {
+	issuer      string
+	audience    string
+	tokenTTL    time.Duration
+	keyRotation time.Duration
+}
+
+//newJWTVerifier constrói a verificação dos JWTs a partir das variáveis de ambiente: JWT_SECRET habilita tokens HS256
+//e JWT_JWKS_FILE, o caminho de um JWKS, habilita tokens RS256. JWT_ISSUER e JWT_AUDIENCE são opcionais
+func newJWTVerifier() (auth.JWTVerifier, error) {
+	var keys auth.KeySet
+	if path := os.Getenv("JWT_JWKS_FILE"); path != "" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return auth.JWTVerifier{}, errors.Wrap(err, "error reading jwks file")
+		}
+
+		keys, err = auth.ParseJWKS(data)
+		if err != nil {
+			return auth.JWTVerifier{}, err
+		}
+	}
+
+	return auth.NewJWTVerifier(
+		[]byte(os.Getenv("JWT_SECRET")),
+		keys,
+		os.Getenv("JWT_ISSUER"),
+		os.Getenv("JWT_AUDIENCE"),
+	)
+}
+
+//newOAuthConfig lê a configuração do endpoint de token das variáveis de ambiente: OAUTH_ISSUER, OAUTH_AUDIENCE,
+//OAUTH_TOKEN_TTL e OAUTH_KEY_ROTATION, as durações no formato de time.ParseDuration
+//
+//O issuer separa os tokens emitidos pela API dos JWTs externos, por isso não pode ser igual ao JWT_ISSUER
+func newOAuthConfig() (oauthConfig, error) {
+	var cfg = oauthConfig{
+		issuer:   os.Getenv("OAUTH_ISSUER"),
+		audience: os.Getenv("OAUTH_AUDIENCE"),
+	}
+
+	if cfg.issuer == "" {
+		cfg.issuer = defaultOAuthIssuer
+	}
+
+	if cfg.issuer == os.Getenv("JWT_ISSUER") {
+		return oauthConfig{}, errOAuthIssuerConflict
+	}
+
+	var err error
+	if cfg.tokenTTL, err = durationEnv("OAUTH_TOKEN_TTL", defaultOAuthTokenTTL); err != nil {
+		return oauthConfig{}, err
+	}
+
+	if cfg.keyRotation, err = durationEnv("OAUTH_KEY_ROTATION", defaultOAuthKeyRotation); err != nil {
+		return oauthConfig{}, err
+	}
+
+	return cfg, nil
+}
+
+func durationEnv(name string, defaultValue time.Duration) (time.Duration, error) {
+	var value = os.Getenv(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error parsing %s", name)
+	}
+
+	if d <= 0 {
+		return 0, errors.Wrap(errInvalidDuration, name)
+	}
+
+	return d, nil
+}
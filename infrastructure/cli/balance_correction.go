@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/presenter"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/event"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/repository"
+	"github.com/gsabadini/go-bank-transfer/repository/mongodb"
+	"github.com/gsabadini/go-bank-transfer/repository/postgres"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+const (
+	decisionApprove = "approve"
+	decisionReject  = "reject"
+)
+
+var (
+	errInvalidCorrectionID = errors.New("invalid correction id, must be a valid UUID")
+	errInvalidDecision     = errors.New("invalid decision, must be one of [approve reject]")
+)
+
+type balanceCorrection struct {
+	log        logger.Logger
+	dbSQL      repository.SQLHandler
+	dbNoSQL    repository.NoSQLHandler
+	ctxTimeout time.Duration
+	out        io.Writer
+}
+
+func newBalanceCorrection(
+	log logger.Logger,
+	dbSQL repository.SQLHandler,
+	dbNoSQL repository.NoSQLHandler,
+	t time.Duration,
+) balanceCorrection {
+	return balanceCorrection{
+		log:        log,
+		dbSQL:      dbSQL,
+		dbNoSQL:    dbNoSQL,
+		ctxTimeout: t,
+		out:        os.Stdout,
+	}
+}
+
+//Run aprova ou recusa uma BalanceCorrection pendente aberta pela conciliação e escreve o resultado em JSON
+//
+//Flags: -id (obrigatória), -decision approve|reject e -database postgres|mongodb (padrão: postgres)
+func (b balanceCorrection) Run(args []string) error {
+	var (
+		flags    = flag.NewFlagSet(CommandBalanceCorrection, flag.ContinueOnError)
+		ID       = flags.String("id", "", "balance correction id")
+		decision = flags.String("decision", "", "decision [approve reject]")
+		database = flags.String("database", databasePostgres, "repository backend [postgres mongodb]")
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if !domain.IsValidUUID(*ID) {
+		return errInvalidCorrectionID
+	}
+
+	if *decision != decisionApprove && *decision != decisionReject {
+		return errInvalidDecision
+	}
+
+	uc, err := b.buildUseCase(*database)
+	if err != nil {
+		return err
+	}
+
+	var decide = uc.Approve
+	if *decision == decisionReject {
+		decide = uc.Reject
+	}
+
+	output, err := decide(context.Background(), domain.BalanceCorrectionID(*ID))
+	if err != nil {
+		b.log.WithFields(logger.Fields{
+			"key":           CommandBalanceCorrection,
+			"correction_id": *ID,
+			"decision":      *decision,
+		}).WithError(err).Errorf("error when deciding balance correction")
+
+		return err
+	}
+
+	if err := json.NewEncoder(b.out).Encode(output); err != nil {
+		return err
+	}
+
+	b.log.WithFields(logger.Fields{
+		"key":           CommandBalanceCorrection,
+		"correction_id": output.ID,
+		"account_id":    output.AccountID,
+		"status":        output.Status,
+	}).Infof("success when deciding balance correction")
+
+	return nil
+}
+
+func (b balanceCorrection) buildUseCase(database string) (usecase.BalanceCorrectionUseCase, error) {
+	switch database {
+	case databasePostgres:
+		return usecase.NewBalanceCorrection(
+			postgres.NewBalanceCorrectionRepository(b.dbSQL),
+			postgres.NewAccountRepository(b.dbSQL),
+			presenter.NewBalanceCorrectionPresenter(),
+			event.NewOutbox(postgres.NewOutboxRepository(b.dbSQL)),
+			b.dbSQL,
+			b.ctxTimeout,
+		), nil
+	case databaseMongoDB:
+		return usecase.NewBalanceCorrection(
+			mongodb.NewBalanceCorrectionRepository(b.dbNoSQL),
+			mongodb.NewAccountRepository(b.dbNoSQL),
+			presenter.NewBalanceCorrectionPresenter(),
+			event.NewOutbox(mongodb.NewOutboxRepository(b.dbNoSQL)),
+			b.dbNoSQL,
+			b.ctxTimeout,
+		), nil
+	default:
+		return nil, errInvalidDatabase
+	}
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/repository"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+//Command é uma abstração para um comando executado pela linha de comando
+type Command interface {
+	Run(args []string) error
+}
+
+var (
+	errInvalidCommand       = errors.New("invalid command")
+	errInvalidDatabase      = errors.New("invalid database, must be one of [postgres mongodb]")
+	errInvalidFormat        = errors.New("invalid format, must be one of [json csv]")
+	errBalanceDiscrepancies = errors.New("balance discrepancies found")
+)
+
+const (
+	CommandDailyClosing      = "daily-closing"
+	CommandReconciliation    = "reconciliation"
+	CommandBalanceCorrection = "balance-correction"
+	CommandOutboxRelay       = "outbox-relay"
+	CommandWebhookDelivery   = "webhook-delivery"
+)
+
+const (
+	databasePostgres = "postgres"
+	databaseMongoDB  = "mongodb"
+)
+
+const (
+	formatJSON = "json"
+	formatCSV  = "csv"
+)
+
+//NewCommandFactory retorna a instância de um comando a partir do seu nome
+func NewCommandFactory(
+	name string,
+	log logger.Logger,
+	dbSQL repository.SQLHandler,
+	dbNoSQL repository.NoSQLHandler,
+	publisher usecase.EventPublisher,
+	ctxTimeout time.Duration,
+) (Command, error) {
+	switch name {
+	case CommandDailyClosing:
+		return newDailyClosing(log, dbSQL, dbNoSQL, ctxTimeout), nil
+	case CommandReconciliation:
+		return newReconciliation(log, dbSQL, dbNoSQL, ctxTimeout), nil
+	case CommandOutboxRelay:
+		return newOutboxRelay(log, dbSQL, dbNoSQL, publisher, ctxTimeout), nil
+	case CommandWebhookDelivery:
+		return newWebhookDelivery(log, dbSQL, dbNoSQL, ctxTimeout), nil
+	case CommandBalanceCorrection:
+		return newBalanceCorrection(log, dbSQL, dbNoSQL, ctxTimeout), nil
+	default:
+		return nil, errInvalidCommand
+	}
+}
@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/presenter"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/repository"
+	"github.com/gsabadini/go-bank-transfer/repository/mongodb"
+	"github.com/gsabadini/go-bank-transfer/repository/postgres"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type dailyClosing struct {
+	log        logger.Logger
+	dbSQL      repository.SQLHandler
+	dbNoSQL    repository.NoSQLHandler
+	ctxTimeout time.Duration
+	out        io.Writer
+}
+
+func newDailyClosing(
+	log logger.Logger,
+	dbSQL repository.SQLHandler,
+	dbNoSQL repository.NoSQLHandler,
+	t time.Duration,
+) dailyClosing {
+	return dailyClosing{
+		log:        log,
+		dbSQL:      dbSQL,
+		dbNoSQL:    dbNoSQL,
+		ctxTimeout: t,
+		out:        os.Stdout,
+	}
+}
+
+//Run fecha os saldos de um dia e escreve o relatório em JSON, retornando erro quando há divergências
+//
+//Flags: -date YYYY-MM-DD (padrão: ontem em UTC) e -database postgres|mongodb (padrão: postgres)
+func (d dailyClosing) Run(args []string) error {
+	var (
+		flags    = flag.NewFlagSet(CommandDailyClosing, flag.ContinueOnError)
+		date     = flags.String("date", time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02"), "day to close (YYYY-MM-DD, UTC)")
+		database = flags.String("database", databasePostgres, "repository backend [postgres mongodb]")
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	day, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		return fmt.Errorf("invalid date %q, must be YYYY-MM-DD", *date)
+	}
+
+	uc, err := d.buildUseCase(*database)
+	if err != nil {
+		return err
+	}
+
+	output, err := uc.Execute(context.Background(), day)
+	if err != nil {
+		d.log.WithFields(logger.Fields{
+			"key":  CommandDailyClosing,
+			"date": *date,
+		}).WithError(err).Errorf("error when closing daily balances")
+
+		return err
+	}
+
+	if err := json.NewEncoder(d.out).Encode(output); err != nil {
+		return err
+	}
+
+	for _, discrepancy := range output.Discrepancies {
+		d.log.WithFields(logger.Fields{
+			"key":        CommandDailyClosing,
+			"date":       *date,
+			"account_id": discrepancy.AccountID,
+			"expected":   discrepancy.Expected,
+			"closing":    discrepancy.Closing,
+		}).Warnf("balance discrepancy in daily closing")
+	}
+
+	d.log.WithFields(logger.Fields{
+		"key":            CommandDailyClosing,
+		"date":           *date,
+		"closed":         output.Closed,
+		"already_closed": output.AlreadyClosed,
+		"discrepancies":  len(output.Discrepancies),
+	}).Infof("success when closing daily balances")
+
+	if len(output.Discrepancies) > 0 {
+		return errBalanceDiscrepancies
+	}
+
+	return nil
+}
+
+func (d dailyClosing) buildUseCase(database string) (usecase.DailyClosingUseCase, error) {
+	switch database {
+	case databasePostgres:
+		return usecase.NewDailyClosing(
+			postgres.NewAccountRepository(d.dbSQL),
+			postgres.NewTransferRepository(d.dbSQL),
+			postgres.NewBalanceSnapshotRepository(d.dbSQL),
+			presenter.NewDailyClosingPresenter(),
+			d.ctxTimeout,
+		), nil
+	case databaseMongoDB:
+		return usecase.NewDailyClosing(
+			mongodb.NewAccountRepository(d.dbNoSQL),
+			mongodb.NewTransferRepository(d.dbNoSQL),
+			mongodb.NewBalanceSnapshotRepository(d.dbNoSQL),
+			presenter.NewDailyClosingPresenter(),
+			d.ctxTimeout,
+		), nil
+	default:
+		return nil, errInvalidDatabase
+	}
+}
@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/event"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/repository"
+	"github.com/gsabadini/go-bank-transfer/repository/mongodb"
+	"github.com/gsabadini/go-bank-transfer/repository/postgres"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+//cleanupInterval é o intervalo mínimo entre duas remoções das mensagens enviadas
+const cleanupInterval = time.Hour
+
+var errInvalidBatchSize = errors.New("invalid batch size, must be greater than zero")
+
+type outboxRelay struct {
+	log        logger.Logger
+	dbSQL      repository.SQLHandler
+	dbNoSQL    repository.NoSQLHandler
+	publisher  usecase.EventPublisher
+	ctxTimeout time.Duration
+}
+
+func newOutboxRelay(
+	log logger.Logger,
+	dbSQL repository.SQLHandler,
+	dbNoSQL repository.NoSQLHandler,
+	publisher usecase.EventPublisher,
+	t time.Duration,
+) outboxRelay {
+	return outboxRelay{
+		log:        log,
+		dbSQL:      dbSQL,
+		dbNoSQL:    dbNoSQL,
+		publisher:  publisher,
+		ctxTimeout: t,
+	}
+}
+
+//Run publica periodicamente as mensagens pendentes do outbox até receber SIGINT ou SIGTERM
+//
+//Flags: -database postgres|mongodb (padrão: postgres), -interval (padrão: 1s), -batch-size (padrão: 100),
+//-max-attempts (padrão: 10), -retention (padrão: 168h) e -once, que executa uma única publicação e remoção
+func (o outboxRelay) Run(args []string) error {
+	var (
+		flags       = flag.NewFlagSet(CommandOutboxRelay, flag.ContinueOnError)
+		database    = flags.String("database", databasePostgres, "repository backend [postgres mongodb]")
+		interval    = flags.Duration("interval", time.Second, "polling interval")
+		batchSize   = flags.Int("batch-size", 100, "maximum messages published per poll")
+		maxAttempts = flags.Int("max-attempts", 10, "attempts before a message is marked as failed")
+		retention   = flags.Duration("retention", 7*24*time.Hour, "how long sent messages are kept")
+		once        = flags.Bool("once", false, "publish pending messages once and exit")
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *batchSize <= 0 {
+		return errInvalidBatchSize
+	}
+
+	if *maxAttempts <= 0 {
+		return errInvalidMaxAttempts
+	}
+
+	uc, err := o.buildUseCase(*database, *maxAttempts)
+	if err != nil {
+		return err
+	}
+
+	if *once {
+		if err := o.relay(uc, *batchSize); err != nil {
+			return err
+		}
+
+		return o.cleanup(uc, *retention)
+	}
+
+	var (
+		stop   = make(chan os.Signal, 1)
+		ticker = time.NewTicker(*interval)
+		last   time.Time
+	)
+	defer ticker.Stop()
+
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(stop)
+
+	o.log.WithFields(logger.Fields{
+		"key":      CommandOutboxRelay,
+		"database": *database,
+		"interval": interval.String(),
+	}).Infof("Starting outbox relay")
+
+	for {
+		//erros são registrados no log e a publicação é tentada novamente no próximo intervalo
+		_ = o.relay(uc, *batchSize)
+
+		if time.Since(last) >= cleanupInterval {
+			if err := o.cleanup(uc, *retention); err == nil {
+				last = time.Now()
+			}
+		}
+
+		select {
+		case <-stop:
+			o.log.WithFields(logger.Fields{"key": CommandOutboxRelay}).Infof("Stopping outbox relay")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (o outboxRelay) relay(uc usecase.OutboxRelayUseCase, batchSize int) error {
+	output, err := uc.Relay(context.Background(), batchSize)
+	if err != nil {
+		o.log.WithFields(logger.Fields{
+			"key": CommandOutboxRelay,
+		}).WithError(err).Errorf("error when relaying outbox messages")
+
+		return err
+	}
+
+	for _, failure := range output.Failures {
+		var entry = o.log.WithFields(logger.Fields{
+			"key":        CommandOutboxRelay,
+			"message_id": failure.MessageID,
+			"event":      failure.EventType,
+			"attempts":   failure.Attempts,
+			"error":      failure.Error,
+		})
+
+		if failure.Failed {
+			entry.Errorf("outbox message marked as failed after exhausting its attempts")
+			continue
+		}
+
+		entry.Warnf("error when publishing outbox message")
+	}
+
+	if output.Sent > 0 || output.Deferred > 0 {
+		o.log.WithFields(logger.Fields{
+			"key":      CommandOutboxRelay,
+			"sent":     output.Sent,
+			"deferred": output.Deferred,
+			"failed":   len(output.Failures),
+		}).Infof("success when relaying outbox messages")
+	}
+
+	return nil
+}
+
+func (o outboxRelay) cleanup(uc usecase.OutboxRelayUseCase, retention time.Duration) error {
+	deleted, err := uc.Cleanup(context.Background(), retention)
+	if err != nil {
+		o.log.WithFields(logger.Fields{
+			"key": CommandOutboxRelay,
+		}).WithError(err).Errorf("error when deleting sent outbox messages")
+
+		return err
+	}
+
+	o.log.WithFields(logger.Fields{
+		"key":     CommandOutboxRelay,
+		"deleted": deleted,
+	}).Infof("success when deleting sent outbox messages")
+
+	return nil
+}
+
+func (o outboxRelay) buildUseCase(database string, maxAttempts int) (usecase.OutboxRelayUseCase, error) {
+	switch database {
+	case databasePostgres:
+		return usecase.NewOutboxRelay(
+			postgres.NewOutboxRepository(o.dbSQL),
+			messagePublishers{
+				usecase.NewWebhookFanOut(
+					postgres.NewWebhookSubscriptionRepository(o.dbSQL),
+					postgres.NewWebhookDeliveryRepository(o.dbSQL),
+				),
+				event.NewDispatcher(o.publisher),
+			},
+			maxAttempts,
+			o.ctxTimeout,
+		), nil
+	case databaseMongoDB:
+		return usecase.NewOutboxRelay(
+			mongodb.NewOutboxRepository(o.dbNoSQL),
+			messagePublishers{
+				usecase.NewWebhookFanOut(
+					mongodb.NewWebhookSubscriptionRepository(o.dbNoSQL),
+					mongodb.NewWebhookDeliveryRepository(o.dbNoSQL),
+				),
+				event.NewDispatcher(o.publisher),
+			},
+			maxAttempts,
+			o.ctxTimeout,
+		), nil
+	default:
+		return nil, errInvalidDatabase
+	}
+}
+
+//messagePublishers publica uma OutboxMessage em cada MessagePublisher, em ordem, parando no primeiro erro
+//
+//O registro das entregas de webhooks vem antes do barramento de eventos por ser idempotente: uma falha no
+//barramento faz o relay republicar a mensagem sem duplicar as entregas
+type messagePublishers []usecase.MessagePublisher
+
+func (m messagePublishers) Publish(ctx context.Context, message domain.OutboxMessage) error {
+	for _, publisher := range m {
+		if err := publisher.Publish(ctx, message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
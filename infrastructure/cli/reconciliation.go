@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/export"
+	"github.com/gsabadini/go-bank-transfer/api/presenter"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/repository"
+	"github.com/gsabadini/go-bank-transfer/repository/mongodb"
+	"github.com/gsabadini/go-bank-transfer/repository/postgres"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type reconciliation struct {
+	log        logger.Logger
+	dbSQL      repository.SQLHandler
+	dbNoSQL    repository.NoSQLHandler
+	ctxTimeout time.Duration
+	out        io.Writer
+}
+
+func newReconciliation(
+	log logger.Logger,
+	dbSQL repository.SQLHandler,
+	dbNoSQL repository.NoSQLHandler,
+	t time.Duration,
+) reconciliation {
+	return reconciliation{
+		log:        log,
+		dbSQL:      dbSQL,
+		dbNoSQL:    dbNoSQL,
+		ctxTimeout: t,
+		out:        os.Stdout,
+	}
+}
+
+//Run concilia os saldos das Account com o histórico e escreve o relatório, retornando erro quando há divergências
+//
+//Flags: -database postgres|mongodb (padrão: postgres), -format json|csv (padrão: json) e -open-corrections
+func (r reconciliation) Run(args []string) error {
+	var (
+		flags           = flag.NewFlagSet(CommandReconciliation, flag.ContinueOnError)
+		database        = flags.String("database", databasePostgres, "repository backend [postgres mongodb]")
+		format          = flags.String("format", formatJSON, "report format [json csv]")
+		openCorrections = flags.Bool("open-corrections", false, "open a pending balance correction for each divergence")
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *format != formatJSON && *format != formatCSV {
+		return errInvalidFormat
+	}
+
+	uc, err := r.buildUseCase(*database)
+	if err != nil {
+		return err
+	}
+
+	output, err := uc.Execute(context.Background(), *openCorrections)
+	if err != nil {
+		r.log.WithFields(logger.Fields{
+			"key": CommandReconciliation,
+		}).WithError(err).Errorf("error when reconciling balances")
+
+		return err
+	}
+
+	if err := r.write(*format, output); err != nil {
+		return err
+	}
+
+	for _, item := range output.Items {
+		r.log.WithFields(logger.Fields{
+			"key":              CommandReconciliation,
+			"account_id":       item.AccountID,
+			"stored_balance":   item.StoredBalance,
+			"computed_balance": item.ComputedBalance,
+			"correction_id":    item.CorrectionID,
+		}).Warnf("balance divergent from transfer history")
+	}
+
+	r.log.WithFields(logger.Fields{
+		"key":       CommandReconciliation,
+		"checked":   output.Checked,
+		"divergent": output.Divergent,
+	}).Infof("success when reconciling balances")
+
+	if output.Divergent > 0 {
+		return errBalanceDiscrepancies
+	}
+
+	return nil
+}
+
+func (r reconciliation) write(format string, output usecase.ReconciliationOutput) error {
+	if format == formatCSV {
+		return export.ReconciliationCSV(r.out, output)
+	}
+
+	return json.NewEncoder(r.out).Encode(output)
+}
+
+func (r reconciliation) buildUseCase(database string) (usecase.ReconciliationUseCase, error) {
+	switch database {
+	case databasePostgres:
+		return usecase.NewReconciliation(
+			postgres.NewAccountRepository(r.dbSQL),
+			postgres.NewTransferRepository(r.dbSQL),
+			postgres.NewBalanceCorrectionRepository(r.dbSQL),
+			presenter.NewReconciliationPresenter(),
+			r.ctxTimeout,
+		), nil
+	case databaseMongoDB:
+		return usecase.NewReconciliation(
+			mongodb.NewAccountRepository(r.dbNoSQL),
+			mongodb.NewTransferRepository(r.dbNoSQL),
+			mongodb.NewBalanceCorrectionRepository(r.dbNoSQL),
+			presenter.NewReconciliationPresenter(),
+			r.ctxTimeout,
+		), nil
+	default:
+		return nil, errInvalidDatabase
+	}
+}
@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/webhook"
+	"github.com/gsabadini/go-bank-transfer/repository"
+	"github.com/gsabadini/go-bank-transfer/repository/mongodb"
+	"github.com/gsabadini/go-bank-transfer/repository/postgres"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+var errInvalidMaxAttempts = errors.New("invalid max attempts, must be greater than zero")
+
+type webhookDelivery struct {
+	log        logger.Logger
+	dbSQL      repository.SQLHandler
+	dbNoSQL    repository.NoSQLHandler
+	ctxTimeout time.Duration
+}
+
+func newWebhookDelivery(
+	log logger.Logger,
+	dbSQL repository.SQLHandler,
+	dbNoSQL repository.NoSQLHandler,
+	t time.Duration,
+) webhookDelivery {
+	return webhookDelivery{
+		log:        log,
+		dbSQL:      dbSQL,
+		dbNoSQL:    dbNoSQL,
+		ctxTimeout: t,
+	}
+}
+
+//Run envia periodicamente as entregas de webhooks pendentes até receber SIGINT ou SIGTERM
+//
+//Flags: -database postgres|mongodb (padrão: postgres), -interval (padrão: 1s), -batch-size (padrão: 100),
+//-max-attempts (padrão: 5), -backoff (padrão: 30s), -max-backoff (padrão: 1h), -timeout (padrão: 10s) e -once,
+//que executa um único envio
+func (w webhookDelivery) Run(args []string) error {
+	var (
+		flags       = flag.NewFlagSet(CommandWebhookDelivery, flag.ContinueOnError)
+		database    = flags.String("database", databasePostgres, "repository backend [postgres mongodb]")
+		interval    = flags.Duration("interval", time.Second, "polling interval")
+		batchSize   = flags.Int("batch-size", 100, "maximum deliveries sent per poll")
+		maxAttempts = flags.Int("max-attempts", 5, "attempts before a delivery is marked as dead")
+		backoff     = flags.Duration("backoff", 30*time.Second, "wait after the first failed attempt, doubled on each retry")
+		maxBackoff  = flags.Duration("max-backoff", time.Hour, "maximum wait between attempts")
+		timeout     = flags.Duration("timeout", 10*time.Second, "HTTP timeout for each delivery")
+		once        = flags.Bool("once", false, "send due deliveries once and exit")
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *batchSize <= 0 {
+		return errInvalidBatchSize
+	}
+
+	if *maxAttempts <= 0 {
+		return errInvalidMaxAttempts
+	}
+
+	var policy = domain.WebhookRetryPolicy{MaxAttempts: *maxAttempts, Backoff: *backoff, MaxBackoff: *maxBackoff}
+
+	uc, err := w.buildUseCase(*database, webhook.NewHTTPSender(*timeout), policy)
+	if err != nil {
+		return err
+	}
+
+	if *once {
+		return w.deliver(uc, *batchSize)
+	}
+
+	var (
+		stop   = make(chan os.Signal, 1)
+		ticker = time.NewTicker(*interval)
+	)
+	defer ticker.Stop()
+
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(stop)
+
+	w.log.WithFields(logger.Fields{
+		"key":      CommandWebhookDelivery,
+		"database": *database,
+		"interval": interval.String(),
+	}).Infof("Starting webhook delivery")
+
+	for {
+		//erros são registrados no log e o envio é tentado novamente no próximo intervalo
+		_ = w.deliver(uc, *batchSize)
+
+		select {
+		case <-stop:
+			w.log.WithFields(logger.Fields{"key": CommandWebhookDelivery}).Infof("Stopping webhook delivery")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w webhookDelivery) deliver(uc usecase.WebhookDispatchUseCase, batchSize int) error {
+	output, err := uc.Deliver(context.Background(), batchSize)
+	if err != nil {
+		w.log.WithFields(logger.Fields{
+			"key": CommandWebhookDelivery,
+		}).WithError(err).Errorf("error when sending webhook deliveries")
+
+		return err
+	}
+
+	if output.Dead > 0 {
+		w.log.WithFields(logger.Fields{
+			"key":  CommandWebhookDelivery,
+			"dead": output.Dead,
+		}).Warnf("webhook deliveries exhausted their attempts")
+	}
+
+	if output.Succeeded > 0 || output.Retrying > 0 || output.Dead > 0 {
+		w.log.WithFields(logger.Fields{
+			"key":       CommandWebhookDelivery,
+			"succeeded": output.Succeeded,
+			"retrying":  output.Retrying,
+			"dead":      output.Dead,
+		}).Infof("success when sending webhook deliveries")
+	}
+
+	return nil
+}
+
+func (w webhookDelivery) buildUseCase(
+	database string,
+	sender usecase.WebhookSender,
+	policy domain.WebhookRetryPolicy,
+) (usecase.WebhookDispatchUseCase, error) {
+	switch database {
+	case databasePostgres:
+		return usecase.NewWebhookDispatch(
+			postgres.NewWebhookSubscriptionRepository(w.dbSQL),
+			postgres.NewWebhookDeliveryRepository(w.dbSQL),
+			sender,
+			policy,
+			w.ctxTimeout,
+		), nil
+	case databaseMongoDB:
+		return usecase.NewWebhookDispatch(
+			mongodb.NewWebhookSubscriptionRepository(w.dbNoSQL),
+			mongodb.NewWebhookDeliveryRepository(w.dbNoSQL),
+			sender,
+			policy,
+			w.ctxTimeout,
+		), nil
+	default:
+		return nil, errInvalidDatabase
+	}
+}
@@ -1,14 +1,30 @@
 package infrastructure
 
 import (
+	"errors"
 	"strconv"
 	"time"
 
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/cli"
 	"github.com/gsabadini/go-bank-transfer/infrastructure/database"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/event"
 	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
 	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
 	"github.com/gsabadini/go-bank-transfer/infrastructure/web"
 	"github.com/gsabadini/go-bank-transfer/repository"
+	"github.com/gsabadini/go-bank-transfer/repository/mongodb"
+	"github.com/gsabadini/go-bank-transfer/repository/postgres"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+var (
+	errInvalidRepositoriesInstance = errors.New("invalid repositories instance")
+)
+
+const (
+	RepositoriesPostgres int = iota
+	RepositoriesMongoDB
 )
 
 //config armazena a estrutura de configuração da aplicação
@@ -18,6 +34,10 @@ type config struct {
 	validator     validator.Validator
 	dbSQL         repository.SQLHandler
 	dbNoSQL       repository.NoSQLHandler
+	publisher     usecase.EventPublisher
+	repositories  repository.Repositories
+	verifier      auth.TokenVerifier
+	oauth         oauthConfig
 	ctxTimeout    time.Duration
 	webServerPort web.Port
 	webServer     web.Server
@@ -87,17 +107,62 @@ func (c *config) Validator(instance int) *config {
 	return c
 }
 
+//EventPublisher configura a publicação síncrona, registrando no log os eventos entregues pelo relay do outbox
+func (c *config) EventPublisher() *config {
+	var publisher = event.NewSynchronous(c.logger)
+	publisher.Subscribe(event.NewLogHandler(c.logger))
+
+	c.logger.Infof("Successfully configured event publisher")
+
+	c.publisher = publisher
+	return c
+}
+
+//Repositories configura o banco de dados usado pelos repositórios do web server, independente do roteador escolhido
+func (c *config) Repositories(instance int) *config {
+	switch instance {
+	case RepositoriesPostgres:
+		c.repositories = postgres.NewRepositories(c.dbSQL)
+	case RepositoriesMongoDB:
+		c.repositories = mongodb.NewRepositories(c.dbNoSQL)
+	default:
+		panic(errInvalidRepositoriesInstance)
+	}
+
+	c.logger.Infof("Successfully configured repositories")
+	return c
+}
+
+//Authentication configura a verificação das credenciais exigidas pelo web server e a emissão dos tokens OAuth
+func (c *config) Authentication() *config {
+	verifier, err := newJWTVerifier()
+	if err != nil {
+		c.logger.WithError(err).Fatalln("Could not configure the authentication")
+		panic(err)
+	}
+
+	oauth, err := newOAuthConfig()
+	if err != nil {
+		c.logger.WithError(err).Fatalln("Could not configure the oauth token endpoint")
+		panic(err)
+	}
+
+	c.logger.Infof("Successfully configured authentication")
+
+	c.verifier = verifier
+	c.oauth = oauth
+	return c
+}
+
+//WebServer configura o web server com os handlers construídos uma única vez a partir das dependências configuradas
 func (c *config) WebServer(instance int) *config {
-	s, err := web.NewWebServerFactory(
-		instance,
-		c.logger,
-		c.dbSQL,
-		c.dbNoSQL,
-		c.validator,
-		c.webServerPort,
-		c.ctxTimeout,
-	)
+	handlers, err := newHandlers(c.logger, c.repositories, c.validator, c.verifier, c.oauth, c.ctxTimeout)
+	if err != nil {
+		c.logger.WithError(err).Fatalln("Could not build the web server dependencies")
+		panic(err)
+	}
 
+	s, err := web.NewWebServerFactory(instance, c.logger, handlers, c.webServerPort)
 	if err != nil {
 		panic(err)
 	}
@@ -118,6 +183,18 @@ func (c *config) WebServerPort(port string) *config {
 	return c
 }
 
+//Command executa um comando de linha de comando no lugar do web server
+func (c *config) Command(name string, args []string) {
+	cmd, err := cli.NewCommandFactory(name, c.logger, c.dbSQL, c.dbNoSQL, c.publisher, c.ctxTimeout)
+	if err != nil {
+		c.logger.WithError(err).Fatalln("Could not configure the command")
+	}
+
+	if err := cmd.Run(args); err != nil {
+		c.logger.WithError(err).Fatalln("Error running the command")
+	}
+}
+
 func (c *config) Start() {
 	c.webServer.Listen()
 }
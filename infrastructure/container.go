@@ -0,0 +1,166 @@
+package infrastructure
+
+import (
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/action"
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/graph"
+	"github.com/gsabadini/go-bank-transfer/api/presenter"
+	"github.com/gsabadini/go-bank-transfer/api/rpc"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/event"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/web"
+	"github.com/gsabadini/go-bank-transfer/repository"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	//accountEventBufferSize é a quantidade de eventos mantidos para a retomada dos streams de Account
+	accountEventBufferSize = 1000
+
+	//keyRingRefresh é o intervalo em que cada instância relê as chaves de assinatura dos tokens OAuth
+	keyRingRefresh = time.Minute
+)
+
+//graphQLLimits são os limites das consultas do endpoint GraphQL, a complexidade permite listar uma página padrão de
+//Account com uma página padrão de Transfer de cada uma
+var graphQLLimits = graph.Limits{
+	MaxDepth:      10,
+	MaxComplexity: 1000,
+}
+
+var errDependencyNotConfigured = errors.New("dependency not configured")
+
+//newHandlers é a raiz de composição dos servidores: constrói uma única vez, na inicialização, o grafo que vai dos
+//repositórios às actions, falhando quando alguma dependência não foi configurada
+func newHandlers(
+	log logger.Logger,
+	repos repository.Repositories,
+	v validator.Validator,
+	verifier auth.TokenVerifier,
+	oauth oauthConfig,
+	ctxTimeout time.Duration,
+) (web.Handlers, error) {
+	if err := validateDependencies(log, repos, v, verifier); err != nil {
+		return web.Handlers{}, err
+	}
+
+	var (
+		publisher = event.NewOutbox(repos.Outbox)
+
+		accountUseCase = usecase.NewAccount(
+			repos.Account,
+			presenter.NewAccountPresenter(),
+			publisher,
+			repos.Transactor,
+			ctxTimeout,
+		)
+		balanceUseCase = usecase.NewBalance(
+			repos.Account,
+			repos.Transfer,
+			repos.BalanceSnapshot,
+			presenter.NewAccountPresenter(),
+			ctxTimeout,
+		)
+		transferUseCase = usecase.NewTransfer(
+			repos.Transfer,
+			repos.Account,
+			presenter.NewTransferPresenter(),
+			publisher,
+			repos.Transactor,
+			ctxTimeout,
+		)
+		statementUseCase = usecase.NewStatement(
+			repos.Account,
+			repos.Transfer,
+			presenter.NewStatementPresenter(),
+			ctxTimeout,
+		)
+		webhookUseCase = usecase.NewWebhook(
+			repos.WebhookSubscription,
+			repos.WebhookDelivery,
+			presenter.NewWebhookPresenter(),
+			ctxTimeout,
+		)
+		apiKeyUseCase      = usecase.NewAPIKey(repos.APIKey, presenter.NewAPIKeyPresenter(), ctxTimeout)
+		oauthClientUseCase = usecase.NewOAuthClient(repos.OAuthClient, presenter.NewOAuthClientPresenter(), ctxTimeout)
+		signingKeyUseCase  = usecase.NewSigningKey(
+			repos.SigningKey,
+			presenter.NewSigningKeyPresenter(),
+			oauth.keyRotation,
+			oauth.tokenTTL,
+			keyRingRefresh,
+			ctxTimeout,
+		)
+		events = usecase.NewAccountEvent(repos.Outbox, repos.Account, accountEventBufferSize, ctxTimeout)
+
+		ring   = auth.NewKeyRing(signingKeyUseCase, keyRingRefresh)
+		issuer = auth.NewTokenIssuer(ring, oauth.issuer, oauth.audience, oauth.tokenTTL)
+	)
+
+	schema, err := graph.NewSchema(accountUseCase, transferUseCase, balanceUseCase, v, graphQLLimits)
+	if err != nil {
+		return web.Handlers{}, errors.Wrap(err, "error building graphql schema")
+	}
+
+	return web.Handlers{
+		Account:      action.NewAccountBalance(accountUseCase, balanceUseCase, log, v),
+		AccountEvent: action.NewAccountEvent(events, log),
+		Statement:    action.NewStatement(statementUseCase, log),
+		Transfer:     action.NewTransfer(transferUseCase, log, v),
+		Webhook:      action.NewWebhook(webhookUseCase, log, v),
+		APIKey:       action.NewAPIKey(apiKeyUseCase, log, v),
+		OAuthClient:  action.NewOAuthClient(oauthClientUseCase, log, v),
+		OAuth:        action.NewOAuth(oauthClientUseCase, issuer, ring, log),
+		GraphQL:      action.NewGraphQL(schema, log),
+
+		AccountService:  rpc.NewAccount(accountUseCase, balanceUseCase, v),
+		TransferService: rpc.NewTransfer(transferUseCase, v),
+
+		Verifier: auth.NewAPIKeyVerifier(
+			apiKeyUseCase,
+			auth.NewIssuedTokenVerifier(ring, oauth.issuer, oauth.audience, verifier),
+		),
+
+		Events: events,
+	}, nil
+}
+
+//validateDependencies garante que as dependências dos servidores foram configuradas antes de construir os handlers
+func validateDependencies(
+	log logger.Logger,
+	repos repository.Repositories,
+	v validator.Validator,
+	verifier auth.TokenVerifier,
+) error {
+	var dependencies = []struct {
+		name       string
+		configured bool
+	}{
+		{name: "logger", configured: log != nil},
+		{name: "validator", configured: v != nil},
+		{name: "token verifier", configured: verifier != nil},
+		{name: "account repository", configured: repos.Account != nil},
+		{name: "transfer repository", configured: repos.Transfer != nil},
+		{name: "balance snapshot repository", configured: repos.BalanceSnapshot != nil},
+		{name: "outbox repository", configured: repos.Outbox != nil},
+		{name: "webhook subscription repository", configured: repos.WebhookSubscription != nil},
+		{name: "webhook delivery repository", configured: repos.WebhookDelivery != nil},
+		{name: "api key repository", configured: repos.APIKey != nil},
+		{name: "oauth client repository", configured: repos.OAuthClient != nil},
+		{name: "signing key repository", configured: repos.SigningKey != nil},
+		{name: "transactor", configured: repos.Transactor != nil},
+	}
+
+	for _, dependency := range dependencies {
+		if !dependency.configured {
+			return errors.Wrap(errDependencyNotConfigured, dependency.name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,269 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/action"
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/presenter"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/event"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/repository"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+type stubAccountRepo struct {
+	domain.AccountRepository
+}
+
+func (s stubAccountRepo) FindByID(_ context.Context, ID domain.AccountID) (domain.Account, error) {
+	return domain.NewAccount(ID, "Test", "07091054954", domain.TaxIDTypeCPF, 100, 100, time.Time{}), nil
+}
+
+type stubTransferRepo struct {
+	domain.TransferRepository
+}
+
+type stubBalanceSnapshotRepo struct {
+	domain.BalanceSnapshotRepository
+}
+
+type stubOutboxRepo struct {
+	domain.OutboxRepository
+}
+
+type stubWebhookSubscriptionRepo struct {
+	domain.WebhookSubscriptionRepository
+}
+
+type stubWebhookDeliveryRepo struct {
+	domain.WebhookDeliveryRepository
+}
+
+type stubAPIKeyRepo struct {
+	domain.APIKeyRepository
+}
+
+type stubOAuthClientRepo struct {
+	domain.OAuthClientRepository
+}
+
+type stubSigningKeyRepo struct {
+	domain.SigningKeyRepository
+}
+
+type stubTransactor struct {
+	domain.Transactor
+}
+
+func stubRepositories() repository.Repositories {
+	return repository.Repositories{
+		Account:             stubAccountRepo{},
+		Transfer:            stubTransferRepo{},
+		BalanceSnapshot:     stubBalanceSnapshotRepo{},
+		Outbox:              stubOutboxRepo{},
+		WebhookSubscription: stubWebhookSubscriptionRepo{},
+		WebhookDelivery:     stubWebhookDeliveryRepo{},
+		APIKey:              stubAPIKeyRepo{},
+		OAuthClient:         stubOAuthClientRepo{},
+		SigningKey:          stubSigningKeyRepo{},
+		Transactor:          stubTransactor{},
+	}
+}
+
+var testOAuthConfig = oauthConfig{
+	issuer:      defaultOAuthIssuer,
+	tokenTTL:    defaultOAuthTokenTTL,
+	keyRotation: defaultOAuthKeyRotation,
+}
+
+func TestNewHandlers(t *testing.T) {
+	v, err := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifier, err := auth.NewJWTVerifier([]byte("secret"), nil, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	withoutTransactor := stubRepositories()
+	withoutTransactor.Transactor = nil
+
+	withoutWebhookDelivery := stubRepositories()
+	withoutWebhookDelivery.WebhookDelivery = nil
+
+	withoutSigningKey := stubRepositories()
+	withoutSigningKey.SigningKey = nil
+
+	tests := []struct {
+		name          string
+		log           logger.Logger
+		repos         repository.Repositories
+		validator     validator.Validator
+		verifier      auth.TokenVerifier
+		expectedError string
+	}{
+		{
+			name:      "All dependencies configured",
+			log:       logger.LoggerMock{},
+			repos:     stubRepositories(),
+			validator: v,
+			verifier:  verifier,
+		},
+		{
+			name:          "Repositories not configured",
+			log:           logger.LoggerMock{},
+			repos:         repository.Repositories{},
+			validator:     v,
+			verifier:      verifier,
+			expectedError: "account repository: dependency not configured",
+		},
+		{
+			name:          "Database connection not configured",
+			log:           logger.LoggerMock{},
+			repos:         withoutTransactor,
+			validator:     v,
+			verifier:      verifier,
+			expectedError: "transactor: dependency not configured",
+		},
+		{
+			name:          "Single repository not configured",
+			log:           logger.LoggerMock{},
+			repos:         withoutWebhookDelivery,
+			validator:     v,
+			verifier:      verifier,
+			expectedError: "webhook delivery repository: dependency not configured",
+		},
+		{
+			name:          "Signing key repository not configured",
+			log:           logger.LoggerMock{},
+			repos:         withoutSigningKey,
+			validator:     v,
+			verifier:      verifier,
+			expectedError: "signing key repository: dependency not configured",
+		},
+		{
+			name:          "Validator not configured",
+			log:           logger.LoggerMock{},
+			repos:         stubRepositories(),
+			verifier:      verifier,
+			expectedError: "validator: dependency not configured",
+		},
+		{
+			name:          "Token verifier not configured",
+			log:           logger.LoggerMock{},
+			repos:         stubRepositories(),
+			validator:     v,
+			expectedError: "token verifier: dependency not configured",
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := newHandlers(tt.log, tt.repos, tt.validator, tt.verifier, testOAuthConfig, time.Second)
+
+		var result string
+		if err != nil {
+			result = err.Error()
+		}
+
+		if result != tt.expectedError {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expectedError)
+		}
+	}
+}
+
+//BenchmarkFindByIDAccount compara as alocações de uma request quando as dependências são construídas a cada request,
+//como nos handlers anteriores à raiz de composição, e quando são construídas uma única vez na inicialização
+func BenchmarkFindByIDAccount(b *testing.B) {
+	v, err := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	verifier, err := auth.NewJWTVerifier([]byte("secret"), nil, "", "")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var (
+		log       = logger.LoggerMock{}
+		repos     = stubRepositories()
+		publisher = event.NewOutbox(repos.Outbox)
+		req       = httptest.NewRequest(
+			http.MethodGet,
+			"/v1/accounts?account_id=3c096a40-ccba-4b58-93ed-57379ab04680",
+			nil,
+		)
+	)
+
+	//O Principal do titular faz a request percorrer a busca real, e não parar na verificação de acesso
+	req = req.WithContext(auth.WithPrincipal(
+		req.Context(),
+		auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04680", auth.AccountHolderScopes()),
+	))
+
+	var assertOK = func(b *testing.B, handler http.HandlerFunc) {
+		var w = httptest.NewRecorder()
+		handler(w, req)
+
+		if w.Code != http.StatusOK {
+			b.Fatalf("[TestCase 'FindByID'] Result: '%v' | Expected: '%v' (%s)", w.Code, http.StatusOK, w.Body)
+		}
+	}
+
+	var perRequest = func(w http.ResponseWriter, r *http.Request) {
+		var (
+			accountUseCase = usecase.NewAccount(
+				repos.Account,
+				presenter.NewAccountPresenter(),
+				publisher,
+				repos.Transactor,
+				time.Second,
+			)
+			balanceUseCase = usecase.NewBalance(
+				repos.Account,
+				repos.Transfer,
+				repos.BalanceSnapshot,
+				presenter.NewAccountPresenter(),
+				time.Second,
+			)
+		)
+
+		action.NewAccountBalance(accountUseCase, balanceUseCase, log, v).FindByID(w, r)
+	}
+
+	b.Run("PerRequest", func(b *testing.B) {
+		assertOK(b, perRequest)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			perRequest(httptest.NewRecorder(), req)
+		}
+	})
+
+	b.Run("Startup", func(b *testing.B) {
+		handlers, err := newHandlers(log, repos, v, verifier, testOAuthConfig, time.Second)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		assertOK(b, handlers.Account.FindByID)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			handlers.Account.FindByID(httptest.NewRecorder(), req)
+		}
+	})
+}
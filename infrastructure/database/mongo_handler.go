@@ -3,7 +3,9 @@ package database
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -79,6 +81,46 @@ func (mgo mongoHandler) FindAll(ctx context.Context, collection string, query in
 	return nil
 }
 
+//FindPage realiza uma busca ordenada e limitada no banco de dados, campos de sort prefixados com "-" são decrescentes
+func (mgo mongoHandler) FindPage(
+	ctx context.Context,
+	collection string,
+	query interface{},
+	sort []string,
+	limit int,
+	result interface{},
+) error {
+	var sortDoc = make(bson.D, 0, len(sort))
+	for _, field := range sort {
+		if strings.HasPrefix(field, "-") {
+			sortDoc = append(sortDoc, bson.E{Key: strings.TrimPrefix(field, "-"), Value: -1})
+			continue
+		}
+
+		sortDoc = append(sortDoc, bson.E{Key: field, Value: 1})
+	}
+
+	cur, err := mgo.db.Collection(collection).Find(
+		ctx,
+		query,
+		options.Find().SetSort(sortDoc).SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return err
+	}
+
+	defer cur.Close(ctx)
+	if err = cur.All(ctx, result); err != nil {
+		return err
+	}
+
+	if err := cur.Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 //FindOne realiza a busca de um item específico no banco de dados
 func (mgo mongoHandler) FindOne(
 	ctx context.Context,
@@ -99,3 +141,54 @@ func (mgo mongoHandler) FindOne(
 
 	return nil
 }
+
+//Aggregate executa um pipeline de agregação no banco de dados
+func (mgo mongoHandler) Aggregate(ctx context.Context, collection string, pipeline interface{}, result interface{}) error {
+	cur, err := mgo.db.Collection(collection).Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+
+	defer cur.Close(ctx)
+	if err = cur.All(ctx, result); err != nil {
+		return err
+	}
+
+	if err := cur.Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+//Delete remove os registros que atendem à query e retorna a quantidade removida
+func (mgo mongoHandler) Delete(ctx context.Context, collection string, query interface{}) (int64, error) {
+	result, err := mgo.db.Collection(collection).DeleteMany(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
+
+//WithTransaction executa fn em uma transação, confirmada apenas quando fn não retorna erro
+//
+//As operações realizadas com o contexto recebido por fn participam da transação, e uma chamada aninhada
+//reutiliza a sessão já aberta. Transações exigem um MongoDB 4.0+ executando como replica set
+func (mgo mongoHandler) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	if mongo.SessionFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	session, err := mgo.db.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+
+	return err
+}
@@ -50,6 +50,21 @@ func (mgo mongoHandlerDeprecated) FindAll(_ context.Context, collection string,
 	return mgo.database.C(collection).With(session).Find(query).All(result)
 }
 
+//FindPage realiza uma busca ordenada e limitada no banco de dados, campos de sort prefixados com "-" são decrescentes
+func (mgo mongoHandlerDeprecated) FindPage(
+	_ context.Context,
+	collection string,
+	query interface{},
+	sort []string,
+	limit int,
+	result interface{},
+) error {
+	session := mgo.session.Clone()
+	defer session.Close()
+
+	return mgo.database.C(collection).With(session).Find(query).Sort(sort...).Limit(limit).All(result)
+}
+
 //FindOne realiza a busca de um item específico no banco de dados
 func (mgo mongoHandlerDeprecated) FindOne(
 	_ context.Context,
@@ -63,3 +78,29 @@ func (mgo mongoHandlerDeprecated) FindOne(
 
 	return mgo.database.C(collection).With(session).Find(query).Select(selector).One(result)
 }
+
+//Aggregate executa um pipeline de agregação no banco de dados
+func (mgo mongoHandlerDeprecated) Aggregate(_ context.Context, collection string, pipeline interface{}, result interface{}) error {
+	session := mgo.session.Clone()
+	defer session.Close()
+
+	return mgo.database.C(collection).With(session).Pipe(pipeline).All(result)
+}
+
+//Delete remove os registros que atendem à query e retorna a quantidade removida
+func (mgo mongoHandlerDeprecated) Delete(_ context.Context, collection string, query interface{}) (int64, error) {
+	session := mgo.session.Clone()
+	defer session.Close()
+
+	info, err := mgo.database.C(collection).With(session).RemoveAll(query)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(info.Removed), nil
+}
+
+//WithTransaction executa fn diretamente, o driver mgo não oferece suporte a transações
+func (mgo mongoHandlerDeprecated) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
@@ -39,27 +39,52 @@ func NewPostgresHandler(c *config) (*postgresHandler, error) {
 	return &postgresHandler{db: db}, nil
 }
 
-//BeginTx
+//txKey é a chave do contexto que carrega a transação aberta por WithTransaction
+type txKey struct{}
+
+//BeginTx inicia uma nova transação
 func (p postgresHandler) BeginTx(ctx context.Context) (postgresTx, error) {
 	tx, err := p.db.BeginTx(ctx, nil)
 	if err != nil {
 		return postgresTx{}, err
 	}
 
-	return postgresTx{tx: tx}, nil
+	return newPostgresTx(tx), nil
 }
 
-//postgresRow
+//WithTransaction executa fn em uma transação, confirmada apenas quando fn não retorna erro
+//
+//As chamadas de ExecuteContext e QueryContext com o contexto recebido por fn participam da transação, e uma
+//chamada aninhada reutiliza a transação já aberta
+func (p postgresHandler) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(postgresTx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := p.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+//postgresTx armazena a estrutura de uma transação do Postgres
 type postgresTx struct {
 	tx *sql.Tx
 }
 
-//Commit
+//Commit confirma a transação
 func (p postgresTx) Commit() error {
 	return p.tx.Commit()
 }
 
-//Rollback
+//Rollback desfaz a transação
 func (p postgresTx) Rollback() error {
 	return p.tx.Rollback()
 }
@@ -69,9 +94,24 @@ func newPostgresTx(tx *sql.Tx) postgresTx {
 	return postgresTx{tx: tx}
 }
 
+//sqlExecutor expõe os métodos comuns a *sql.DB e *sql.Tx
+type sqlExecutor interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+}
+
+//executor retorna a transação carregada pelo contexto ou, na sua ausência, a conexão com o banco
+func (p postgresHandler) executor(ctx context.Context) sqlExecutor {
+	if tx, ok := ctx.Value(txKey{}).(postgresTx); ok {
+		return tx.tx
+	}
+
+	return p.db
+}
+
 //ExecuteContext
 func (p postgresHandler) ExecuteContext(ctx context.Context, query string, args ...interface{}) error {
-	_, err := p.db.ExecContext(ctx, query, args...)
+	_, err := p.executor(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return err
 	}
@@ -81,7 +121,7 @@ func (p postgresHandler) ExecuteContext(ctx context.Context, query string, args
 
 //Query
 func (p postgresHandler) QueryContext(ctx context.Context, query string, args ...interface{}) (repository.Row, error) {
-	rows, err := p.db.QueryContext(ctx, query, args...)
+	rows, err := p.executor(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
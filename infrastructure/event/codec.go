@@ -0,0 +1,159 @@
+package event
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//errUnknownEventType é um erro de mensagem com um tipo de evento sem serialização conhecida
+var errUnknownEventType = errors.New("unknown event type")
+
+//accountCreatedPayload armazena a serialização de um AccountCreated, com valores em centavos
+type accountCreatedPayload struct {
+	AccountID      string    `json:"account_id"`
+	Name           string    `json:"name"`
+	TaxID          string    `json:"tax_id"`
+	TaxIDType      string    `json:"tax_id_type"`
+	Balance        int64     `json:"balance"`
+	InitialBalance int64     `json:"initial_balance"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+//balanceChangedPayload armazena a serialização de um BalanceChanged, com valores em centavos
+type balanceChangedPayload struct {
+	AccountID  string    `json:"account_id"`
+	Previous   int64     `json:"previous"`
+	Current    int64     `json:"current"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+//transferCompletedPayload armazena a serialização de um TransferCompleted, com valores em centavos
+type transferCompletedPayload struct {
+	TransferID           string    `json:"transfer_id"`
+	AccountOriginID      string    `json:"account_origin_id"`
+	AccountDestinationID string    `json:"account_destination_id"`
+	Amount               int64     `json:"amount"`
+	Status               string    `json:"status"`
+	CreatedAt            time.Time `json:"created_at"`
+}
+
+//transferFailedPayload armazena a serialização de um TransferFailed, com valores em centavos
+type transferFailedPayload struct {
+	AccountOriginID      string    `json:"account_origin_id"`
+	AccountDestinationID string    `json:"account_destination_id"`
+	Amount               int64     `json:"amount"`
+	Reason               string    `json:"reason"`
+	OccurredAt           time.Time `json:"occurred_at"`
+}
+
+//Encode serializa um evento de domínio
+func Encode(event domain.Event) ([]byte, error) {
+	switch e := event.(type) {
+	case domain.AccountCreated:
+		return json.Marshal(accountCreatedPayload{
+			AccountID:      e.Account().ID().String(),
+			Name:           e.Account().Name(),
+			TaxID:          e.Account().TaxID(),
+			TaxIDType:      e.Account().TaxIDType().String(),
+			Balance:        e.Account().Balance().Int64(),
+			InitialBalance: e.Account().InitialBalance().Int64(),
+			CreatedAt:      e.Account().CreatedAt(),
+		})
+	case domain.BalanceChanged:
+		return json.Marshal(balanceChangedPayload{
+			AccountID:  e.AccountID().String(),
+			Previous:   e.Previous().Int64(),
+			Current:    e.Current().Int64(),
+			OccurredAt: e.OccurredAt(),
+		})
+	case domain.TransferCompleted:
+		return json.Marshal(transferCompletedPayload{
+			TransferID:           e.Transfer().ID().String(),
+			AccountOriginID:      e.Transfer().AccountOriginID().String(),
+			AccountDestinationID: e.Transfer().AccountDestinationID().String(),
+			Amount:               e.Transfer().Amount().Int64(),
+			Status:               e.Transfer().Status().String(),
+			CreatedAt:            e.Transfer().CreatedAt(),
+		})
+	case domain.TransferFailed:
+		var reason string
+		if e.Reason() != nil {
+			reason = e.Reason().Error()
+		}
+
+		return json.Marshal(transferFailedPayload{
+			AccountOriginID:      e.AccountOriginID().String(),
+			AccountDestinationID: e.AccountDestinationID().String(),
+			Amount:               e.Amount().Int64(),
+			Reason:               reason,
+			OccurredAt:           e.OccurredAt(),
+		})
+	default:
+		return nil, errUnknownEventType
+	}
+}
+
+//Decode reconstrói um evento de domínio a partir do seu tipo e da sua serialização
+func Decode(eventType domain.EventType, payload []byte) (domain.Event, error) {
+	switch eventType {
+	case domain.EventAccountCreated:
+		var p accountCreatedPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return nil, err
+		}
+
+		return domain.NewAccountCreated(domain.NewAccount(
+			domain.AccountID(p.AccountID),
+			p.Name,
+			p.TaxID,
+			domain.TaxIDType(p.TaxIDType),
+			domain.Money(p.Balance),
+			domain.Money(p.InitialBalance),
+			p.CreatedAt,
+		)), nil
+	case domain.EventBalanceChanged:
+		var p balanceChangedPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return nil, err
+		}
+
+		return domain.NewBalanceChanged(
+			domain.AccountID(p.AccountID),
+			domain.Money(p.Previous),
+			domain.Money(p.Current),
+			p.OccurredAt,
+		), nil
+	case domain.EventTransferCompleted:
+		var p transferCompletedPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return nil, err
+		}
+
+		return domain.NewTransferCompleted(domain.NewTransfer(
+			domain.TransferID(p.TransferID),
+			domain.AccountID(p.AccountOriginID),
+			domain.AccountID(p.AccountDestinationID),
+			domain.Money(p.Amount),
+			domain.TransferStatus(p.Status),
+			p.CreatedAt,
+		)), nil
+	case domain.EventTransferFailed:
+		var p transferFailedPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return nil, err
+		}
+
+		return domain.NewTransferFailed(
+			domain.AccountID(p.AccountOriginID),
+			domain.AccountID(p.AccountDestinationID),
+			domain.Money(p.Amount),
+			errors.New(p.Reason),
+			p.OccurredAt,
+		), nil
+	default:
+		return nil, errUnknownEventType
+	}
+}
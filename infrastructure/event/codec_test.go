@@ -0,0 +1,151 @@
+package event
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+func TestCodec_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var at = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		event    domain.Event
+		expected []byte
+	}{
+		{
+			name: "AccountCreated",
+			event: domain.NewAccountCreated(domain.NewAccount(
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				"Test",
+				"02815517078",
+				domain.TaxIDTypeCPF,
+				1050,
+				1000,
+				at,
+			)),
+			expected: []byte(`{"account_id":"3c096a40-ccba-4b58-93ed-57379ab04680","name":"Test","tax_id":"02815517078","tax_id_type":"cpf","balance":1050,"initial_balance":1000,"created_at":"2020-06-01T12:00:00Z"}`),
+		},
+		{
+			name:     "BalanceChanged",
+			event:    domain.NewBalanceChanged("3c096a40-ccba-4b58-93ed-57379ab04680", 1050, 550, at),
+			expected: []byte(`{"account_id":"3c096a40-ccba-4b58-93ed-57379ab04680","previous":1050,"current":550,"occurred_at":"2020-06-01T12:00:00Z"}`),
+		},
+		{
+			name: "TransferCompleted",
+			event: domain.NewTransferCompleted(domain.NewTransfer(
+				"b51cd6c7-a55c-491e-9140-91903fe66fa9",
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				"3c096a40-ccba-4b58-93ed-57379ab04681",
+				500,
+				domain.TransferStatusCompleted,
+				at,
+			)),
+			expected: []byte(`{"transfer_id":"b51cd6c7-a55c-491e-9140-91903fe66fa9","account_origin_id":"3c096a40-ccba-4b58-93ed-57379ab04680","account_destination_id":"3c096a40-ccba-4b58-93ed-57379ab04681","amount":500,"status":"completed","created_at":"2020-06-01T12:00:00Z"}`),
+		},
+		{
+			name: "TransferFailed",
+			event: domain.NewTransferFailed(
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				"3c096a40-ccba-4b58-93ed-57379ab04681",
+				500,
+				domain.ErrInsufficientBalance,
+				at,
+			),
+			expected: []byte(`{"account_origin_id":"3c096a40-ccba-4b58-93ed-57379ab04680","account_destination_id":"3c096a40-ccba-4b58-93ed-57379ab04681","amount":500,"reason":"origin account does not have sufficient balance","occurred_at":"2020-06-01T12:00:00Z"}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := Encode(tt.event)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, nil)
+			}
+
+			if !bytes.Equal(payload, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%s' | Expected: '%s'", tt.name, payload, tt.expected)
+			}
+
+			decoded, err := Decode(tt.event.Type(), payload)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, nil)
+			}
+
+			if decoded.Type() != tt.event.Type() {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, decoded.Type(), tt.event.Type())
+			}
+
+			if !reflect.DeepEqual(decoded.AccountIDs(), tt.event.AccountIDs()) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, decoded.AccountIDs(), tt.event.AccountIDs())
+			}
+
+			if !decoded.OccurredAt().Equal(tt.event.OccurredAt()) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, decoded.OccurredAt(), tt.event.OccurredAt())
+			}
+
+			reencoded, err := Encode(decoded)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, nil)
+			}
+
+			if !bytes.Equal(reencoded, payload) {
+				t.Errorf("[TestCase '%s'] Result: '%s' | Expected: '%s'", tt.name, reencoded, payload)
+			}
+		})
+	}
+}
+
+func TestCodec_TransferFailedReason(t *testing.T) {
+	t.Parallel()
+
+	var event = domain.NewTransferFailed(
+		"3c096a40-ccba-4b58-93ed-57379ab04680",
+		"3c096a40-ccba-4b58-93ed-57379ab04681",
+		500,
+		errors.New("origin account not found"),
+		time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC),
+	)
+
+	payload, err := Encode(event)
+	if err != nil {
+		t.Fatalf("[TestCase 'TransferFailed reason'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	decoded, err := Decode(domain.EventTransferFailed, payload)
+	if err != nil {
+		t.Fatalf("[TestCase 'TransferFailed reason'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	failed, ok := decoded.(domain.TransferFailed)
+	if !ok {
+		t.Fatalf("[TestCase 'TransferFailed reason'] Result: '%T' | Expected: '%T'", decoded, domain.TransferFailed{})
+	}
+
+	if failed.Reason() == nil || failed.Reason().Error() != "origin account not found" {
+		t.Errorf("[TestCase 'TransferFailed reason'] Result: '%v' | Expected: '%v'", failed.Reason(), "origin account not found")
+	}
+
+	if failed.Amount() != 500 {
+		t.Errorf("[TestCase 'TransferFailed reason'] Result: '%v' | Expected: '%v'", failed.Amount(), 500)
+	}
+}
+
+func TestCodec_UnknownEventType(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Decode("transfer.created", []byte(`{}`)); !errors.Is(err, errUnknownEventType) {
+		t.Errorf("[TestCase 'Decode unknown event type'] Result: '%v' | Expected: '%v'", err, errUnknownEventType)
+	}
+
+	if _, err := Decode(domain.EventBalanceChanged, []byte(`{`)); err == nil {
+		t.Errorf("[TestCase 'Decode invalid payload'] Result: '%v' | Expected: an error", err)
+	}
+}
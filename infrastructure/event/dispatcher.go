@@ -0,0 +1,33 @@
+package event
+
+import (
+	"context"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//eventPublisher expõe o método de publicação comum ao Synchronous e ao Recorder
+type eventPublisher interface {
+	Publish(context.Context, ...domain.Event) error
+}
+
+//Dispatcher armazena a estrutura de um MessagePublisher que reconstrói os eventos do outbox e os entrega a um
+//EventPublisher
+type Dispatcher struct {
+	publisher eventPublisher
+}
+
+//NewDispatcher constrói um Dispatcher com suas dependências
+func NewDispatcher(publisher eventPublisher) Dispatcher {
+	return Dispatcher{publisher: publisher}
+}
+
+//Publish entrega o evento serializado na OutboxMessage
+func (d Dispatcher) Publish(ctx context.Context, message domain.OutboxMessage) error {
+	event, err := Decode(message.EventType(), message.Payload())
+	if err != nil {
+		return err
+	}
+
+	return d.publisher.Publish(ctx, event)
+}
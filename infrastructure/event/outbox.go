@@ -0,0 +1,49 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//Outbox armazena a estrutura de um publicador que grava os eventos no outbox em vez de entregá-los
+//
+//A gravação participa da transação carregada pelo contexto, e os eventos são entregues posteriormente
+//pelo relay do outbox
+type Outbox struct {
+	repo domain.OutboxRepository
+	now  func() time.Time
+}
+
+//NewOutbox constrói um Outbox com suas dependências
+func NewOutbox(repo domain.OutboxRepository) Outbox {
+	return Outbox{repo: repo, now: time.Now}
+}
+
+//Publish serializa os eventos e os grava no outbox, preservando a ordem de publicação
+func (o Outbox) Publish(ctx context.Context, events ...domain.Event) error {
+	var (
+		createdAt = o.now()
+		messages  = make([]domain.OutboxMessage, 0, len(events))
+	)
+
+	for position, event := range events {
+		payload, err := Encode(event)
+		if err != nil {
+			return err
+		}
+
+		messages = append(messages, domain.NewOutboxMessage(
+			domain.OutboxMessageID(domain.NewUUID()),
+			event.Type(),
+			event.AccountIDs(),
+			payload,
+			position,
+			createdAt,
+			time.Time{},
+		))
+	}
+
+	return o.repo.Store(ctx, messages...)
+}
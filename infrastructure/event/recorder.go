@@ -0,0 +1,53 @@
+package event
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//Recorder armazena os eventos publicados para verificação nos testes
+type Recorder struct {
+	mu     sync.Mutex
+	events []domain.Event
+}
+
+//NewRecorder constrói um Recorder vazio
+func NewRecorder() *Recorder {
+	return &Recorder{events: make([]domain.Event, 0)}
+}
+
+//Publish registra os eventos na ordem em que foram publicados
+func (r *Recorder) Publish(_ context.Context, events ...domain.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, events...)
+
+	return nil
+}
+
+//Events retorna uma cópia dos eventos publicados
+func (r *Recorder) Events() []domain.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var events = make([]domain.Event, len(r.events))
+	copy(events, r.events)
+
+	return events
+}
+
+//Types retorna os tipos dos eventos publicados, em ordem
+func (r *Recorder) Types() []domain.EventType {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var types = make([]domain.EventType, 0, len(r.events))
+	for _, event := range r.events {
+		types = append(types, event.Type())
+	}
+
+	return types
+}
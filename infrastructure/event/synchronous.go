@@ -0,0 +1,99 @@
+package event
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+)
+
+//Handler processa um evento de domínio publicado
+type Handler func(context.Context, domain.Event) error
+
+//Synchronous armazena a estrutura de um publicador que entrega os eventos aos handlers no mesmo processo
+type Synchronous struct {
+	mu       sync.RWMutex
+	log      logger.Logger
+	handlers map[domain.EventType][]Handler
+	all      []Handler
+}
+
+//NewSynchronous constrói um Synchronous com suas dependências
+func NewSynchronous(log logger.Logger) *Synchronous {
+	return &Synchronous{
+		log:      log,
+		handlers: make(map[domain.EventType][]Handler),
+	}
+}
+
+//Subscribe registra um handler para os eventos dos tipos informados, ou para todos quando nenhum tipo é informado
+func (s *Synchronous) Subscribe(handler Handler, eventTypes ...domain.EventType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(eventTypes) == 0 {
+		s.all = append(s.all, handler)
+		return
+	}
+
+	for _, eventType := range eventTypes {
+		s.handlers[eventType] = append(s.handlers[eventType], handler)
+	}
+}
+
+//Publish entrega cada evento, em ordem, aos handlers registrados antes de retornar
+//
+//O erro de um handler é registrado no log e não impede a entrega aos demais, o primeiro erro é retornado
+func (s *Synchronous) Publish(ctx context.Context, events ...domain.Event) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var first error
+	for _, event := range events {
+		for _, handler := range s.handlers[event.Type()] {
+			if err := s.handle(ctx, handler, event); err != nil && first == nil {
+				first = err
+			}
+		}
+
+		for _, handler := range s.all {
+			if err := s.handle(ctx, handler, event); err != nil && first == nil {
+				first = err
+			}
+		}
+	}
+
+	return first
+}
+
+func (s *Synchronous) handle(ctx context.Context, handler Handler, event domain.Event) error {
+	err := handler(ctx, event)
+	if err != nil {
+		s.log.WithFields(logger.Fields{
+			"key":   "publish_event",
+			"event": event.Type().String(),
+		}).WithError(err).Errorf("error when handling event")
+	}
+
+	return err
+}
+
+//NewLogHandler constrói um Handler que registra no log os eventos publicados
+func NewLogHandler(log logger.Logger) Handler {
+	return func(_ context.Context, event domain.Event) error {
+		var accountIDs = make([]string, 0, len(event.AccountIDs()))
+		for _, ID := range event.AccountIDs() {
+			accountIDs = append(accountIDs, ID.String())
+		}
+
+		log.WithFields(logger.Fields{
+			"key":         "domain_event",
+			"event":       event.Type().String(),
+			"account_ids": accountIDs,
+			"occurred_at": event.OccurredAt(),
+		}).Infof("domain event published")
+
+		return nil
+	}
+}
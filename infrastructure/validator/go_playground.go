@@ -2,6 +2,10 @@ package validator
 
 import (
 	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
 
 	"github.com/go-playground/locales/en"
 	ut "github.com/go-playground/universal-translator"
@@ -12,8 +16,6 @@ import (
 type goPlayground struct {
 	validator *validator.Validate
 	translate ut.Translator
-	err       error
-	msg       []string
 }
 
 //NewGoPlayground constrói uma instância do validator GoPlayground
@@ -33,28 +35,90 @@ func NewGoPlayground() (Validator, error) {
 		return nil, errors.New("translator not found")
 	}
 
+	if err := registerCustomValidations(v, translate); err != nil {
+		return nil, err
+	}
+
 	return &goPlayground{validator: v, translate: translate}, nil
 }
 
-func (g *goPlayground) Validate(i interface{}) error {
-	if len(g.msg) > 0 {
-		g.msg = nil
+func (g *goPlayground) Validate(i interface{}) []FieldError {
+	err := g.validator.Struct(i)
+	if err == nil {
+		return nil
 	}
 
-	g.err = g.validator.Struct(i)
-	if g.err != nil {
-		return g.err
+	validationErrors, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return []FieldError{{Message: err.Error()}}
 	}
 
-	return nil
+	var (
+		input       = reflect.Indirect(reflect.ValueOf(i)).Type()
+		fieldErrors = make([]FieldError, 0, len(validationErrors))
+	)
+	for _, err := range validationErrors {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   jsonFieldName(input, err),
+			Message: err.Translate(g.translate),
+		})
+	}
+
+	return fieldErrors
 }
 
-func (g *goPlayground) Messages() []string {
-	if g.err != nil {
-		for _, err := range g.err.(validator.ValidationErrors) {
-			g.msg = append(g.msg, err.Translate(g.translate))
+//jsonFieldName retorna o nome no JSON do campo com erro, ou o nome do campo quando não há tag json
+func jsonFieldName(input reflect.Type, err validator.FieldError) string {
+	field, found := input.FieldByName(err.StructField())
+	if !found {
+		return err.Field()
+	}
+
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return err.Field()
+	}
+
+	return name
+}
+
+func registerCustomValidations(v *validator.Validate, translate ut.Translator) error {
+	var validations = []struct {
+		tag     string
+		message string
+		fn      func(string) bool
+	}{
+		{tag: "cnpj", message: "{0} must be a valid CNPJ", fn: domain.IsValidCNPJ},
+		{tag: "webhook_url", message: "{0} must be an https URL with a public host", fn: domain.IsValidWebhookURL},
+	}
+
+	for _, validation := range validations {
+		var (
+			tag     = validation.tag
+			message = validation.message
+			fn      = validation.fn
+		)
+
+		if err := v.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+			return fn(fl.Field().String())
+		}); err != nil {
+			return err
+		}
+
+		if err := v.RegisterTranslation(
+			tag,
+			translate,
+			func(ut ut.Translator) error {
+				return ut.Add(tag, message, true)
+			},
+			func(ut ut.Translator, fe validator.FieldError) string {
+				t, _ := ut.T(tag, fe.Field())
+				return t
+			},
+		); err != nil {
+			return err
 		}
 	}
 
-	return g.msg
+	return nil
 }
@@ -0,0 +1,58 @@
+package validator
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type validatorInput struct {
+	Name  string `json:"name" validate:"required"`
+	Email string `json:"email" validate:"omitempty,email"`
+}
+
+func TestGoPlayground_Validate(t *testing.T) {
+	t.Parallel()
+
+	v, err := NewGoPlayground()
+	if err != nil {
+		t.Fatalf("[TestCase 'NewGoPlayground'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	tests := []struct {
+		name     string
+		input    validatorInput
+		expected []FieldError
+	}{
+		{
+			name:  "Valid input",
+			input: validatorInput{Name: "Test"},
+		},
+		{
+			name:     "Required field",
+			input:    validatorInput{},
+			expected: []FieldError{{Field: "name", Message: "Name is a required field"}},
+		},
+		{
+			name:     "Invalid field",
+			input:    validatorInput{Name: "Test", Email: "invalid"},
+			expected: []FieldError{{Field: "email", Message: "Email must be a valid email address"}},
+		},
+	}
+
+	//A mesma instância é compartilhada entre as requisições, cada chamada deve retornar apenas os seus erros
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		for _, tt := range tests {
+			wg.Add(1)
+			go func(name string, input validatorInput, expected []FieldError) {
+				defer wg.Done()
+
+				if result := v.Validate(input); !reflect.DeepEqual(result, expected) {
+					t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", name, result, expected)
+				}
+			}(tt.name, tt.input, tt.expected)
+		}
+	}
+	wg.Wait()
+}
@@ -5,9 +5,17 @@ import (
 )
 
 //Validator é uma abstração para os validator da aplicação
+//
+//Validate retorna os erros de cada chamada, sem guardar estado, para que a mesma instância seja compartilhada entre
+//requisições concorrentes
 type Validator interface {
-	Validate(interface{}) error
-	Messages() []string
+	Validate(interface{}) []FieldError
+}
+
+//FieldError armazena o erro de validação de um campo, identificado pelo seu nome no JSON de entrada
+type FieldError struct {
+	Field   string
+	Message string
 }
 
 var (
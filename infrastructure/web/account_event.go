@@ -0,0 +1,26 @@
+package web
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+//accountEventPollInterval é o intervalo entre as leituras das mensagens publicadas pelo relay do outbox
+const accountEventPollInterval = 500 * time.Millisecond
+
+//pollAccountEvents lê periodicamente os eventos publicados e os entrega aos streams de Account abertos no servidor
+func pollAccountEvents(log logger.Logger, events *usecase.AccountEvent) {
+	var ticker = time.NewTicker(accountEventPollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := events.Poll(context.Background()); err != nil {
+			log.WithFields(logger.Fields{
+				"key": "poll_account_events",
+			}).WithError(err).Errorf("error when polling account events")
+		}
+	}
+}
@@ -5,14 +5,7 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/gsabadini/go-bank-transfer/api/action"
-	"github.com/gsabadini/go-bank-transfer/api/middleware"
-	"github.com/gsabadini/go-bank-transfer/api/presenter"
 	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
-	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
-	"github.com/gsabadini/go-bank-transfer/repository"
-	"github.com/gsabadini/go-bank-transfer/repository/postgres"
-	"github.com/gsabadini/go-bank-transfer/usecase"
 
 	"github.com/gorilla/mux"
 	"github.com/urfave/negroni"
@@ -21,36 +14,32 @@ import (
 type gorillaMux struct {
 	router     *mux.Router
 	middleware *negroni.Negroni
+	handlers   Handlers
 	log        logger.Logger
-	db         repository.SQLHandler
-	validator  validator.Validator
 	port       Port
-	ctxTimeout time.Duration
 }
 
-func newGorillaMux(
-	log logger.Logger,
-	db repository.SQLHandler,
-	validator validator.Validator,
-	port Port,
-	t time.Duration,
-) *gorillaMux {
+func newGorillaMux(log logger.Logger, handlers Handlers, port Port) *gorillaMux {
 	return &gorillaMux{
 		router:     mux.NewRouter(),
 		middleware: negroni.New(),
+		handlers:   handlers,
 		log:        log,
-		db:         db,
-		validator:  validator,
 		port:       port,
-		ctxTimeout: t,
 	}
 }
 
 //Listen inicia o servidor HTTP
 func (g gorillaMux) Listen() {
 	g.setAppHandlers(g.router)
+	if validator, ok := newOpenAPIValidator(g.log); ok {
+		g.middleware.Use(negroni.HandlerFunc(validator.Execute))
+	}
 	g.middleware.UseHandler(g.router)
 
+	go pollAccountEvents(g.log, g.handlers.Events)
+
+	//O stream de eventos assume a conexão HTTP/1.1 e controla o prazo das suas escritas, sem o WriteTimeout
 	server := &http.Server{
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 15 * time.Second,
@@ -65,130 +54,12 @@ func (g gorillaMux) Listen() {
 }
 
 func (g gorillaMux) setAppHandlers(router *mux.Router) {
-	api := router.PathPrefix("/v1").Subrouter()
-
-	api.Handle("/transfers", g.buildActionStoreTransfer()).Methods(http.MethodPost)
-	api.Handle("/transfers", g.buildActionIndexTransfer()).Methods(http.MethodGet)
-
-	api.Handle("/accounts/{account_id}/balance", g.buildActionFindBalanceAccount()).Methods(http.MethodGet)
-	api.Handle("/accounts", g.buildActionStoreAccount()).Methods(http.MethodPost)
-	api.Handle("/accounts", g.buildActionFindAllAccount()).Methods(http.MethodGet)
-
-	api.HandleFunc("/healthcheck", action.HealthCheck).Methods(http.MethodGet)
-}
-
-func (g gorillaMux) buildActionStoreTransfer() *negroni.Negroni {
-	var handler http.HandlerFunc = func(res http.ResponseWriter, req *http.Request) {
-		var (
-			transferUseCase = usecase.NewTransfer(
-				postgres.NewTransferRepository(g.db),
-				postgres.NewAccountRepository(g.db),
-				presenter.NewTransferPresenter(),
-				g.ctxTimeout,
-			)
-
-			transferAction = action.NewTransfer(transferUseCase, g.log, g.validator)
-		)
+	for _, r := range g.handlers.routes(g.log) {
+		var handler = r.handle()
 
-		transferAction.Store(res, req)
+		router.HandleFunc(r.path, func(w http.ResponseWriter, req *http.Request) {
+			addPathParams(req, mux.Vars(req))
+			handler.ServeHTTP(w, req)
+		}).Methods(r.method)
 	}
-
-	return negroni.New(
-		negroni.HandlerFunc(middleware.NewLogger(g.log).Execute),
-		negroni.NewRecovery(),
-		negroni.Wrap(handler),
-	)
-}
-
-func (g gorillaMux) buildActionIndexTransfer() *negroni.Negroni {
-	var handler http.HandlerFunc = func(res http.ResponseWriter, req *http.Request) {
-		var (
-			transferUseCase = usecase.NewTransfer(
-				postgres.NewTransferRepository(g.db),
-				postgres.NewAccountRepository(g.db),
-				presenter.NewTransferPresenter(),
-				g.ctxTimeout,
-			)
-			transferAction = action.NewTransfer(transferUseCase, g.log, g.validator)
-		)
-
-		transferAction.FindAll(res, req)
-	}
-
-	return negroni.New(
-		negroni.HandlerFunc(middleware.NewLogger(g.log).Execute),
-		negroni.NewRecovery(),
-		negroni.Wrap(handler),
-	)
-}
-
-func (g gorillaMux) buildActionStoreAccount() *negroni.Negroni {
-	var handler http.HandlerFunc = func(res http.ResponseWriter, req *http.Request) {
-		var (
-			accountUseCase = usecase.NewAccount(
-				postgres.NewAccountRepository(g.db),
-				presenter.NewAccountPresenter(),
-				g.ctxTimeout,
-			)
-			accountAction = action.NewAccount(accountUseCase, g.log, g.validator)
-		)
-
-		accountAction.Store(res, req)
-	}
-
-	return negroni.New(
-		negroni.HandlerFunc(middleware.NewLogger(g.log).Execute),
-		negroni.NewRecovery(),
-		negroni.Wrap(handler),
-	)
-}
-
-func (g gorillaMux) buildActionFindAllAccount() *negroni.Negroni {
-	var handler http.HandlerFunc = func(res http.ResponseWriter, req *http.Request) {
-		var (
-			accountUseCase = usecase.NewAccount(
-				postgres.NewAccountRepository(g.db),
-				presenter.NewAccountPresenter(),
-				g.ctxTimeout,
-			)
-			accountAction = action.NewAccount(accountUseCase, g.log, g.validator)
-		)
-
-		accountAction.FindAll(res, req)
-	}
-
-	return negroni.New(
-		negroni.HandlerFunc(middleware.NewLogger(g.log).Execute),
-		negroni.NewRecovery(),
-		negroni.Wrap(handler),
-	)
-}
-
-func (g gorillaMux) buildActionFindBalanceAccount() *negroni.Negroni {
-	var handler http.HandlerFunc = func(res http.ResponseWriter, req *http.Request) {
-		var (
-			accountUseCase = usecase.NewAccount(
-				postgres.NewAccountRepository(g.db),
-				presenter.NewAccountPresenter(),
-				g.ctxTimeout,
-			)
-			accountAction = action.NewAccount(accountUseCase, g.log, g.validator)
-		)
-
-		var (
-			vars = mux.Vars(req)
-			q    = req.URL.Query()
-		)
-
-		q.Add("account_id", vars["account_id"])
-		req.URL.RawQuery = q.Encode()
-
-		accountAction.FindBalance(res, req)
-	}
-
-	return negroni.New(
-		negroni.HandlerFunc(middleware.NewLogger(g.log).Execute),
-		negroni.NewRecovery(),
-		negroni.Wrap(handler),
-	)
 }
@@ -0,0 +1,56 @@
+package web
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/gsabadini/go-bank-transfer/api/rpc"
+	"github.com/gsabadini/go-bank-transfer/api/rpc/pb"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+type grpcServer struct {
+	log      logger.Logger
+	handlers Handlers
+	port     Port
+}
+
+func newGRPCServer(log logger.Logger, handlers Handlers, port Port) *grpcServer {
+	return &grpcServer{
+		log:      log,
+		handlers: handlers,
+		port:     port,
+	}
+}
+
+//Listen inicia o servidor gRPC, com reflection para a descoberta dos serviços e o serviço de health check
+func (g grpcServer) Listen() {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		rpc.NewLoggerInterceptor(g.log),
+		rpc.NewAuthInterceptor(g.handlers.Verifier),
+	))
+
+	g.registerServices(server)
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", g.port))
+	if err != nil {
+		g.log.WithError(err).Fatalln("Error starting gRPC server")
+	}
+
+	g.log.WithFields(logger.Fields{"port": g.port}).Infof("Starting gRPC Server")
+	if err := server.Serve(listener); err != nil {
+		g.log.WithError(err).Fatalln("Error starting gRPC server")
+	}
+}
+
+func (g grpcServer) registerServices(server *grpc.Server) {
+	pb.RegisterAccountServiceServer(server, g.handlers.AccountService)
+	pb.RegisterTransferServiceServer(server, g.handlers.TransferService)
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	reflection.Register(server)
+}
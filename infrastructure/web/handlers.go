@@ -0,0 +1,32 @@
+package web
+
+import (
+	"github.com/gsabadini/go-bank-transfer/api/action"
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/rpc"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+)
+
+//Handlers agrupa as actions e os serviços gRPC prontos para uso, construídos uma única vez na inicialização da
+//aplicação e registrados pelos servidores nas suas rotas
+type Handlers struct {
+	Account      action.Account
+	AccountEvent action.AccountEvent
+	Statement    action.Statement
+	Transfer     action.Transfer
+	Webhook      action.Webhook
+	APIKey       action.APIKey
+	OAuthClient  action.OAuthClient
+	OAuth        action.OAuth
+	GraphQL      action.GraphQL
+
+	AccountService  rpc.Account
+	TransferService rpc.Transfer
+
+	//Verifier autentica as requests HTTP e as chamadas gRPC através de JWT ou APIKey, apenas o health check, a
+	//especificação OpenAPI, o endpoint de token e o JWKS são públicos
+	Verifier auth.TokenVerifier
+
+	//Events entrega aos streams de Account abertos os eventos publicados, lidos periodicamente pelos servidores HTTP
+	Events *usecase.AccountEvent
+}
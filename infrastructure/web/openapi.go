@@ -0,0 +1,40 @@
+package web
+
+import (
+	"os"
+
+	"github.com/gsabadini/go-bank-transfer/api/middleware"
+	"github.com/gsabadini/go-bank-transfer/api/openapi"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+)
+
+const (
+	//openAPIValidationRequests rejeita as requests que não seguem a especificação OpenAPI
+	openAPIValidationRequests = "requests"
+
+	//openAPIValidationAll também registra em log as responses que divergem da especificação OpenAPI
+	openAPIValidationAll = "all"
+)
+
+//newOpenAPIValidator constrói o middleware de validação conforme a variável OPENAPI_VALIDATION, retornando false
+//quando a validação está desabilitada
+func newOpenAPIValidator(log logger.Logger) (middleware.OpenAPIValidator, bool) {
+	var mode = os.Getenv("OPENAPI_VALIDATION")
+	if mode != openAPIValidationRequests && mode != openAPIValidationAll {
+		return middleware.OpenAPIValidator{}, false
+	}
+
+	doc, err := openapi.Load()
+	if err != nil {
+		log.WithError(err).Fatalln("Error loading OpenAPI specification")
+	}
+
+	validator, err := middleware.NewOpenAPIValidator(doc, log, mode == openAPIValidationAll)
+	if err != nil {
+		log.WithError(err).Fatalln("Error building OpenAPI validator")
+	}
+
+	log.WithFields(logger.Fields{"mode": mode}).Infof("Validating requests with the OpenAPI specification")
+
+	return validator, true
+}
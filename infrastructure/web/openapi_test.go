@@ -0,0 +1,138 @@
+package web
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gsabadini/go-bank-transfer/api/openapi"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/mux"
+)
+
+//openAPIBasePath é o prefixo das rotas da API, declarado como server na especificação. Os paths servidos fora da
+//versão da API, como o endpoint de token, declaram o próprio server
+const openAPIBasePath = "/v1"
+
+var ginParam = regexp.MustCompile(`:(\w+)`)
+
+func TestOpenAPI_GorillaMuxRoutes(t *testing.T) {
+	var (
+		server = newGorillaMux(logger.LoggerMock{}, Handlers{}, 0)
+		router = mux.NewRouter()
+	)
+
+	server.setAppHandlers(router)
+
+	var routes = make(map[string][]string)
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil
+		}
+
+		routes[path] = append(routes[path], methods...)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertRoutesDocumented(t, "gorilla/mux", routes)
+}
+
+func TestOpenAPI_GinRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		server = newGinServer(logger.LoggerMock{}, Handlers{}, 0)
+		router = gin.New()
+	)
+
+	server.setAppHandlers(router)
+
+	var routes = make(map[string][]string)
+	for _, route := range router.Routes() {
+		var path = ginParam.ReplaceAllString(route.Path, "{$1}")
+		routes[path] = append(routes[path], route.Method)
+	}
+
+	assertRoutesDocumented(t, "gin", routes)
+}
+
+func assertRoutesDocumented(t *testing.T, name string, routes map[string][]string) {
+	t.Helper()
+
+	doc, err := openapi.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(routes) == 0 {
+		t.Fatalf("[TestCase '%s'] Result: '%v' | Expected: '%v'", name, len(routes), "> 0")
+	}
+
+	var documented = make(map[string]*openapi3.PathItem, len(doc.Paths))
+	for path, pathItem := range doc.Paths {
+		documented[serverPath(pathItem)+path] = pathItem
+	}
+
+	for path, methods := range routes {
+		var pathItem, ok = documented[path]
+		if !ok {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", name, path, "route documented in openapi specification")
+			continue
+		}
+
+		for _, method := range methods {
+			if pathItem.GetOperation(method) == nil {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					name,
+					method+" "+path,
+					"operation documented in openapi specification",
+				)
+			}
+		}
+	}
+
+	for path, pathItem := range documented {
+		for method := range pathItem.Operations() {
+			if !hasRoute(routes[path], method) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					name,
+					method+" "+path,
+					"documented operation registered in router",
+				)
+			}
+		}
+	}
+}
+
+//serverPath retorna o prefixo das rotas de um path da especificação
+func serverPath(pathItem *openapi3.PathItem) string {
+	if len(pathItem.Servers) > 0 {
+		return strings.TrimSuffix(pathItem.Servers[0].URL, "/")
+	}
+
+	return openAPIBasePath
+}
+
+func hasRoute(methods []string, method string) bool {
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,105 @@
+package web
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/gsabadini/go-bank-transfer/api/action"
+	"github.com/gsabadini/go-bank-transfer/api/middleware"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+
+	"github.com/urfave/negroni"
+)
+
+//pathParamPattern encontra os parâmetros declarados no path de uma route, no formato {param}
+var pathParamPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+//route define uma rota HTTP da API independente do roteador que a registra
+type route struct {
+	method     string
+	path       string
+	handler    http.HandlerFunc
+	middleware []negroni.Handler
+}
+
+//routes retorna a tabela de rotas HTTP da API, registrada da mesma forma pelos servidores gorilla/mux e gin
+func (h Handlers) routes(log logger.Logger) []route {
+	var public = []negroni.Handler{
+		negroni.HandlerFunc(middleware.NewLogger(log).Execute),
+		negroni.NewRecovery(),
+	}
+
+	var common = append(
+		public[:len(public):len(public)],
+		negroni.HandlerFunc(middleware.NewAuthentication(h.Verifier, log).Execute),
+	)
+
+	//scoped exige, além da autenticação, o escopo informado
+	var scoped = func(scope domain.Scope) []negroni.Handler {
+		return append(common[:len(common):len(common)], negroni.HandlerFunc(middleware.NewScope(scope, log).Execute))
+	}
+
+	return []route{
+		{method: http.MethodPost, path: "/v1/transfers", handler: h.Transfer.Store, middleware: scoped(domain.ScopeTransfersWrite)},
+		{method: http.MethodGet, path: "/v1/transfers", handler: h.Transfer.FindAll, middleware: scoped(domain.ScopeTransfersRead)},
+
+		{method: http.MethodGet, path: "/v1/accounts/{account_id}/balance", handler: h.Account.FindBalance, middleware: scoped(domain.ScopeAccountsRead)},
+		{method: http.MethodGet, path: "/v1/accounts/{account_id}/events", handler: h.AccountEvent.Stream, middleware: scoped(domain.ScopeAccountsRead)},
+		{method: http.MethodGet, path: "/v1/accounts/{account_id}/statement", handler: h.Statement.Find, middleware: scoped(domain.ScopeAccountsRead)},
+		{method: http.MethodGet, path: "/v1/accounts/{account_id}/statement.csv", handler: h.Statement.ExportCSV, middleware: scoped(domain.ScopeAccountsRead)},
+		{method: http.MethodGet, path: "/v1/accounts/{account_id}/statement.ofx", handler: h.Statement.ExportOFX, middleware: scoped(domain.ScopeAccountsRead)},
+		{method: http.MethodGet, path: "/v1/accounts/{account_id}", handler: h.Account.FindByID, middleware: scoped(domain.ScopeAccountsRead)},
+		{method: http.MethodPost, path: "/v1/accounts", handler: h.Account.Store, middleware: scoped(domain.ScopeAccountsWrite)},
+		{method: http.MethodGet, path: "/v1/accounts", handler: h.Account.FindAll, middleware: scoped(domain.ScopeAccountsRead)},
+
+		{method: http.MethodPost, path: "/v1/webhooks/{webhook_id}/deliveries/{delivery_id}/replay", handler: h.Webhook.Replay, middleware: scoped(domain.ScopeWebhooksWrite)},
+		{method: http.MethodGet, path: "/v1/webhooks/{webhook_id}/deliveries", handler: h.Webhook.FindDeliveries, middleware: scoped(domain.ScopeWebhooksRead)},
+		{method: http.MethodGet, path: "/v1/webhooks/{webhook_id}", handler: h.Webhook.FindByID, middleware: scoped(domain.ScopeWebhooksRead)},
+		{method: http.MethodPut, path: "/v1/webhooks/{webhook_id}", handler: h.Webhook.Update, middleware: scoped(domain.ScopeWebhooksWrite)},
+		{method: http.MethodDelete, path: "/v1/webhooks/{webhook_id}", handler: h.Webhook.Delete, middleware: scoped(domain.ScopeWebhooksWrite)},
+		{method: http.MethodPost, path: "/v1/webhooks", handler: h.Webhook.Store, middleware: scoped(domain.ScopeWebhooksWrite)},
+		{method: http.MethodGet, path: "/v1/webhooks", handler: h.Webhook.FindAll, middleware: scoped(domain.ScopeWebhooksRead)},
+
+		{method: http.MethodPost, path: "/v1/api-keys/{api_key_id}/rotate", handler: h.APIKey.Rotate, middleware: scoped(domain.ScopeAdmin)},
+		{method: http.MethodDelete, path: "/v1/api-keys/{api_key_id}", handler: h.APIKey.Revoke, middleware: scoped(domain.ScopeAdmin)},
+		{method: http.MethodPost, path: "/v1/api-keys", handler: h.APIKey.Store, middleware: scoped(domain.ScopeAdmin)},
+		{method: http.MethodGet, path: "/v1/api-keys", handler: h.APIKey.FindAll, middleware: scoped(domain.ScopeAdmin)},
+
+		{method: http.MethodDelete, path: "/v1/oauth/clients/{client_id}", handler: h.OAuthClient.Delete, middleware: scoped(domain.ScopeAdmin)},
+		{method: http.MethodPost, path: "/v1/oauth/clients", handler: h.OAuthClient.Store, middleware: scoped(domain.ScopeAdmin)},
+		{method: http.MethodGet, path: "/v1/oauth/clients", handler: h.OAuthClient.FindAll, middleware: scoped(domain.ScopeAdmin)},
+
+		//o endpoint de token e o JWKS seguem os caminhos padrão do OAuth 2.0, fora da versão da API
+		{method: http.MethodPost, path: "/oauth/token", handler: h.OAuth.Token, middleware: public},
+		{method: http.MethodGet, path: "/.well-known/jwks.json", handler: h.OAuth.JWKS, middleware: public},
+
+		//os escopos das consultas GraphQL são exigidos pelos resolvers de cada campo
+		{method: http.MethodPost, path: "/v1/graphql", handler: h.GraphQL.Execute, middleware: common},
+
+		{method: http.MethodGet, path: "/v1/openapi.json", handler: action.OpenAPI},
+		{method: http.MethodGet, path: "/v1/healthcheck", handler: action.HealthCheck},
+	}
+}
+
+//handle encadeia os middlewares da route ao seu handler
+func (r route) handle() http.Handler {
+	var n = negroni.New(r.middleware...)
+	n.UseHandlerFunc(r.handler)
+
+	return n
+}
+
+//addPathParams repassa os parâmetros do path lidos pelo roteador como query string, formato esperado pelos
+//handlers da api/action
+func addPathParams(r *http.Request, params map[string]string) {
+	if len(params) == 0 {
+		return
+	}
+
+	var q = r.URL.Query()
+	for key, value := range params {
+		q.Add(key, value)
+	}
+	r.URL.RawQuery = q.Encode()
+}
@@ -0,0 +1,310 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/api/action"
+	"github.com/gsabadini/go-bank-transfer/api/auth"
+	"github.com/gsabadini/go-bank-transfer/api/presenter"
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
+	"github.com/gsabadini/go-bank-transfer/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/mux"
+)
+
+type stubAccountRepo struct {
+	domain.AccountRepository
+}
+
+func (s stubAccountRepo) FindByID(_ context.Context, ID domain.AccountID) (domain.Account, error) {
+	if ID != "3c096a40-ccba-4b58-93ed-57379ab04680" {
+		return domain.Account{}, domain.ErrNotFound
+	}
+
+	return domain.NewAccount(ID, "Test", "07091054954", domain.TaxIDTypeCPF, 100, 100, time.Now()), nil
+}
+
+type stubWebhookSubscriptionRepo struct {
+	domain.WebhookSubscriptionRepository
+}
+
+func (s stubWebhookSubscriptionRepo) FindByID(
+	_ context.Context,
+	ID domain.WebhookSubscriptionID,
+) (domain.WebhookSubscription, error) {
+	return domain.NewWebhookSubscription(
+		ID,
+		"https://example.com/hook",
+		"whsec_test",
+		[]domain.EventType{domain.EventTransferCompleted},
+		"3c096a40-ccba-4b58-93ed-57379ab04680",
+		time.Now(),
+	), nil
+}
+
+type stubWebhookDeliveryRepo struct {
+	domain.WebhookDeliveryRepository
+	deliveryID *domain.WebhookDeliveryID
+}
+
+func (s stubWebhookDeliveryRepo) FindByID(_ context.Context, ID domain.WebhookDeliveryID) (domain.WebhookDelivery, error) {
+	*s.deliveryID = ID
+	return domain.WebhookDelivery{}, domain.ErrNotFound
+}
+
+type stubVerifier struct{}
+
+func (stubVerifier) Verify(_ context.Context, token string) (auth.Principal, error) {
+	switch token {
+	case "valid":
+		return auth.NewPrincipal("backoffice", []domain.Scope{domain.ScopeAdmin}), nil
+	case "holder":
+		return auth.NewPrincipal("3c096a40-ccba-4b58-93ed-57379ab04681", auth.AccountHolderScopes()), nil
+	case "reader":
+		return auth.NewPrincipal("batch", []domain.Scope{domain.ScopeAccountsRead}), nil
+	}
+
+	return auth.Principal{}, auth.ErrUnauthenticated
+}
+
+//TestRoutes executa as mesmas requests nos roteadores gorilla/mux e gin, que registram a mesma tabela de rotas
+func TestRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	v, err := validator.NewValidatorFactory(validator.InstanceGoPlayground)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var deliveryID domain.WebhookDeliveryID
+
+	var (
+		log = logger.LoggerMock{}
+
+		accountUseCase = usecase.NewAccount(stubAccountRepo{}, presenter.NewAccountPresenter(), nil, nil, time.Second)
+		webhookUseCase = usecase.NewWebhook(
+			stubWebhookSubscriptionRepo{},
+			stubWebhookDeliveryRepo{deliveryID: &deliveryID},
+			presenter.NewWebhookPresenter(),
+			time.Second,
+		)
+
+		h = Handlers{
+			Account: action.NewAccount(accountUseCase, log, v),
+			Webhook: action.NewWebhook(webhookUseCase, log, v),
+			OAuth:   action.NewOAuth(nil, auth.TokenIssuer{}, nil, log),
+
+			Verifier: stubVerifier{},
+		}
+	)
+
+	gorillaRouter := mux.NewRouter()
+	newGorillaMux(log, h, 0).setAppHandlers(gorillaRouter)
+
+	ginRouter := gin.New()
+	newGinServer(log, h, 0).setAppHandlers(ginRouter)
+
+	adapters := map[string]http.Handler{
+		"gorilla/mux": gorillaRouter,
+		"gin":         ginRouter,
+	}
+
+	tests := []struct {
+		name               string
+		method             string
+		target             string
+		body               string
+		authorization      string
+		expectedStatusCode int
+		expectedBody       string
+		expectedDeliveryID domain.WebhookDeliveryID
+	}{
+		{
+			name:               "Health check",
+			method:             http.MethodGet,
+			target:             "/v1/healthcheck",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "OpenAPI specification",
+			method:             http.MethodGet,
+			target:             "/v1/openapi.json",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"openapi"`,
+		},
+		{
+			name:               "Path parameter passed to the action",
+			method:             http.MethodGet,
+			target:             "/v1/accounts/3c096a40-ccba-4b58-93ed-57379ab04680",
+			authorization:      "Bearer valid",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"id":"3c096a40-ccba-4b58-93ed-57379ab04680"`,
+		},
+		{
+			name:               "Resource not found",
+			method:             http.MethodGet,
+			target:             "/v1/accounts/3c096a40-ccba-4b58-93ed-57379ab04681",
+			authorization:      "Bearer valid",
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Invalid path parameter",
+			method:             http.MethodGet,
+			target:             "/v1/accounts/123",
+			authorization:      "Bearer valid",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Multiple path parameters",
+			method:             http.MethodPost,
+			target:             "/v1/webhooks/3c096a40-ccba-4b58-93ed-57379ab04680/deliveries/3c096a40-ccba-4b58-93ed-57379ab04681/replay",
+			authorization:      "Bearer valid",
+			expectedStatusCode: http.StatusNotFound,
+			expectedDeliveryID: "3c096a40-ccba-4b58-93ed-57379ab04681",
+		},
+		{
+			name:               "Invalid JSON",
+			method:             http.MethodPost,
+			target:             "/v1/accounts",
+			body:               `{"name":`,
+			authorization:      "Bearer valid",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Missing bearer token",
+			method:             http.MethodGet,
+			target:             "/v1/accounts/3c096a40-ccba-4b58-93ed-57379ab04680",
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedBody:       `"code":"unauthorized"`,
+		},
+		{
+			name:               "Invalid bearer token",
+			method:             http.MethodPost,
+			target:             "/v1/transfers",
+			body:               `{}`,
+			authorization:      "Bearer invalid",
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedBody:       `"code":"unauthorized"`,
+		},
+		{
+			name:               "Scope not granted",
+			method:             http.MethodPost,
+			target:             "/v1/accounts",
+			body:               `{}`,
+			authorization:      "Bearer reader",
+			expectedStatusCode: http.StatusForbidden,
+			expectedBody:       `"code":"insufficient_scope"`,
+		},
+		{
+			name:               "Admin route without the admin scope",
+			method:             http.MethodGet,
+			target:             "/v1/api-keys",
+			authorization:      "Bearer reader",
+			expectedStatusCode: http.StatusForbidden,
+			expectedBody:       `"code":"insufficient_scope"`,
+		},
+		{
+			name:               "Account holder scopes on a webhook of another account",
+			method:             http.MethodGet,
+			target:             "/v1/webhooks/3c096a40-ccba-4b58-93ed-57379ab04682",
+			authorization:      "Bearer holder",
+			expectedStatusCode: http.StatusForbidden,
+			expectedBody:       `"code":"forbidden"`,
+		},
+		{
+			name:               "Account holder scopes creating a webhook for every account",
+			method:             http.MethodPost,
+			target:             "/v1/webhooks",
+			body:               `{"url":"https://example.com/hook","event_types":["transfer.completed"]}`,
+			authorization:      "Bearer holder",
+			expectedStatusCode: http.StatusForbidden,
+			expectedBody:       `"code":"forbidden"`,
+		},
+		{
+			name:               "Token endpoint outside the api version without bearer token",
+			method:             http.MethodPost,
+			target:             "/oauth/token",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"error":"invalid_request"`,
+		},
+		{
+			name:               "OAuth client registration without the admin scope",
+			method:             http.MethodPost,
+			target:             "/v1/oauth/clients",
+			body:               `{}`,
+			authorization:      "Bearer reader",
+			expectedStatusCode: http.StatusForbidden,
+			expectedBody:       `"code":"insufficient_scope"`,
+		},
+		{
+			name:               "Route not registered",
+			method:             http.MethodGet,
+			target:             "/v1/unknown",
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for name, adapter := range adapters {
+		for _, tt := range tests {
+			deliveryID = ""
+
+			var (
+				req = httptest.NewRequest(tt.method, tt.target, bytes.NewBufferString(tt.body))
+				rr  = httptest.NewRecorder()
+			)
+
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			adapter.ServeHTTP(rr, req)
+
+			if rr.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s - %s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					name,
+					tt.name,
+					rr.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if !strings.Contains(rr.Body.String(), tt.expectedBody) {
+				t.Errorf("[TestCase '%s - %s'] Result: '%v' | Expected: '%v'", name, tt.name, rr.Body.String(), tt.expectedBody)
+			}
+
+			if deliveryID != tt.expectedDeliveryID {
+				t.Errorf("[TestCase '%s - %s'] Result: '%v' | Expected: '%v'", name, tt.name, deliveryID, tt.expectedDeliveryID)
+			}
+		}
+	}
+}
+
+func TestGinPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{path: "/v1/accounts", expected: "/v1/accounts"},
+		{path: "/v1/accounts/{account_id}/statement.csv", expected: "/v1/accounts/:account_id/statement.csv"},
+		{
+			path:     "/v1/webhooks/{webhook_id}/deliveries/{delivery_id}/replay",
+			expected: "/v1/webhooks/:webhook_id/deliveries/:delivery_id/replay",
+		},
+	}
+
+	for _, tt := range tests {
+		if result := ginPath(tt.path); result != tt.expected {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.path, result, tt.expected)
+		}
+	}
+}
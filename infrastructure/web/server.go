@@ -2,11 +2,8 @@ package web
 
 import (
 	"errors"
-	"time"
 
 	"github.com/gsabadini/go-bank-transfer/infrastructure/logger"
-	"github.com/gsabadini/go-bank-transfer/infrastructure/validator"
-	"github.com/gsabadini/go-bank-transfer/repository"
 )
 
 //Server é uma abstração para o server da aplicação
@@ -24,23 +21,23 @@ var (
 const (
 	InstanceGorillaMux int = iota
 	InstanceGin
+	InstanceGRPC
 )
 
 //NewWebServerFactory retorna a instância de um web server
 func NewWebServerFactory(
 	instance int,
 	log logger.Logger,
-	dbSQL repository.SQLHandler,
-	dbNoSQL repository.NoSQLHandler,
-	validator validator.Validator,
+	handlers Handlers,
 	port Port,
-	ctxTimeout time.Duration,
 ) (Server, error) {
 	switch instance {
 	case InstanceGorillaMux:
-		return newGorillaMux(log, dbSQL, validator, port, ctxTimeout), nil
+		return newGorillaMux(log, handlers, port), nil
 	case InstanceGin:
-		return newGinServer(log, dbNoSQL, validator, port, ctxTimeout), nil
+		return newGinServer(log, handlers, port), nil
+	case InstanceGRPC:
+		return newGRPCServer(log, handlers, port), nil
 	default:
 		return nil, errInvalidWebServerInstance
 	}
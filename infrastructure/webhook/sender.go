@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+const (
+	//HeaderID identifica a entrega, estável entre as tentativas, permitindo ao destino descartar duplicidades
+	HeaderID = "X-Webhook-ID"
+
+	//HeaderEvent informa o tipo do evento entregue
+	HeaderEvent = "X-Webhook-Event"
+
+	//HeaderTimestamp informa o instante do envio em segundos desde a época Unix
+	HeaderTimestamp = "X-Webhook-Timestamp"
+
+	//HeaderSignature carrega a assinatura HMAC-SHA256 do timestamp e do corpo, no formato sha256=<hex>
+	HeaderSignature = "X-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+var (
+	errInsecureURL    = errors.New("webhook url must use https")
+	errBlockedAddress = errors.New("webhook url resolves to a non-public address")
+)
+
+//envelope armazena a estrutura do corpo enviado ao destino
+type envelope struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+//HTTPSender armazena a estrutura de um WebhookSender que envia as entregas por HTTP POST
+type HTTPSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+//NewHTTPSender constrói um HTTPSender cujas requisições expiram após timeout
+//
+//A conexão é recusada quando o host resolve para um endereço não público, verificado no momento da conexão para
+//que a resolução DNS não permita alcançar a rede interna, e redirecionamentos não são seguidos
+func NewHTTPSender(timeout time.Duration) HTTPSender {
+	var dialer = &net.Dialer{Timeout: timeout, Control: controlPublicAddress}
+
+	return HTTPSender{
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+			},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+//controlPublicAddress recusa conexões com endereços já resolvidos que não sejam públicos
+func controlPublicAddress(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if !domain.IsPublicIP(net.ParseIP(host)) {
+		return errBlockedAddress
+	}
+
+	return nil
+}
+
+//Send envia a entrega assinada com a chave da WebhookSubscription, qualquer resposta fora de 2xx é uma falha
+func (h HTTPSender) Send(
+	ctx context.Context,
+	subscription domain.WebhookSubscription,
+	delivery domain.WebhookDelivery,
+) error {
+	target, err := url.Parse(subscription.URL())
+	if err != nil {
+		return err
+	}
+
+	if target.Scheme != "https" {
+		return errInsecureURL
+	}
+
+	body, err := json.Marshal(envelope{
+		ID:        delivery.ID().String(),
+		Type:      delivery.EventType().String(),
+		CreatedAt: delivery.CreatedAt(),
+		Data:      json.RawMessage(delivery.Payload()),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, subscription.URL(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	var timestamp = strconv.FormatInt(h.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, delivery.ID().String())
+	req.Header.Set(HeaderEvent, delivery.EventType().String())
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret(), timestamp, body))
+
+	res, err := h.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+//Sign calcula a assinatura HMAC-SHA256 de "<timestamp>.<body>" com a chave informada
+//
+//O destino deve recalcular a assinatura e compará-la com hmac.Equal, rejeitando timestamps antigos para evitar
+//a reutilização de uma entrega
+func Sign(secret string, timestamp string, body []byte) string {
+	var mac = hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
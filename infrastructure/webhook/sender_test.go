@@ -0,0 +1,209 @@
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+func TestHTTPSender_Send(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now      = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		delivery = domain.NewWebhookDelivery(
+			"b51cd6c7-a55c-491e-9140-91903fe66fa9",
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			"0c83e1d2-cc5a-4b48-8e0e-2ec2e2a3a8b4",
+			domain.EventTransferCompleted,
+			[]byte(`{"transfer_id":"1","amount":100}`),
+			domain.WebhookDeliveryStatusPending,
+			0,
+			now,
+			"",
+			now,
+			now,
+		)
+	)
+
+	tests := []struct {
+		name          string
+		status        int
+		expectedError interface{}
+	}{
+		{
+			name:   "Success when the receiver accepts the delivery",
+			status: http.StatusNoContent,
+		},
+		{
+			name:          "Error when the receiver rejects the delivery",
+			status:        http.StatusInternalServerError,
+			expectedError: "unexpected status 500",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received *http.Request
+			var body []byte
+
+			receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r
+				body, _ = ioutil.ReadAll(r.Body)
+				w.WriteHeader(tt.status)
+			}))
+			defer receiver.Close()
+
+			var (
+				subscription = domain.NewWebhookSubscription(
+					"3c096a40-ccba-4b58-93ed-57379ab04680",
+					receiver.URL,
+					"whsec_test",
+					[]domain.EventType{domain.EventTransferCompleted},
+					"",
+					now,
+				)
+				sender = NewHTTPSender(time.Second)
+			)
+			sender.client = receiver.Client()
+			sender.now = func() time.Time { return now }
+
+			err := sender.Send(context.Background(), subscription, delivery)
+			if (err != nil) && (err.Error() != tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if err == nil && tt.expectedError != nil {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if received == nil {
+				t.Fatalf("[TestCase '%s'] delivery not received", tt.name)
+			}
+
+			if received.Header.Get(HeaderTimestamp) != "1591012800" {
+				t.Errorf("[TestCase '%s'] Timestamp: '%v'", tt.name, received.Header.Get(HeaderTimestamp))
+			}
+
+			if expected := Sign("whsec_test", "1591012800", body); received.Header.Get(HeaderSignature) != expected {
+				t.Errorf(
+					"[TestCase '%s'] Signature: '%v' | Expected: '%v'",
+					tt.name,
+					received.Header.Get(HeaderSignature),
+					expected,
+				)
+			}
+
+			if received.Header.Get(HeaderID) != delivery.ID().String() ||
+				received.Header.Get(HeaderEvent) != "transfer.completed" {
+				t.Errorf("[TestCase '%s'] Headers: '%v'", tt.name, received.Header)
+			}
+
+			var payload envelope
+			if err := json.Unmarshal(body, &payload); err != nil {
+				t.Fatalf("[TestCase '%s'] invalid body: '%v'", tt.name, err)
+			}
+
+			if payload.Type != "transfer.completed" || string(payload.Data) != `{"transfer_id":"1","amount":100}` {
+				t.Errorf("[TestCase '%s'] Body: '%s'", tt.name, body)
+			}
+		})
+	}
+}
+
+func TestHTTPSender_SendBlocked(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now      = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		delivery = domain.NewWebhookDelivery(
+			"b51cd6c7-a55c-491e-9140-91903fe66fa9",
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			"0c83e1d2-cc5a-4b48-8e0e-2ec2e2a3a8b4",
+			domain.EventTransferCompleted,
+			[]byte(`{"transfer_id":"1","amount":100}`),
+			domain.WebhookDeliveryStatusPending,
+			0,
+			now,
+			"",
+			now,
+			now,
+		)
+	)
+
+	var received bool
+	receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	tests := []struct {
+		name          string
+		url           string
+		expectedError error
+	}{
+		{
+			name:          "Error when the url does not use https",
+			url:           strings.Replace(receiver.URL, "https://", "http://", 1),
+			expectedError: errInsecureURL,
+		},
+		{
+			name:          "Error when the host resolves to a loopback address",
+			url:           receiver.URL,
+			expectedError: errBlockedAddress,
+		},
+		{
+			name:          "Error when the host name resolves to a loopback address",
+			url:           strings.Replace(receiver.URL, "127.0.0.1", "localhost", 1),
+			expectedError: errBlockedAddress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var subscription = domain.NewWebhookSubscription(
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				tt.url,
+				"whsec_test",
+				[]domain.EventType{domain.EventTransferCompleted},
+				"",
+				now,
+			)
+
+			err := NewHTTPSender(time.Second).Send(context.Background(), subscription, delivery)
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+		})
+	}
+
+	if received {
+		t.Errorf("[TestCase 'SendBlocked'] delivery must not reach the receiver")
+	}
+}
+
+func TestSign(t *testing.T) {
+	var signature = Sign("whsec_test", "1591012800", []byte(`{"id":"1"}`))
+
+	if len(signature) != len(signaturePrefix)+sha256.Size*2 || !strings.HasPrefix(signature, signaturePrefix) {
+		t.Errorf("[TestCase 'Sign'] Result: '%v'", signature)
+	}
+
+	if signature == Sign("another", "1591012800", []byte(`{"id":"1"}`)) {
+		t.Errorf("[TestCase 'Sign'] signatures with different secrets must differ")
+	}
+
+	if signature == Sign("whsec_test", "1591012801", []byte(`{"id":"1"}`)) {
+		t.Errorf("[TestCase 'Sign'] signatures with different timestamps must differ")
+	}
+}
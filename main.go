@@ -17,10 +17,18 @@ func main() {
 		ContextTimeout(10 * time.Second).
 		Logger(logger.InstanceLogrusLogger).
 		Validator(validator.InstanceGoPlayground).
+		EventPublisher().
 		DbSQL(database.InstancePostgres).
 		DbNoSQL(database.InstanceMongoDB)
 
+	if len(os.Args) > 1 {
+		app.Command(os.Args[1], os.Args[2:])
+		return
+	}
+
 	app.WebServerPort(os.Getenv("APP_PORT")).
+		Repositories(infrastructure.RepositoriesPostgres).
+		Authentication().
 		WebServer(web.InstanceGorillaMux).
 		Start()
 }
@@ -8,13 +8,17 @@ type NoSQLHandler interface {
 	Update(context.Context, string, interface{}, interface{}) error
 	FindAll(context.Context, string, interface{}, interface{}) error
 	FindOne(context.Context, string, interface{}, interface{}, interface{}) error
+	FindPage(context.Context, string, interface{}, []string, int, interface{}) error
+	Aggregate(context.Context, string, interface{}, interface{}) error
+	Delete(context.Context, string, interface{}) (int64, error)
+	WithTransaction(context.Context, func(context.Context) error) error
 }
 
 //SQLHandler expõe os métodos disponíveis para as abstrações de banco SQL
 type SQLHandler interface {
 	ExecuteContext(context.Context, string, ...interface{}) error
 	QueryContext(context.Context, string, ...interface{}) (Row, error)
-	//BeginTx(ctx context.Context) (Tx, error)
+	WithTransaction(context.Context, func(context.Context) error) error
 }
 
 //Row expõe os métodos disponíveis para as abstrações de linhas de banco SQL
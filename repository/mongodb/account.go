@@ -14,11 +14,34 @@ import (
 
 //accountBSON armazena a estrutura de dados do MongoDB
 type accountBSON struct {
-	ID        string    `bson:"id"`
-	Name      string    `bson:"name"`
-	CPF       string    `bson:"cpf"`
-	Balance   int64     `bson:"balance"`
-	CreatedAt time.Time `bson:"created_at"`
+	ID             string    `bson:"id"`
+	Name           string    `bson:"name"`
+	TaxID          string    `bson:"tax_id"`
+	TaxIDType      string    `bson:"tax_id_type"`
+	Balance        int64     `bson:"balance"`
+	InitialBalance int64     `bson:"initial_balance"`
+	CreatedAt      time.Time `bson:"created_at"`
+
+	//CPF é o documento das Account gravadas antes do tax_id, até a execução de scripts/mongodb/migrations
+	CPF string `bson:"cpf,omitempty"`
+}
+
+//toDomain converte o documento em uma Account, lendo o cpf das Account gravadas antes do tax_id
+func (a accountBSON) toDomain() domain.Account {
+	var taxID, taxIDType = a.TaxID, domain.TaxIDType(a.TaxIDType)
+	if taxID == "" && a.CPF != "" {
+		taxID, taxIDType = a.CPF, domain.TaxIDTypeCPF
+	}
+
+	return domain.NewAccount(
+		domain.AccountID(a.ID),
+		a.Name,
+		taxID,
+		taxIDType,
+		domain.Money(a.Balance),
+		domain.Money(a.InitialBalance),
+		a.CreatedAt,
+	)
 }
 
 //AccountRepository armazena a estrutura de dados de um repositório de Account
@@ -35,14 +58,20 @@ func NewAccountRepository(h repository.NoSQLHandler) AccountRepository {
 //Store insere uma Account no database
 func (a AccountRepository) Store(ctx context.Context, account domain.Account) (domain.Account, error) {
 	var accountBSON = accountBSON{
-		ID:        account.ID().String(),
-		Name:      account.Name(),
-		CPF:       account.CPF(),
-		Balance:   account.Balance().Int64(),
-		CreatedAt: account.CreatedAt(),
+		ID:             account.ID().String(),
+		Name:           account.Name(),
+		TaxID:          account.TaxID(),
+		TaxIDType:      account.TaxIDType().String(),
+		Balance:        account.Balance().Int64(),
+		InitialBalance: account.InitialBalance().Int64(),
+		CreatedAt:      account.CreatedAt(),
 	}
 
 	if err := a.handler.Store(ctx, a.collectionName, accountBSON); err != nil {
+		if isDuplicateKeyError(err) {
+			return domain.Account{}, errors.Wrap(domain.ErrAccountAlreadyExists, "error creating account")
+		}
+
 		return domain.Account{}, errors.Wrap(err, "error creating account")
 	}
 
@@ -68,11 +97,18 @@ func (a AccountRepository) UpdateBalance(ctx context.Context, ID domain.AccountI
 	return nil
 }
 
-//FindAll busca todas as Account no database
-func (a AccountRepository) FindAll(ctx context.Context) ([]domain.Account, error) {
+//FindAll busca uma página de Account no database ordenada por created_at e id
+func (a AccountRepository) FindAll(ctx context.Context, pagination domain.Pagination) ([]domain.Account, error) {
 	var accountsBSON = make([]accountBSON, 0)
 
-	if err := a.handler.FindAll(ctx, a.collectionName, bson.M{}, &accountsBSON); err != nil {
+	if err := a.handler.FindPage(
+		ctx,
+		a.collectionName,
+		paginationQuery(bson.M{}, "created_at", false, pagination.After.CreatedAt(), pagination),
+		paginationSort,
+		pagination.Limit,
+		&accountsBSON,
+	); err != nil {
 		switch err {
 		case mongo.ErrNilDocument:
 			return []domain.Account{}, errors.Wrap(domain.ErrNotFound, "error listing accounts")
@@ -84,15 +120,7 @@ func (a AccountRepository) FindAll(ctx context.Context) ([]domain.Account, error
 	var accounts = make([]domain.Account, 0)
 
 	for _, accountBSON := range accountsBSON {
-		var account = domain.NewAccount(
-			domain.AccountID(accountBSON.ID),
-			accountBSON.Name,
-			accountBSON.CPF,
-			domain.Money(accountBSON.Balance),
-			accountBSON.CreatedAt,
-		)
-
-		accounts = append(accounts, account)
+		accounts = append(accounts, accountBSON.toDomain())
 	}
 
 	return accounts, nil
@@ -114,13 +142,26 @@ func (a AccountRepository) FindByID(ctx context.Context, ID domain.AccountID) (d
 		}
 	}
 
-	return domain.NewAccount(
-		domain.AccountID(accountBSON.ID),
-		accountBSON.Name,
-		accountBSON.CPF,
-		domain.Money(accountBSON.Balance),
-		accountBSON.CreatedAt,
-	), nil
+	return accountBSON.toDomain(), nil
+}
+
+//FindByIDs busca as Account dos ids informados no database, ids inexistentes são ignorados
+func (a AccountRepository) FindByIDs(ctx context.Context, IDs []domain.AccountID) ([]domain.Account, error) {
+	var (
+		accountsBSON = make([]accountBSON, 0, len(IDs))
+		query        = bson.M{"id": bson.M{"$in": IDs}}
+	)
+
+	if err := a.handler.FindAll(ctx, a.collectionName, query, &accountsBSON); err != nil {
+		return []domain.Account{}, errors.Wrap(err, "error fetching accounts")
+	}
+
+	var accounts = make([]domain.Account, 0, len(accountsBSON))
+	for _, accountBSON := range accountsBSON {
+		accounts = append(accounts, accountBSON.toDomain())
+	}
+
+	return accounts, nil
 }
 
 //FindBalance busca o Balance de uma Account no database
@@ -0,0 +1,181 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+//apiKeyBSON armazena a estrutura de dados do MongoDB
+type apiKeyBSON struct {
+	ID         string     `bson:"id"`
+	Name       string     `bson:"name"`
+	Prefix     string     `bson:"prefix"`
+	Hash       string     `bson:"hash"`
+	Scopes     []string   `bson:"scopes"`
+	AccountID  string     `bson:"account_id"`
+	ExpiresAt  *time.Time `bson:"expires_at"`
+	LastUsedAt *time.Time `bson:"last_used_at"`
+	RevokedAt  *time.Time `bson:"revoked_at"`
+	CreatedAt  time.Time  `bson:"created_at"`
+}
+
+//APIKeyRepository armazena a estrutura de dados de um repositório de APIKey
+type APIKeyRepository struct {
+	collectionName string
+	handler        repository.NoSQLHandler
+}
+
+//NewAPIKeyRepository constrói um repository com suas dependências
+func NewAPIKeyRepository(h repository.NoSQLHandler) APIKeyRepository {
+	return APIKeyRepository{handler: h, collectionName: "api_keys"}
+}
+
+//Store insere uma APIKey no database
+func (a APIKeyRepository) Store(ctx context.Context, key domain.APIKey) (domain.APIKey, error) {
+	var scopes = make([]string, 0, len(key.Scopes()))
+	for _, scope := range key.Scopes() {
+		scopes = append(scopes, scope.String())
+	}
+
+	keyBSON := &apiKeyBSON{
+		ID:         key.ID().String(),
+		Name:       key.Name(),
+		Prefix:     key.Prefix(),
+		Hash:       key.Hash(),
+		Scopes:     scopes,
+		AccountID:  key.AccountID().String(),
+		ExpiresAt:  optionalTime(key.ExpiresAt()),
+		LastUsedAt: optionalTime(key.LastUsedAt()),
+		RevokedAt:  optionalTime(key.RevokedAt()),
+		CreatedAt:  key.CreatedAt(),
+	}
+
+	if err := a.handler.Store(ctx, a.collectionName, keyBSON); err != nil {
+		return domain.APIKey{}, errors.Wrap(err, "error creating api key")
+	}
+
+	return key, nil
+}
+
+//Update altera a expiração e a revogação de uma APIKey no database
+func (a APIKeyRepository) Update(ctx context.Context, key domain.APIKey) error {
+	var (
+		query  = bson.M{"id": key.ID().String()}
+		update = bson.M{"$set": bson.M{
+			"expires_at": optionalTime(key.ExpiresAt()),
+			"revoked_at": optionalTime(key.RevokedAt()),
+		}}
+	)
+
+	if err := a.handler.Update(ctx, a.collectionName, query, update); err != nil {
+		return errors.Wrap(err, "error updating api key")
+	}
+
+	return nil
+}
+
+//UpdateLastUsedAt registra no database o último uso de uma APIKey
+func (a APIKeyRepository) UpdateLastUsedAt(ctx context.Context, ID domain.APIKeyID, lastUsedAt time.Time) error {
+	var (
+		query  = bson.M{"id": ID.String()}
+		update = bson.M{"$set": bson.M{"last_used_at": lastUsedAt}}
+	)
+
+	if err := a.handler.Update(ctx, a.collectionName, query, update); err != nil {
+		return errors.Wrap(err, "error updating api key last use")
+	}
+
+	return nil
+}
+
+//FindAll busca todas as APIKey no database
+func (a APIKeyRepository) FindAll(ctx context.Context) ([]domain.APIKey, error) {
+	var keysBSON = make([]apiKeyBSON, 0)
+
+	if err := a.handler.FindPage(
+		ctx,
+		a.collectionName,
+		bson.M{},
+		[]string{"created_at", "id"},
+		0,
+		&keysBSON,
+	); err != nil {
+		return []domain.APIKey{}, errors.Wrap(err, "error listing api keys")
+	}
+
+	var keys = make([]domain.APIKey, 0, len(keysBSON))
+	for _, keyBSON := range keysBSON {
+		keys = append(keys, keyBSON.toDomain())
+	}
+
+	return keys, nil
+}
+
+//FindByID busca uma APIKey por ID no database
+func (a APIKeyRepository) FindByID(ctx context.Context, ID domain.APIKeyID) (domain.APIKey, error) {
+	return a.findOne(ctx, bson.M{"id": ID.String()})
+}
+
+//FindByHash busca uma APIKey pelo hash da chave no database
+func (a APIKeyRepository) FindByHash(ctx context.Context, hash string) (domain.APIKey, error) {
+	return a.findOne(ctx, bson.M{"hash": hash})
+}
+
+func (a APIKeyRepository) findOne(ctx context.Context, query bson.M) (domain.APIKey, error) {
+	var keyBSON = &apiKeyBSON{}
+
+	if err := a.handler.FindOne(ctx, a.collectionName, query, nil, keyBSON); err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return domain.APIKey{}, errors.Wrap(domain.ErrNotFound, "error fetching api key")
+		default:
+			return domain.APIKey{}, errors.Wrap(err, "error fetching api key")
+		}
+	}
+
+	return keyBSON.toDomain(), nil
+}
+
+func (a apiKeyBSON) toDomain() domain.APIKey {
+	var scopes = make([]domain.Scope, 0, len(a.Scopes))
+	for _, scope := range a.Scopes {
+		scopes = append(scopes, domain.Scope(scope))
+	}
+
+	return domain.NewAPIKey(
+		domain.APIKeyID(a.ID),
+		a.Name,
+		a.Prefix,
+		a.Hash,
+		scopes,
+		domain.AccountID(a.AccountID),
+		timeValue(a.ExpiresAt),
+		timeValue(a.LastUsedAt),
+		timeValue(a.RevokedAt),
+		a.CreatedAt,
+	)
+}
+
+//optionalTime converte as datas zeradas do domínio em null
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return *t
+}
@@ -0,0 +1,135 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+//balanceCorrectionBSON armazena a estrutura de dados do MongoDB
+type balanceCorrectionBSON struct {
+	ID        string    `bson:"id"`
+	AccountID string    `bson:"account_id"`
+	Amount    int64     `bson:"amount"`
+	Status    string    `bson:"status"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+//BalanceCorrectionRepository armazena a estrutura de dados de um repositório de BalanceCorrection
+type BalanceCorrectionRepository struct {
+	collectionName string
+	handler        repository.NoSQLHandler
+}
+
+//NewBalanceCorrectionRepository constrói um repository com suas dependências
+func NewBalanceCorrectionRepository(h repository.NoSQLHandler) BalanceCorrectionRepository {
+	return BalanceCorrectionRepository{handler: h, collectionName: "balance_corrections"}
+}
+
+//Store insere uma BalanceCorrection no database, permitindo uma única pendente por Account
+func (b BalanceCorrectionRepository) Store(
+	ctx context.Context,
+	correction domain.BalanceCorrection,
+) (domain.BalanceCorrection, error) {
+	correctionBSON := &balanceCorrectionBSON{
+		ID:        correction.ID().String(),
+		AccountID: correction.AccountID().String(),
+		Amount:    correction.Amount().Int64(),
+		Status:    correction.Status().String(),
+		CreatedAt: correction.CreatedAt(),
+	}
+
+	if err := b.handler.Store(ctx, b.collectionName, correctionBSON); err != nil {
+		if isDuplicateKeyError(err) {
+			return domain.BalanceCorrection{}, errors.Wrap(domain.ErrBalanceCorrectionAlreadyExists, "error creating balance correction")
+		}
+
+		return domain.BalanceCorrection{}, errors.Wrap(err, "error creating balance correction")
+	}
+
+	return correction, nil
+}
+
+//UpdateStatus decide uma BalanceCorrection pendente no database
+//
+//O filtro pelo status pendente impede alterar uma BalanceCorrection já decidida. Dentro de uma transação, a leitura
+//prévia e esta escrita conflitam com uma decisão concorrente, que é abortada
+func (b BalanceCorrectionRepository) UpdateStatus(
+	ctx context.Context,
+	ID domain.BalanceCorrectionID,
+	status domain.BalanceCorrectionStatus,
+) error {
+	var (
+		query  = bson.M{"id": ID.String(), "status": domain.BalanceCorrectionStatusPending.String()}
+		update = bson.M{"$set": bson.M{"status": status.String()}}
+	)
+
+	if err := b.handler.Update(ctx, b.collectionName, query, update); err != nil {
+		return errors.Wrap(err, "error updating balance correction status")
+	}
+
+	return nil
+}
+
+//FindByID busca uma BalanceCorrection no database
+func (b BalanceCorrectionRepository) FindByID(
+	ctx context.Context,
+	ID domain.BalanceCorrectionID,
+) (domain.BalanceCorrection, error) {
+	var (
+		correctionBSON = &balanceCorrectionBSON{}
+		query          = bson.M{"id": ID.String()}
+	)
+
+	if err := b.handler.FindOne(ctx, b.collectionName, query, nil, correctionBSON); err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return domain.BalanceCorrection{}, errors.Wrap(domain.ErrNotFound, "error fetching balance correction")
+		default:
+			return domain.BalanceCorrection{}, errors.Wrap(err, "error fetching balance correction")
+		}
+	}
+
+	return correctionBSON.toDomain(), nil
+}
+
+//FindPendingByAccountID busca a BalanceCorrection pendente de uma Account no database
+func (b BalanceCorrectionRepository) FindPendingByAccountID(
+	ctx context.Context,
+	accountID domain.AccountID,
+) (domain.BalanceCorrection, error) {
+	var (
+		correctionBSON = &balanceCorrectionBSON{}
+		query          = bson.M{
+			"account_id": accountID.String(),
+			"status":     domain.BalanceCorrectionStatusPending.String(),
+		}
+	)
+
+	if err := b.handler.FindOne(ctx, b.collectionName, query, nil, correctionBSON); err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return domain.BalanceCorrection{}, errors.Wrap(domain.ErrNotFound, "error fetching pending balance correction")
+		default:
+			return domain.BalanceCorrection{}, errors.Wrap(err, "error fetching pending balance correction")
+		}
+	}
+
+	return correctionBSON.toDomain(), nil
+}
+
+func (b balanceCorrectionBSON) toDomain() domain.BalanceCorrection {
+	return domain.NewBalanceCorrection(
+		domain.BalanceCorrectionID(b.ID),
+		domain.AccountID(b.AccountID),
+		domain.Money(b.Amount),
+		domain.BalanceCorrectionStatus(b.Status),
+		b.CreatedAt,
+	)
+}
@@ -0,0 +1,84 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+//balanceSnapshotBSON armazena a estrutura de dados do MongoDB
+type balanceSnapshotBSON struct {
+	AccountID string    `bson:"account_id"`
+	Date      time.Time `bson:"date"`
+	Balance   int64     `bson:"balance"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+//BalanceSnapshotRepository armazena a estrutura de dados de um repositório de BalanceSnapshot
+type BalanceSnapshotRepository struct {
+	collectionName string
+	handler        repository.NoSQLHandler
+}
+
+//NewBalanceSnapshotRepository constrói um repository com suas dependências
+func NewBalanceSnapshotRepository(h repository.NoSQLHandler) BalanceSnapshotRepository {
+	return BalanceSnapshotRepository{handler: h, collectionName: "balance_snapshots"}
+}
+
+//Store insere um BalanceSnapshot no database
+func (b BalanceSnapshotRepository) Store(
+	ctx context.Context,
+	snapshot domain.BalanceSnapshot,
+) (domain.BalanceSnapshot, error) {
+	snapshotBSON := &balanceSnapshotBSON{
+		AccountID: snapshot.AccountID().String(),
+		Date:      snapshot.Date(),
+		Balance:   snapshot.Balance().Int64(),
+		CreatedAt: snapshot.CreatedAt(),
+	}
+
+	if err := b.handler.Store(ctx, b.collectionName, snapshotBSON); err != nil {
+		if isDuplicateKeyError(err) {
+			return domain.BalanceSnapshot{}, errors.Wrap(domain.ErrBalanceSnapshotAlreadyExists, "error creating balance snapshot")
+		}
+
+		return domain.BalanceSnapshot{}, errors.Wrap(err, "error creating balance snapshot")
+	}
+
+	return snapshot, nil
+}
+
+//FindLatest busca no database o BalanceSnapshot mais recente de uma Account cujo dia terminou até at
+func (b BalanceSnapshotRepository) FindLatest(
+	ctx context.Context,
+	ID domain.AccountID,
+	at time.Time,
+) (domain.BalanceSnapshot, error) {
+	var (
+		snapshotsBSON = make([]balanceSnapshotBSON, 0)
+		query         = bson.M{
+			"account_id": ID.String(),
+			"date":       bson.M{"$lte": domain.TruncateDay(at.Add(-24 * time.Hour))},
+		}
+	)
+
+	if err := b.handler.FindPage(ctx, b.collectionName, query, []string{"-date"}, 1, &snapshotsBSON); err != nil {
+		return domain.BalanceSnapshot{}, errors.Wrap(err, "error fetching balance snapshot")
+	}
+
+	if len(snapshotsBSON) == 0 {
+		return domain.BalanceSnapshot{}, errors.Wrap(domain.ErrNotFound, "error fetching balance snapshot")
+	}
+
+	return domain.NewBalanceSnapshot(
+		domain.AccountID(snapshotsBSON[0].AccountID),
+		snapshotsBSON[0].Date,
+		domain.Money(snapshotsBSON[0].Balance),
+		snapshotsBSON[0].CreatedAt,
+	), nil
+}
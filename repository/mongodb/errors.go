@@ -0,0 +1,37 @@
+package mongodb
+
+import (
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+//duplicateKeyCodes são os códigos retornados pelo MongoDB quando um índice único é violado
+var duplicateKeyCodes = map[int32]bool{11000: true, 11001: true, 12582: true}
+
+//isDuplicateKeyError verifica se o erro foi causado por uma violação de índice único
+func isDuplicateKeyError(err error) bool {
+	var writeException mongo.WriteException
+	if errors.As(err, &writeException) {
+		for _, writeErr := range writeException.WriteErrors {
+			if duplicateKeyCodes[int32(writeErr.Code)] {
+				return true
+			}
+		}
+	}
+
+	var bulkWriteException mongo.BulkWriteException
+	if errors.As(err, &bulkWriteException) {
+		for _, writeErr := range bulkWriteException.WriteErrors {
+			if duplicateKeyCodes[int32(writeErr.Code)] {
+				return true
+			}
+		}
+	}
+
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) {
+		return duplicateKeyCodes[commandErr.Code]
+	}
+
+	return false
+}
@@ -0,0 +1,121 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+//oauthClientBSON armazena a estrutura de dados do MongoDB
+type oauthClientBSON struct {
+	ID         string    `bson:"id"`
+	Name       string    `bson:"name"`
+	SecretHash string    `bson:"secret_hash"`
+	Scopes     []string  `bson:"scopes"`
+	AccountID  string    `bson:"account_id"`
+	CreatedAt  time.Time `bson:"created_at"`
+}
+
+//OAuthClientRepository armazena a estrutura de dados de um repositório de OAuthClient
+type OAuthClientRepository struct {
+	collectionName string
+	handler        repository.NoSQLHandler
+}
+
+//NewOAuthClientRepository constrói um repository com suas dependências
+func NewOAuthClientRepository(h repository.NoSQLHandler) OAuthClientRepository {
+	return OAuthClientRepository{handler: h, collectionName: "oauth_clients"}
+}
+
+//Store insere um OAuthClient no database
+func (o OAuthClientRepository) Store(ctx context.Context, client domain.OAuthClient) (domain.OAuthClient, error) {
+	var scopes = make([]string, 0, len(client.Scopes()))
+	for _, scope := range client.Scopes() {
+		scopes = append(scopes, scope.String())
+	}
+
+	clientBSON := &oauthClientBSON{
+		ID:         client.ID().String(),
+		Name:       client.Name(),
+		SecretHash: client.SecretHash(),
+		Scopes:     scopes,
+		AccountID:  client.AccountID().String(),
+		CreatedAt:  client.CreatedAt(),
+	}
+
+	if err := o.handler.Store(ctx, o.collectionName, clientBSON); err != nil {
+		return domain.OAuthClient{}, errors.Wrap(err, "error creating oauth client")
+	}
+
+	return client, nil
+}
+
+//Delete remove um OAuthClient do database
+func (o OAuthClientRepository) Delete(ctx context.Context, ID domain.OAuthClientID) error {
+	if _, err := o.handler.Delete(ctx, o.collectionName, bson.M{"id": ID.String()}); err != nil {
+		return errors.Wrap(err, "error deleting oauth client")
+	}
+
+	return nil
+}
+
+//FindAll busca todos os OAuthClient no database
+func (o OAuthClientRepository) FindAll(ctx context.Context) ([]domain.OAuthClient, error) {
+	var clientsBSON = make([]oauthClientBSON, 0)
+
+	if err := o.handler.FindPage(
+		ctx,
+		o.collectionName,
+		bson.M{},
+		[]string{"created_at", "id"},
+		0,
+		&clientsBSON,
+	); err != nil {
+		return []domain.OAuthClient{}, errors.Wrap(err, "error listing oauth clients")
+	}
+
+	var clients = make([]domain.OAuthClient, 0, len(clientsBSON))
+	for _, clientBSON := range clientsBSON {
+		clients = append(clients, clientBSON.toDomain())
+	}
+
+	return clients, nil
+}
+
+//FindByID busca um OAuthClient por ID no database
+func (o OAuthClientRepository) FindByID(ctx context.Context, ID domain.OAuthClientID) (domain.OAuthClient, error) {
+	var clientBSON = &oauthClientBSON{}
+
+	if err := o.handler.FindOne(ctx, o.collectionName, bson.M{"id": ID.String()}, nil, clientBSON); err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return domain.OAuthClient{}, errors.Wrap(domain.ErrNotFound, "error fetching oauth client")
+		default:
+			return domain.OAuthClient{}, errors.Wrap(err, "error fetching oauth client")
+		}
+	}
+
+	return clientBSON.toDomain(), nil
+}
+
+func (o oauthClientBSON) toDomain() domain.OAuthClient {
+	var scopes = make([]domain.Scope, 0, len(o.Scopes))
+	for _, scope := range o.Scopes {
+		scopes = append(scopes, domain.Scope(scope))
+	}
+
+	return domain.NewOAuthClient(
+		domain.OAuthClientID(o.ID),
+		o.Name,
+		o.SecretHash,
+		scopes,
+		domain.AccountID(o.AccountID),
+		o.CreatedAt,
+	)
+}
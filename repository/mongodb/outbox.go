@@ -0,0 +1,184 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+//outboxMessageBSON armazena a estrutura de dados do MongoDB
+type outboxMessageBSON struct {
+	ID         string     `bson:"id"`
+	EventType  string     `bson:"event_type"`
+	AccountIDs []string   `bson:"account_ids"`
+	Payload    string     `bson:"payload"`
+	Position   int        `bson:"position"`
+	Attempts   int        `bson:"attempts"`
+	LastError  string     `bson:"last_error"`
+	CreatedAt  time.Time  `bson:"created_at"`
+	SentAt     *time.Time `bson:"sent_at"`
+	FailedAt   *time.Time `bson:"failed_at"`
+}
+
+//OutboxRepository armazena a estrutura de dados de um repositório de OutboxMessage
+type OutboxRepository struct {
+	collectionName string
+	handler        repository.NoSQLHandler
+}
+
+//NewOutboxRepository constrói um repository com suas dependências
+func NewOutboxRepository(h repository.NoSQLHandler) OutboxRepository {
+	return OutboxRepository{handler: h, collectionName: "outbox"}
+}
+
+//Store insere as OutboxMessage no database, participando da transação carregada pelo contexto
+func (o OutboxRepository) Store(ctx context.Context, messages ...domain.OutboxMessage) error {
+	for _, message := range messages {
+		var accountIDs = make([]string, 0, len(message.AccountIDs()))
+		for _, ID := range message.AccountIDs() {
+			accountIDs = append(accountIDs, ID.String())
+		}
+
+		messageBSON := &outboxMessageBSON{
+			ID:         message.ID().String(),
+			EventType:  message.EventType().String(),
+			AccountIDs: accountIDs,
+			Payload:    string(message.Payload()),
+			Position:   message.Position(),
+			CreatedAt:  message.CreatedAt(),
+		}
+
+		if err := o.handler.Store(ctx, o.collectionName, messageBSON); err != nil {
+			return errors.Wrap(err, "error creating outbox message")
+		}
+	}
+
+	return nil
+}
+
+//FindPending busca no database as OutboxMessage ainda não publicadas nem marcadas como falha, na ordem em que foram
+//gravadas
+func (o OutboxRepository) FindPending(ctx context.Context, limit int) ([]domain.OutboxMessage, error) {
+	var query = bson.M{"sent_at": nil, "failed_at": nil}
+
+	messages, err := o.find(ctx, query, []string{"created_at", "position"}, limit)
+	if err != nil {
+		return messages, errors.Wrap(err, "error listing pending outbox messages")
+	}
+
+	return messages, nil
+}
+
+//FindSentSince busca no database as OutboxMessage publicadas a partir de since, na ordem de publicação
+func (o OutboxRepository) FindSentSince(ctx context.Context, since time.Time, limit int) ([]domain.OutboxMessage, error) {
+	var query = bson.M{"sent_at": bson.M{"$gte": since}}
+
+	messages, err := o.find(ctx, query, []string{"sent_at", "created_at", "position"}, limit)
+	if err != nil {
+		return messages, errors.Wrap(err, "error listing sent outbox messages")
+	}
+
+	return messages, nil
+}
+
+func (o OutboxRepository) find(
+	ctx context.Context,
+	query bson.M,
+	sort []string,
+	limit int,
+) ([]domain.OutboxMessage, error) {
+	var messagesBSON = make([]outboxMessageBSON, 0)
+
+	if err := o.handler.FindPage(ctx, o.collectionName, query, sort, limit, &messagesBSON); err != nil {
+		return []domain.OutboxMessage{}, err
+	}
+
+	var messages = make([]domain.OutboxMessage, 0, len(messagesBSON))
+	for _, messageBSON := range messagesBSON {
+		var IDs = make([]domain.AccountID, 0, len(messageBSON.AccountIDs))
+		for _, accountID := range messageBSON.AccountIDs {
+			IDs = append(IDs, domain.AccountID(accountID))
+		}
+
+		var sentAt time.Time
+		if messageBSON.SentAt != nil {
+			sentAt = *messageBSON.SentAt
+		}
+
+		messages = append(messages, domain.NewOutboxMessage(
+			domain.OutboxMessageID(messageBSON.ID),
+			domain.EventType(messageBSON.EventType),
+			IDs,
+			[]byte(messageBSON.Payload),
+			messageBSON.Position,
+			messageBSON.CreatedAt,
+			sentAt,
+		).WithAttempts(messageBSON.Attempts))
+	}
+
+	return messages, nil
+}
+
+//MarkSent registra no database a publicação de uma OutboxMessage
+func (o OutboxRepository) MarkSent(ctx context.Context, ID domain.OutboxMessageID, sentAt time.Time) error {
+	var (
+		query  = bson.M{"id": ID.String()}
+		update = bson.M{"$set": bson.M{"sent_at": sentAt}}
+	)
+
+	if err := o.handler.Update(ctx, o.collectionName, query, update); err != nil {
+		return errors.Wrap(err, "error marking outbox message as sent")
+	}
+
+	return nil
+}
+
+//RecordFailure registra no database uma publicação da OutboxMessage que falhou, mantendo-a pendente
+func (o OutboxRepository) RecordFailure(ctx context.Context, ID domain.OutboxMessageID, reason string) error {
+	var (
+		query  = bson.M{"id": ID.String()}
+		update = bson.M{"$inc": bson.M{"attempts": 1}, "$set": bson.M{"last_error": reason}}
+	)
+
+	if err := o.handler.Update(ctx, o.collectionName, query, update); err != nil {
+		return errors.Wrap(err, "error recording outbox message failure")
+	}
+
+	return nil
+}
+
+//MarkFailed registra no database a última publicação da OutboxMessage que falhou, retirando-a das pendentes
+func (o OutboxRepository) MarkFailed(
+	ctx context.Context,
+	ID domain.OutboxMessageID,
+	reason string,
+	failedAt time.Time,
+) error {
+	var (
+		query  = bson.M{"id": ID.String()}
+		update = bson.M{"$inc": bson.M{"attempts": 1}, "$set": bson.M{"last_error": reason, "failed_at": failedAt}}
+	)
+
+	if err := o.handler.Update(ctx, o.collectionName, query, update); err != nil {
+		return errors.Wrap(err, "error marking outbox message as failed")
+	}
+
+	return nil
+}
+
+//DeleteSentBefore remove do database as OutboxMessage publicadas antes de before e retorna a quantidade removida
+func (o OutboxRepository) DeleteSentBefore(ctx context.Context, before time.Time) (int64, error) {
+	var query = bson.M{"sent_at": bson.M{"$lt": before}}
+
+	deleted, err := o.handler.Delete(ctx, o.collectionName, query)
+	if err != nil {
+		return 0, errors.Wrap(err, "error deleting sent outbox messages")
+	}
+
+	return deleted, nil
+}
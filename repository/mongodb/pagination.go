@@ -0,0 +1,44 @@
+package mongodb
+
+import (
+	"github.com/gsabadini/go-bank-transfer/domain"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+//paginationSort é a ordenação estável utilizada nas listagens paginadas por data de criação
+var paginationSort = []string{"created_at", "id"}
+
+//paginationQuery combina o filtro com a condição dos itens posteriores ao cursor na ordenação pelo campo e id
+func paginationQuery(
+	filter bson.M,
+	field string,
+	descending bool,
+	after interface{},
+	pagination domain.Pagination,
+) bson.M {
+	if pagination.After.IsZero() {
+		return filter
+	}
+
+	var operator = "$gt"
+	if descending {
+		operator = "$lt"
+	}
+
+	var cursor = bson.M{
+		"$or": bson.A{
+			bson.M{field: bson.M{operator: after}},
+			bson.M{
+				field: after,
+				"id":  bson.M{operator: pagination.After.ID()},
+			},
+		},
+	}
+
+	if len(filter) == 0 {
+		return cursor
+	}
+
+	return bson.M{"$and": bson.A{filter, cursor}}
+}
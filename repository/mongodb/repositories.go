@@ -0,0 +1,19 @@
+package mongodb
+
+import "github.com/gsabadini/go-bank-transfer/repository"
+
+//NewRepositories constrói os repositórios do MongoDB que compartilham a mesma conexão
+func NewRepositories(h repository.NoSQLHandler) repository.Repositories {
+	return repository.Repositories{
+		Account:             NewAccountRepository(h),
+		Transfer:            NewTransferRepository(h),
+		BalanceSnapshot:     NewBalanceSnapshotRepository(h),
+		Outbox:              NewOutboxRepository(h),
+		WebhookSubscription: NewWebhookSubscriptionRepository(h),
+		WebhookDelivery:     NewWebhookDeliveryRepository(h),
+		APIKey:              NewAPIKeyRepository(h),
+		OAuthClient:         NewOAuthClientRepository(h),
+		SigningKey:          NewSigningKeyRepository(h),
+		Transactor:          h,
+	}
+}
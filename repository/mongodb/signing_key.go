@@ -0,0 +1,74 @@
+package mongodb
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+//signingKeyBSON armazena a estrutura de dados do MongoDB, com a chave privada no formato PKCS #1
+type signingKeyBSON struct {
+	ID         string    `bson:"id"`
+	PrivateKey []byte    `bson:"private_key"`
+	CreatedAt  time.Time `bson:"created_at"`
+}
+
+//SigningKeyRepository armazena a estrutura de dados de um repositório de SigningKey
+type SigningKeyRepository struct {
+	collectionName string
+	handler        repository.NoSQLHandler
+}
+
+//NewSigningKeyRepository constrói um repository com suas dependências
+func NewSigningKeyRepository(h repository.NoSQLHandler) SigningKeyRepository {
+	return SigningKeyRepository{handler: h, collectionName: "signing_keys"}
+}
+
+//Store insere uma SigningKey no database
+func (s SigningKeyRepository) Store(ctx context.Context, key domain.SigningKey) (domain.SigningKey, error) {
+	keyBSON := &signingKeyBSON{
+		ID:         key.ID().String(),
+		PrivateKey: x509.MarshalPKCS1PrivateKey(key.PrivateKey()),
+		CreatedAt:  key.CreatedAt(),
+	}
+
+	if err := s.handler.Store(ctx, s.collectionName, keyBSON); err != nil {
+		return domain.SigningKey{}, errors.Wrap(err, "error creating signing key")
+	}
+
+	return key, nil
+}
+
+//FindCreatedAfter busca no database as SigningKey criadas após since, da mais recente para a mais antiga
+func (s SigningKeyRepository) FindCreatedAfter(ctx context.Context, since time.Time) ([]domain.SigningKey, error) {
+	var keysBSON = make([]signingKeyBSON, 0)
+
+	if err := s.handler.FindPage(
+		ctx,
+		s.collectionName,
+		bson.M{"created_at": bson.M{"$gt": since}},
+		[]string{"-created_at", "id"},
+		0,
+		&keysBSON,
+	); err != nil {
+		return []domain.SigningKey{}, errors.Wrap(err, "error listing signing keys")
+	}
+
+	var keys = make([]domain.SigningKey, 0, len(keysBSON))
+	for _, keyBSON := range keysBSON {
+		privateKey, err := x509.ParsePKCS1PrivateKey(keyBSON.PrivateKey)
+		if err != nil {
+			return []domain.SigningKey{}, errors.Wrap(err, "error decoding signing key")
+		}
+
+		keys = append(keys, domain.NewSigningKey(domain.SigningKeyID(keyBSON.ID), privateKey, keyBSON.CreatedAt))
+	}
+
+	return keys, nil
+}
@@ -17,9 +17,20 @@ type transferBSON struct {
 	AccountOriginID      string    `bson:"account_origin_id"`
 	AccountDestinationID string    `bson:"account_destination_id"`
 	Amount               int64     `bson:"amount"`
+	Status               string    `bson:"status"`
 	CreatedAt            time.Time `bson:"created_at"`
 }
 
+//status retorna o TransferStatus do documento, as transferências gravadas antes do campo status existir foram
+//concluídas
+func (t transferBSON) status() domain.TransferStatus {
+	if t.Status == "" {
+		return domain.TransferStatusCompleted
+	}
+
+	return domain.TransferStatus(t.Status)
+}
+
 //TransferRepository armazena a estrutura de dados de um repositório de Transfer
 type TransferRepository struct {
 	collectionName string
@@ -38,6 +49,7 @@ func (t TransferRepository) Store(ctx context.Context, transfer domain.Transfer)
 		AccountOriginID:      transfer.AccountOriginID().String(),
 		AccountDestinationID: transfer.AccountDestinationID().String(),
 		Amount:               transfer.Amount().Int64(),
+		Status:               transfer.Status().String(),
 		CreatedAt:            transfer.CreatedAt(),
 	}
 
@@ -48,11 +60,26 @@ func (t TransferRepository) Store(ctx context.Context, transfer domain.Transfer)
 	return transfer, nil
 }
 
-//FindAll busca todas as Transfer no database
-func (t TransferRepository) FindAll(ctx context.Context) ([]domain.Transfer, error) {
-	var transfersBSON = make([]transferBSON, 0)
+//FindAll busca uma página de Transfer no database que atendem aos critérios da TransferQuery
+func (t TransferRepository) FindAll(ctx context.Context, query domain.TransferQuery) ([]domain.Transfer, error) {
+	var (
+		transfersBSON = make([]transferBSON, 0)
+		field, after  = transferSortField(query)
+		sort          = []string{field, "id"}
+	)
 
-	if err := t.handler.FindAll(ctx, t.collectionName, bson.M{}, &transfersBSON); err != nil {
+	if query.Sort.Descending() {
+		sort = []string{"-" + field, "-id"}
+	}
+
+	if err := t.handler.FindPage(
+		ctx,
+		t.collectionName,
+		paginationQuery(transferFilter(query.Filter), field, query.Sort.Descending(), after, query.Pagination),
+		sort,
+		query.Pagination.Limit,
+		&transfersBSON,
+	); err != nil {
 		return []domain.Transfer{}, errors.Wrap(err, "error listing transfers")
 	}
 
@@ -64,6 +91,50 @@ func (t TransferRepository) FindAll(ctx context.Context) ([]domain.Transfer, err
 			domain.AccountID(transferBSON.AccountOriginID),
 			domain.AccountID(transferBSON.AccountDestinationID),
 			domain.Money(transferBSON.Amount),
+			transferBSON.status(),
+			transferBSON.CreatedAt,
+		)
+
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, nil
+}
+
+//FindByAccountID busca as Transfer enviadas ou recebidas por uma Account no período [from, to)
+func (t TransferRepository) FindByAccountID(
+	ctx context.Context,
+	ID domain.AccountID,
+	from time.Time,
+	to time.Time,
+) ([]domain.Transfer, error) {
+	var (
+		transfersBSON = make([]transferBSON, 0)
+		pipeline      = bson.A{
+			bson.M{"$match": bson.M{
+				"$or": bson.A{
+					bson.M{"account_origin_id": ID.String()},
+					bson.M{"account_destination_id": ID.String()},
+				},
+				"created_at": bson.M{"$gte": from, "$lt": to},
+			}},
+			bson.M{"$sort": bson.M{"created_at": 1}},
+		}
+	)
+
+	if err := t.handler.Aggregate(ctx, t.collectionName, pipeline, &transfersBSON); err != nil {
+		return []domain.Transfer{}, errors.Wrap(err, "error listing account transfers")
+	}
+
+	var transfers = make([]domain.Transfer, 0)
+
+	for _, transferBSON := range transfersBSON {
+		var transfer = domain.NewTransfer(
+			domain.TransferID(transferBSON.ID),
+			domain.AccountID(transferBSON.AccountOriginID),
+			domain.AccountID(transferBSON.AccountDestinationID),
+			domain.Money(transferBSON.Amount),
+			transferBSON.status(),
 			transferBSON.CreatedAt,
 		)
 
@@ -72,3 +143,108 @@ func (t TransferRepository) FindAll(ctx context.Context) ([]domain.Transfer, err
 
 	return transfers, nil
 }
+
+//NetAmountByAccountID soma as Transfer recebidas menos as enviadas por uma Account no período [from, to)
+func (t TransferRepository) NetAmountByAccountID(
+	ctx context.Context,
+	ID domain.AccountID,
+	from time.Time,
+	to time.Time,
+) (domain.Money, error) {
+	var (
+		result = make([]struct {
+			Amount int64 `bson:"amount"`
+		}, 0)
+		pipeline = bson.A{
+			bson.M{"$match": bson.M{
+				"$or": bson.A{
+					bson.M{"account_origin_id": ID.String()},
+					bson.M{"account_destination_id": ID.String()},
+				},
+				"created_at": bson.M{"$gte": from, "$lt": to},
+			}},
+			bson.M{"$group": bson.M{
+				"_id": nil,
+				"amount": bson.M{"$sum": bson.M{"$cond": bson.A{
+					bson.M{"$eq": bson.A{"$account_destination_id", ID.String()}},
+					"$amount",
+					bson.M{"$multiply": bson.A{"$amount", -1}},
+				}}},
+			}},
+		}
+	)
+
+	if err := t.handler.Aggregate(ctx, t.collectionName, pipeline, &result); err != nil {
+		return 0, errors.Wrap(err, "error summing account transfers")
+	}
+
+	if len(result) == 0 {
+		return 0, nil
+	}
+
+	return domain.Money(result[0].Amount), nil
+}
+
+//transferFilter traduz o TransferFilter para um filtro BSON
+func transferFilter(filter domain.TransferFilter) bson.M {
+	var query = bson.M{}
+
+	if filter.AccountID != "" {
+		query["$or"] = bson.A{
+			bson.M{"account_origin_id": filter.AccountID.String()},
+			bson.M{"account_destination_id": filter.AccountID.String()},
+		}
+	}
+
+	if filter.AccountOriginID != "" {
+		query["account_origin_id"] = filter.AccountOriginID.String()
+	}
+
+	if filter.AccountDestinationID != "" {
+		query["account_destination_id"] = filter.AccountDestinationID.String()
+	}
+
+	var amount = bson.M{}
+	if filter.MinAmount > 0 {
+		amount["$gte"] = filter.MinAmount.Int64()
+	}
+
+	if filter.MaxAmount > 0 {
+		amount["$lte"] = filter.MaxAmount.Int64()
+	}
+
+	if len(amount) > 0 {
+		query["amount"] = amount
+	}
+
+	var createdAt = bson.M{}
+	if !filter.CreatedFrom.IsZero() {
+		createdAt["$gte"] = filter.CreatedFrom
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		createdAt["$lt"] = filter.CreatedTo
+	}
+
+	if len(createdAt) > 0 {
+		query["created_at"] = createdAt
+	}
+
+	if filter.Status == domain.TransferStatusCompleted {
+		//Documentos sem o campo status são concluídos, assim como em transferBSON.status
+		query["status"] = bson.M{"$in": bson.A{filter.Status.String(), nil}}
+	} else if filter.Status != "" {
+		query["status"] = filter.Status.String()
+	}
+
+	return query
+}
+
+//transferSortField retorna o campo da ordenação permitida e o valor do cursor correspondente
+func transferSortField(query domain.TransferQuery) (string, interface{}) {
+	if query.Sort.Field() == domain.TransferSortAmount.Field() {
+		return "amount", query.Pagination.After.Amount().Int64()
+	}
+
+	return "created_at", query.Pagination.After.CreatedAt()
+}
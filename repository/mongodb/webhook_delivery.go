@@ -0,0 +1,187 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+//webhookDeliveryBSON armazena a estrutura de dados do MongoDB
+type webhookDeliveryBSON struct {
+	ID             string    `bson:"id"`
+	SubscriptionID string    `bson:"subscription_id"`
+	MessageID      string    `bson:"message_id"`
+	EventType      string    `bson:"event_type"`
+	Payload        string    `bson:"payload"`
+	Status         string    `bson:"status"`
+	Attempts       int       `bson:"attempts"`
+	NextAttemptAt  time.Time `bson:"next_attempt_at"`
+	LastError      string    `bson:"last_error"`
+	CreatedAt      time.Time `bson:"created_at"`
+	UpdatedAt      time.Time `bson:"updated_at"`
+}
+
+//WebhookDeliveryRepository armazena a estrutura de dados de um repositório de WebhookDelivery
+type WebhookDeliveryRepository struct {
+	collectionName string
+	handler        repository.NoSQLHandler
+}
+
+//NewWebhookDeliveryRepository constrói um repository com suas dependências
+func NewWebhookDeliveryRepository(h repository.NoSQLHandler) WebhookDeliveryRepository {
+	return WebhookDeliveryRepository{handler: h, collectionName: "webhook_deliveries"}
+}
+
+//Store insere uma WebhookDelivery no database, permitindo uma única entrega por WebhookSubscription e OutboxMessage
+func (w WebhookDeliveryRepository) Store(
+	ctx context.Context,
+	delivery domain.WebhookDelivery,
+) (domain.WebhookDelivery, error) {
+	deliveryBSON := &webhookDeliveryBSON{
+		ID:             delivery.ID().String(),
+		SubscriptionID: delivery.SubscriptionID().String(),
+		MessageID:      delivery.MessageID().String(),
+		EventType:      delivery.EventType().String(),
+		Payload:        string(delivery.Payload()),
+		Status:         delivery.Status().String(),
+		Attempts:       delivery.Attempts(),
+		NextAttemptAt:  delivery.NextAttemptAt(),
+		LastError:      delivery.LastError(),
+		CreatedAt:      delivery.CreatedAt(),
+		UpdatedAt:      delivery.UpdatedAt(),
+	}
+
+	if err := w.handler.Store(ctx, w.collectionName, deliveryBSON); err != nil {
+		if isDuplicateKeyError(err) {
+			return domain.WebhookDelivery{}, errors.Wrap(domain.ErrWebhookDeliveryAlreadyExists, "error creating webhook delivery")
+		}
+
+		return domain.WebhookDelivery{}, errors.Wrap(err, "error creating webhook delivery")
+	}
+
+	return delivery, nil
+}
+
+//Update altera a situação e as tentativas de uma WebhookDelivery no database
+func (w WebhookDeliveryRepository) Update(ctx context.Context, delivery domain.WebhookDelivery) error {
+	var (
+		query  = bson.M{"id": delivery.ID().String()}
+		update = bson.M{"$set": bson.M{
+			"status":          delivery.Status().String(),
+			"attempts":        delivery.Attempts(),
+			"next_attempt_at": delivery.NextAttemptAt(),
+			"last_error":      delivery.LastError(),
+			"updated_at":      delivery.UpdatedAt(),
+		}}
+	)
+
+	if err := w.handler.Update(ctx, w.collectionName, query, update); err != nil {
+		return errors.Wrap(err, "error updating webhook delivery")
+	}
+
+	return nil
+}
+
+//FindByID busca uma WebhookDelivery por ID no database
+func (w WebhookDeliveryRepository) FindByID(
+	ctx context.Context,
+	ID domain.WebhookDeliveryID,
+) (domain.WebhookDelivery, error) {
+	var (
+		deliveryBSON = &webhookDeliveryBSON{}
+		query        = bson.M{"id": ID.String()}
+	)
+
+	if err := w.handler.FindOne(ctx, w.collectionName, query, nil, deliveryBSON); err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return domain.WebhookDelivery{}, errors.Wrap(domain.ErrNotFound, "error fetching webhook delivery")
+		default:
+			return domain.WebhookDelivery{}, errors.Wrap(err, "error fetching webhook delivery")
+		}
+	}
+
+	return deliveryBSON.toDomain(), nil
+}
+
+//FindBySubscriptionID busca no database as WebhookDelivery mais recentes de uma WebhookSubscription, filtrando
+//pela situação quando informada
+func (w WebhookDeliveryRepository) FindBySubscriptionID(
+	ctx context.Context,
+	ID domain.WebhookSubscriptionID,
+	status domain.WebhookDeliveryStatus,
+	limit int,
+) ([]domain.WebhookDelivery, error) {
+	var query = bson.M{"subscription_id": ID.String()}
+	if status != "" {
+		query["status"] = status.String()
+	}
+
+	deliveries, err := w.find(ctx, query, []string{"-created_at", "-id"}, limit)
+	if err != nil {
+		return deliveries, errors.Wrap(err, "error listing webhook deliveries")
+	}
+
+	return deliveries, nil
+}
+
+//FindDue busca no database as WebhookDelivery pendentes cuja próxima tentativa ocorre até at
+func (w WebhookDeliveryRepository) FindDue(
+	ctx context.Context,
+	at time.Time,
+	limit int,
+) ([]domain.WebhookDelivery, error) {
+	var query = bson.M{
+		"status":          domain.WebhookDeliveryStatusPending.String(),
+		"next_attempt_at": bson.M{"$lte": at},
+	}
+
+	deliveries, err := w.find(ctx, query, []string{"next_attempt_at", "created_at"}, limit)
+	if err != nil {
+		return deliveries, errors.Wrap(err, "error listing due webhook deliveries")
+	}
+
+	return deliveries, nil
+}
+
+func (w WebhookDeliveryRepository) find(
+	ctx context.Context,
+	query bson.M,
+	sort []string,
+	limit int,
+) ([]domain.WebhookDelivery, error) {
+	var deliveriesBSON = make([]webhookDeliveryBSON, 0)
+
+	if err := w.handler.FindPage(ctx, w.collectionName, query, sort, limit, &deliveriesBSON); err != nil {
+		return []domain.WebhookDelivery{}, err
+	}
+
+	var deliveries = make([]domain.WebhookDelivery, 0, len(deliveriesBSON))
+	for _, deliveryBSON := range deliveriesBSON {
+		deliveries = append(deliveries, deliveryBSON.toDomain())
+	}
+
+	return deliveries, nil
+}
+
+func (w webhookDeliveryBSON) toDomain() domain.WebhookDelivery {
+	return domain.NewWebhookDelivery(
+		domain.WebhookDeliveryID(w.ID),
+		domain.WebhookSubscriptionID(w.SubscriptionID),
+		domain.OutboxMessageID(w.MessageID),
+		domain.EventType(w.EventType),
+		[]byte(w.Payload),
+		domain.WebhookDeliveryStatus(w.Status),
+		w.Attempts,
+		w.NextAttemptAt,
+		w.LastError,
+		w.CreatedAt,
+		w.UpdatedAt,
+	)
+}
@@ -0,0 +1,163 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+//webhookSubscriptionBSON armazena a estrutura de dados do MongoDB
+type webhookSubscriptionBSON struct {
+	ID         string    `bson:"id"`
+	URL        string    `bson:"url"`
+	Secret     string    `bson:"secret"`
+	EventTypes []string  `bson:"event_types"`
+	AccountID  string    `bson:"account_id"`
+	CreatedAt  time.Time `bson:"created_at"`
+}
+
+//WebhookSubscriptionRepository armazena a estrutura de dados de um repositório de WebhookSubscription
+type WebhookSubscriptionRepository struct {
+	collectionName           string
+	deliveriesCollectionName string
+	handler                  repository.NoSQLHandler
+}
+
+//NewWebhookSubscriptionRepository constrói um repository com suas dependências
+func NewWebhookSubscriptionRepository(h repository.NoSQLHandler) WebhookSubscriptionRepository {
+	return WebhookSubscriptionRepository{
+		handler:                  h,
+		collectionName:           "webhook_subscriptions",
+		deliveriesCollectionName: "webhook_deliveries",
+	}
+}
+
+//Store insere uma WebhookSubscription no database
+func (w WebhookSubscriptionRepository) Store(
+	ctx context.Context,
+	subscription domain.WebhookSubscription,
+) (domain.WebhookSubscription, error) {
+	subscriptionBSON := &webhookSubscriptionBSON{
+		ID:         subscription.ID().String(),
+		URL:        subscription.URL(),
+		Secret:     subscription.Secret(),
+		EventTypes: eventTypesToStrings(subscription.EventTypes()),
+		AccountID:  subscription.AccountID().String(),
+		CreatedAt:  subscription.CreatedAt(),
+	}
+
+	if err := w.handler.Store(ctx, w.collectionName, subscriptionBSON); err != nil {
+		return domain.WebhookSubscription{}, errors.Wrap(err, "error creating webhook subscription")
+	}
+
+	return subscription, nil
+}
+
+//Update altera a URL, os tipos de eventos e a Account de uma WebhookSubscription no database
+func (w WebhookSubscriptionRepository) Update(ctx context.Context, subscription domain.WebhookSubscription) error {
+	var (
+		query  = bson.M{"id": subscription.ID().String()}
+		update = bson.M{"$set": bson.M{
+			"url":         subscription.URL(),
+			"event_types": eventTypesToStrings(subscription.EventTypes()),
+			"account_id":  subscription.AccountID().String(),
+		}}
+	)
+
+	if err := w.handler.Update(ctx, w.collectionName, query, update); err != nil {
+		return errors.Wrap(err, "error updating webhook subscription")
+	}
+
+	return nil
+}
+
+//Delete remove uma WebhookSubscription e as suas entregas do database
+func (w WebhookSubscriptionRepository) Delete(ctx context.Context, ID domain.WebhookSubscriptionID) error {
+	return w.handler.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := w.handler.Delete(ctx, w.collectionName, bson.M{"id": ID.String()}); err != nil {
+			return errors.Wrap(err, "error deleting webhook subscription")
+		}
+
+		if _, err := w.handler.Delete(ctx, w.deliveriesCollectionName, bson.M{"subscription_id": ID.String()}); err != nil {
+			return errors.Wrap(err, "error deleting webhook subscription")
+		}
+
+		return nil
+	})
+}
+
+//FindAll busca todas as WebhookSubscription no database
+func (w WebhookSubscriptionRepository) FindAll(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	var subscriptionsBSON = make([]webhookSubscriptionBSON, 0)
+
+	if err := w.handler.FindPage(
+		ctx,
+		w.collectionName,
+		bson.M{},
+		[]string{"created_at", "id"},
+		0,
+		&subscriptionsBSON,
+	); err != nil {
+		return []domain.WebhookSubscription{}, errors.Wrap(err, "error listing webhook subscriptions")
+	}
+
+	var subscriptions = make([]domain.WebhookSubscription, 0, len(subscriptionsBSON))
+	for _, subscriptionBSON := range subscriptionsBSON {
+		subscriptions = append(subscriptions, subscriptionBSON.toDomain())
+	}
+
+	return subscriptions, nil
+}
+
+//FindByID busca uma WebhookSubscription por ID no database
+func (w WebhookSubscriptionRepository) FindByID(
+	ctx context.Context,
+	ID domain.WebhookSubscriptionID,
+) (domain.WebhookSubscription, error) {
+	var (
+		subscriptionBSON = &webhookSubscriptionBSON{}
+		query            = bson.M{"id": ID.String()}
+	)
+
+	if err := w.handler.FindOne(ctx, w.collectionName, query, nil, subscriptionBSON); err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return domain.WebhookSubscription{}, errors.Wrap(domain.ErrNotFound, "error fetching webhook subscription")
+		default:
+			return domain.WebhookSubscription{}, errors.Wrap(err, "error fetching webhook subscription")
+		}
+	}
+
+	return subscriptionBSON.toDomain(), nil
+}
+
+func (w webhookSubscriptionBSON) toDomain() domain.WebhookSubscription {
+	var types = make([]domain.EventType, 0, len(w.EventTypes))
+	for _, eventType := range w.EventTypes {
+		types = append(types, domain.EventType(eventType))
+	}
+
+	return domain.NewWebhookSubscription(
+		domain.WebhookSubscriptionID(w.ID),
+		w.URL,
+		w.Secret,
+		types,
+		domain.AccountID(w.AccountID),
+		w.CreatedAt,
+	)
+}
+
+func eventTypesToStrings(eventTypes []domain.EventType) []string {
+	var types = make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		types = append(types, eventType.String())
+	}
+
+	return types
+}
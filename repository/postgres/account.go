@@ -7,6 +7,7 @@ import (
 	"github.com/gsabadini/go-bank-transfer/domain"
 	"github.com/gsabadini/go-bank-transfer/repository"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
@@ -24,9 +25,9 @@ func NewAccountRepository(h repository.SQLHandler) AccountRepository {
 func (a AccountRepository) Store(ctx context.Context, account domain.Account) (domain.Account, error) {
 	query := `
 		INSERT INTO 
-			accounts (id, name, cpf, balance, created_at)
+			accounts (id, name, tax_id, tax_id_type, balance, initial_balance, created_at)
 		VALUES 
-			($1, $2, $3, $4, $5)
+			($1, $2, $3, $4, $5, $6, $7)
 	`
 
 	if err := a.handler.ExecuteContext(
@@ -34,10 +35,16 @@ func (a AccountRepository) Store(ctx context.Context, account domain.Account) (d
 		query,
 		account.ID(),
 		account.Name(),
-		account.CPF(),
+		account.TaxID(),
+		account.TaxIDType(),
 		account.Balance(),
+		account.InitialBalance(),
 		account.CreatedAt(),
 	); err != nil {
+		if isUniqueViolation(err) {
+			return domain.Account{}, errors.Wrap(domain.ErrAccountAlreadyExists, "error creating account")
+		}
+
 		return domain.Account{}, errors.Wrap(err, "error creating account")
 	}
 
@@ -55,36 +62,47 @@ func (a AccountRepository) UpdateBalance(ctx context.Context, ID domain.AccountI
 	return nil
 }
 
-//FindAlL busca todas as Account no database
-func (a AccountRepository) FindAll(ctx context.Context) ([]domain.Account, error) {
+//FindAll busca uma página de Account no database ordenada por created_at e id
+func (a AccountRepository) FindAll(ctx context.Context, pagination domain.Pagination) ([]domain.Account, error) {
 	var (
-		accounts = make([]domain.Account, 0)
-		query    = "SELECT * FROM accounts"
+		accounts    = make([]domain.Account, 0)
+		query, args = paginate(
+			"SELECT id, name, tax_id, tax_id_type, balance, initial_balance, created_at FROM accounts",
+			conditions{},
+			"created_at",
+			false,
+			pagination.After.CreatedAt(),
+			pagination,
+		)
 	)
 
-	rows, err := a.handler.QueryContext(ctx, query)
+	rows, err := a.handler.QueryContext(ctx, query, args...)
 	if err != nil {
 		return []domain.Account{}, errors.Wrap(err, "error listing accounts")
 	}
 
 	for rows.Next() {
 		var (
-			ID        string
-			name      string
-			CPF       string
-			balance   int64
-			createdAt time.Time
+			ID             string
+			name           string
+			taxID          string
+			taxIDType      string
+			balance        int64
+			initialBalance int64
+			createdAt      time.Time
 		)
 
-		if err = rows.Scan(&ID, &name, &CPF, &balance, &createdAt); err != nil {
+		if err = rows.Scan(&ID, &name, &taxID, &taxIDType, &balance, &initialBalance, &createdAt); err != nil {
 			return []domain.Account{}, errors.Wrap(err, "error listing accounts")
 		}
 
 		accounts = append(accounts, domain.NewAccount(
 			domain.AccountID(ID),
 			name,
-			CPF,
+			taxID,
+			domain.TaxIDType(taxIDType),
 			domain.Money(balance),
+			domain.Money(initialBalance),
 			createdAt,
 		))
 	}
@@ -100,38 +118,96 @@ func (a AccountRepository) FindAll(ctx context.Context) ([]domain.Account, error
 //FindByID busca uma Account por id no database
 func (a AccountRepository) FindByID(ctx context.Context, ID domain.AccountID) (domain.Account, error) {
 	var (
-		query     = "SELECT * FROM accounts WHERE id = $1"
-		id        string
-		name      string
-		CPF       string
-		balance   int64
-		createdAt time.Time
+		query          = "SELECT id, name, tax_id, tax_id_type, balance, initial_balance, created_at FROM accounts WHERE id = $1"
+		id             string
+		name           string
+		taxID          string
+		taxIDType      string
+		balance        int64
+		initialBalance int64
+		createdAt      time.Time
 	)
 
 	row, err := a.handler.QueryContext(ctx, query, ID)
 	if err != nil {
 		return domain.Account{}, errors.Wrap(err, "error fetching account")
 	}
+	defer row.Close()
 
-	row.Next()
-	if err = row.Scan(&id, &name, &CPF, &balance, &createdAt); err != nil {
-		return domain.Account{}, errors.Wrap(err, "error fetching account")
+	if !row.Next() {
+		if err = row.Err(); err != nil {
+			return domain.Account{}, errors.Wrap(err, "error fetching account")
+		}
+
+		return domain.Account{}, errors.Wrap(domain.ErrNotFound, "error fetching account")
 	}
-	defer row.Close()
 
-	if err = row.Err(); err != nil {
-		return domain.Account{}, err
+	if err = row.Scan(&id, &name, &taxID, &taxIDType, &balance, &initialBalance, &createdAt); err != nil {
+		return domain.Account{}, errors.Wrap(err, "error fetching account")
 	}
 
 	return domain.NewAccount(
 		domain.AccountID(id),
 		name,
-		CPF,
+		taxID,
+		domain.TaxIDType(taxIDType),
 		domain.Money(balance),
+		domain.Money(initialBalance),
 		createdAt,
 	), nil
 }
 
+//FindByIDs busca as Account dos ids informados no database, ids inexistentes são ignorados
+func (a AccountRepository) FindByIDs(ctx context.Context, IDs []domain.AccountID) ([]domain.Account, error) {
+	var (
+		accounts = make([]domain.Account, 0, len(IDs))
+		query    = "SELECT id, name, tax_id, tax_id_type, balance, initial_balance, created_at FROM accounts WHERE id = ANY($1)"
+		values   = make([]string, 0, len(IDs))
+	)
+
+	for _, ID := range IDs {
+		values = append(values, ID.String())
+	}
+
+	rows, err := a.handler.QueryContext(ctx, query, pq.Array(values))
+	if err != nil {
+		return []domain.Account{}, errors.Wrap(err, "error fetching accounts")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			ID             string
+			name           string
+			taxID          string
+			taxIDType      string
+			balance        int64
+			initialBalance int64
+			createdAt      time.Time
+		)
+
+		if err = rows.Scan(&ID, &name, &taxID, &taxIDType, &balance, &initialBalance, &createdAt); err != nil {
+			return []domain.Account{}, errors.Wrap(err, "error fetching accounts")
+		}
+
+		accounts = append(accounts, domain.NewAccount(
+			domain.AccountID(ID),
+			name,
+			taxID,
+			domain.TaxIDType(taxIDType),
+			domain.Money(balance),
+			domain.Money(initialBalance),
+			createdAt,
+		))
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.Account{}, errors.Wrap(err, "error fetching accounts")
+	}
+
+	return accounts, nil
+}
+
 //FindBalance busca o Balance de uma Account no database
 func (a AccountRepository) FindBalance(ctx context.Context, ID domain.AccountID) (domain.Account, error) {
 	var (
@@ -143,15 +219,18 @@ func (a AccountRepository) FindBalance(ctx context.Context, ID domain.AccountID)
 	if err != nil {
 		return domain.Account{}, errors.Wrap(err, "error fetching account balance")
 	}
+	defer row.Close()
 
-	row.Next()
-	if err := row.Scan(&balance); err != nil {
-		return domain.Account{}, errors.Wrap(err, "error fetching account balance")
+	if !row.Next() {
+		if err = row.Err(); err != nil {
+			return domain.Account{}, errors.Wrap(err, "error fetching account balance")
+		}
+
+		return domain.Account{}, errors.Wrap(domain.ErrNotFound, "error fetching account balance")
 	}
-	defer row.Close()
 
-	if err = row.Err(); err != nil {
-		return domain.Account{}, err
+	if err = row.Scan(&balance); err != nil {
+		return domain.Account{}, errors.Wrap(err, "error fetching account balance")
 	}
 
 	return domain.NewAccountBalance(domain.Money(balance)), nil
@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const apiKeyColumns = `
+	id, name, prefix, hash, scopes, account_id, expires_at, last_used_at, revoked_at, created_at
+`
+
+//APIKeyRepository armazena a estrutura de dados de um repositório de APIKey
+type APIKeyRepository struct {
+	handler repository.SQLHandler
+}
+
+//NewAPIKeyRepository constrói um APIKeyRepository com suas dependências
+func NewAPIKeyRepository(h repository.SQLHandler) APIKeyRepository {
+	return APIKeyRepository{handler: h}
+}
+
+//Store insere uma APIKey no database
+func (a APIKeyRepository) Store(ctx context.Context, key domain.APIKey) (domain.APIKey, error) {
+	query := `
+		INSERT INTO
+			api_keys (` + apiKeyColumns + `)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	if err := a.handler.ExecuteContext(
+		ctx,
+		query,
+		key.ID(),
+		key.Name(),
+		key.Prefix(),
+		key.Hash(),
+		pq.Array(scopesToStrings(key.Scopes())),
+		key.AccountID(),
+		nullTime(key.ExpiresAt()),
+		nullTime(key.LastUsedAt()),
+		nullTime(key.RevokedAt()),
+		key.CreatedAt(),
+	); err != nil {
+		return domain.APIKey{}, errors.Wrap(err, "error creating api key")
+	}
+
+	return key, nil
+}
+
+//Update altera a expiração e a revogação de uma APIKey no database
+func (a APIKeyRepository) Update(ctx context.Context, key domain.APIKey) error {
+	query := "UPDATE api_keys SET expires_at = $1, revoked_at = $2 WHERE id = $3"
+
+	if err := a.handler.ExecuteContext(
+		ctx,
+		query,
+		nullTime(key.ExpiresAt()),
+		nullTime(key.RevokedAt()),
+		key.ID(),
+	); err != nil {
+		return errors.Wrap(err, "error updating api key")
+	}
+
+	return nil
+}
+
+//UpdateLastUsedAt registra no database o último uso de uma APIKey
+func (a APIKeyRepository) UpdateLastUsedAt(ctx context.Context, ID domain.APIKeyID, lastUsedAt time.Time) error {
+	query := "UPDATE api_keys SET last_used_at = $1 WHERE id = $2"
+
+	if err := a.handler.ExecuteContext(ctx, query, lastUsedAt, ID); err != nil {
+		return errors.Wrap(err, "error updating api key last use")
+	}
+
+	return nil
+}
+
+//FindAll busca todas as APIKey no database
+func (a APIKeyRepository) FindAll(ctx context.Context) ([]domain.APIKey, error) {
+	var (
+		keys  = make([]domain.APIKey, 0)
+		query = "SELECT " + apiKeyColumns + " FROM api_keys ORDER BY created_at, id"
+	)
+
+	rows, err := a.handler.QueryContext(ctx, query)
+	if err != nil {
+		return keys, errors.Wrap(err, "error listing api keys")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return []domain.APIKey{}, errors.Wrap(err, "error listing api keys")
+		}
+
+		keys = append(keys, key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.APIKey{}, err
+	}
+
+	return keys, nil
+}
+
+//FindByID busca uma APIKey por ID no database
+func (a APIKeyRepository) FindByID(ctx context.Context, ID domain.APIKeyID) (domain.APIKey, error) {
+	return a.findOne(ctx, "SELECT "+apiKeyColumns+" FROM api_keys WHERE id = $1", ID)
+}
+
+//FindByHash busca uma APIKey pelo hash da chave no database
+func (a APIKeyRepository) FindByHash(ctx context.Context, hash string) (domain.APIKey, error) {
+	return a.findOne(ctx, "SELECT "+apiKeyColumns+" FROM api_keys WHERE hash = $1", hash)
+}
+
+func (a APIKeyRepository) findOne(ctx context.Context, query string, arg interface{}) (domain.APIKey, error) {
+	row, err := a.handler.QueryContext(ctx, query, arg)
+	if err != nil {
+		return domain.APIKey{}, errors.Wrap(err, "error fetching api key")
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		if err = row.Err(); err != nil {
+			return domain.APIKey{}, errors.Wrap(err, "error fetching api key")
+		}
+
+		return domain.APIKey{}, errors.Wrap(domain.ErrNotFound, "error fetching api key")
+	}
+
+	key, err := scanAPIKey(row)
+	if err != nil {
+		return domain.APIKey{}, errors.Wrap(err, "error fetching api key")
+	}
+
+	return key, nil
+}
+
+func scanAPIKey(row repository.Row) (domain.APIKey, error) {
+	var (
+		ID         string
+		name       string
+		prefix     string
+		hash       string
+		scopes     []string
+		accountID  string
+		expiresAt  pq.NullTime
+		lastUsedAt pq.NullTime
+		revokedAt  pq.NullTime
+		createdAt  time.Time
+	)
+
+	if err := row.Scan(
+		&ID,
+		&name,
+		&prefix,
+		&hash,
+		pq.Array(&scopes),
+		&accountID,
+		&expiresAt,
+		&lastUsedAt,
+		&revokedAt,
+		&createdAt,
+	); err != nil {
+		return domain.APIKey{}, err
+	}
+
+	return domain.NewAPIKey(
+		domain.APIKeyID(ID),
+		name,
+		prefix,
+		hash,
+		stringsToScopes(scopes),
+		domain.AccountID(accountID),
+		expiresAt.Time,
+		lastUsedAt.Time,
+		revokedAt.Time,
+		createdAt,
+	), nil
+}
+
+func scopesToStrings(scopes []domain.Scope) []string {
+	var values = make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		values = append(values, scope.String())
+	}
+
+	return values
+}
+
+func stringsToScopes(values []string) []domain.Scope {
+	var scopes = make([]domain.Scope, 0, len(values))
+	for _, value := range values {
+		scopes = append(scopes, domain.Scope(value))
+	}
+
+	return scopes
+}
+
+//nullTime converte as datas zeradas do domínio em NULL
+func nullTime(t time.Time) pq.NullTime {
+	return pq.NullTime{Time: t, Valid: !t.IsZero()}
+}
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/pkg/errors"
+)
+
+//BalanceCorrectionRepository armazena a estrutura de dados de um repositório de BalanceCorrection
+type BalanceCorrectionRepository struct {
+	handler repository.SQLHandler
+}
+
+//NewBalanceCorrectionRepository constrói um BalanceCorrectionRepository com suas dependências
+func NewBalanceCorrectionRepository(h repository.SQLHandler) BalanceCorrectionRepository {
+	return BalanceCorrectionRepository{handler: h}
+}
+
+//Store insere uma BalanceCorrection no database, permitindo uma única pendente por Account
+func (b BalanceCorrectionRepository) Store(
+	ctx context.Context,
+	correction domain.BalanceCorrection,
+) (domain.BalanceCorrection, error) {
+	query := `
+		INSERT INTO
+			balance_corrections (id, account_id, amount, status, created_at)
+		VALUES
+			($1, $2, $3, $4, $5)
+	`
+
+	if err := b.handler.ExecuteContext(
+		ctx,
+		query,
+		correction.ID(),
+		correction.AccountID(),
+		correction.Amount(),
+		correction.Status(),
+		correction.CreatedAt(),
+	); err != nil {
+		if isUniqueViolation(err) {
+			return domain.BalanceCorrection{}, errors.Wrap(domain.ErrBalanceCorrectionAlreadyExists, "error creating balance correction")
+		}
+
+		return domain.BalanceCorrection{}, errors.Wrap(err, "error creating balance correction")
+	}
+
+	return correction, nil
+}
+
+//UpdateStatus decide uma BalanceCorrection pendente no database, retornando ErrBalanceCorrectionNotPending quando ela
+//já foi decidida
+//
+//A atualização condicionada ao status pendente bloqueia a linha até o fim da transação, de modo que duas decisões
+//concorrentes não aplicam a mesma BalanceCorrection
+func (b BalanceCorrectionRepository) UpdateStatus(
+	ctx context.Context,
+	ID domain.BalanceCorrectionID,
+	status domain.BalanceCorrectionStatus,
+) error {
+	query := `
+		UPDATE balance_corrections SET status = $1
+		WHERE id = $2 AND status = $3
+		RETURNING id
+	`
+
+	row, err := b.handler.QueryContext(ctx, query, status, ID, domain.BalanceCorrectionStatusPending)
+	if err != nil {
+		return errors.Wrap(err, "error updating balance correction status")
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		if err = row.Err(); err != nil {
+			return errors.Wrap(err, "error updating balance correction status")
+		}
+
+		return errors.Wrap(domain.ErrBalanceCorrectionNotPending, "error updating balance correction status")
+	}
+
+	return nil
+}
+
+//FindByID busca uma BalanceCorrection no database
+func (b BalanceCorrectionRepository) FindByID(
+	ctx context.Context,
+	ID domain.BalanceCorrectionID,
+) (domain.BalanceCorrection, error) {
+	query := `
+		SELECT id, account_id, amount, status, created_at
+		FROM balance_corrections
+		WHERE id = $1
+	`
+
+	row, err := b.handler.QueryContext(ctx, query, ID)
+	if err != nil {
+		return domain.BalanceCorrection{}, errors.Wrap(err, "error fetching balance correction")
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		if err = row.Err(); err != nil {
+			return domain.BalanceCorrection{}, errors.Wrap(err, "error fetching balance correction")
+		}
+
+		return domain.BalanceCorrection{}, errors.Wrap(domain.ErrNotFound, "error fetching balance correction")
+	}
+
+	correction, err := scanBalanceCorrection(row)
+	if err != nil {
+		return domain.BalanceCorrection{}, errors.Wrap(err, "error fetching balance correction")
+	}
+
+	return correction, nil
+}
+
+//FindPendingByAccountID busca a BalanceCorrection pendente de uma Account no database
+func (b BalanceCorrectionRepository) FindPendingByAccountID(
+	ctx context.Context,
+	accountID domain.AccountID,
+) (domain.BalanceCorrection, error) {
+	query := `
+		SELECT id, account_id, amount, status, created_at
+		FROM balance_corrections
+		WHERE account_id = $1 AND status = $2
+	`
+
+	row, err := b.handler.QueryContext(ctx, query, accountID, domain.BalanceCorrectionStatusPending)
+	if err != nil {
+		return domain.BalanceCorrection{}, errors.Wrap(err, "error fetching pending balance correction")
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		if err = row.Err(); err != nil {
+			return domain.BalanceCorrection{}, errors.Wrap(err, "error fetching pending balance correction")
+		}
+
+		return domain.BalanceCorrection{}, errors.Wrap(domain.ErrNotFound, "error fetching pending balance correction")
+	}
+
+	correction, err := scanBalanceCorrection(row)
+	if err != nil {
+		return domain.BalanceCorrection{}, errors.Wrap(err, "error fetching pending balance correction")
+	}
+
+	return correction, nil
+}
+
+func scanBalanceCorrection(row repository.Row) (domain.BalanceCorrection, error) {
+	var (
+		ID        string
+		accountID string
+		amount    int64
+		status    string
+		createdAt time.Time
+	)
+
+	if err := row.Scan(&ID, &accountID, &amount, &status, &createdAt); err != nil {
+		return domain.BalanceCorrection{}, err
+	}
+
+	return domain.NewBalanceCorrection(
+		domain.BalanceCorrectionID(ID),
+		domain.AccountID(accountID),
+		domain.Money(amount),
+		domain.BalanceCorrectionStatus(status),
+		createdAt,
+	), nil
+}
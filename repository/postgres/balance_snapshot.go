@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/pkg/errors"
+)
+
+//BalanceSnapshotRepository armazena a estrutura de dados de um repositório de BalanceSnapshot
+type BalanceSnapshotRepository struct {
+	handler repository.SQLHandler
+}
+
+//NewBalanceSnapshotRepository constrói um BalanceSnapshotRepository com suas dependências
+func NewBalanceSnapshotRepository(h repository.SQLHandler) BalanceSnapshotRepository {
+	return BalanceSnapshotRepository{handler: h}
+}
+
+//Store insere um BalanceSnapshot no database
+func (b BalanceSnapshotRepository) Store(
+	ctx context.Context,
+	snapshot domain.BalanceSnapshot,
+) (domain.BalanceSnapshot, error) {
+	query := `
+		INSERT INTO
+			balance_snapshots (account_id, date, balance, created_at)
+		VALUES
+			($1, $2, $3, $4)
+	`
+
+	if err := b.handler.ExecuteContext(
+		ctx,
+		query,
+		snapshot.AccountID(),
+		snapshot.Date().Format("2006-01-02"),
+		snapshot.Balance(),
+		snapshot.CreatedAt(),
+	); err != nil {
+		if isUniqueViolation(err) {
+			return domain.BalanceSnapshot{}, errors.Wrap(domain.ErrBalanceSnapshotAlreadyExists, "error creating balance snapshot")
+		}
+
+		return domain.BalanceSnapshot{}, errors.Wrap(err, "error creating balance snapshot")
+	}
+
+	return snapshot, nil
+}
+
+//FindLatest busca no database o BalanceSnapshot mais recente de uma Account cujo dia terminou até at
+func (b BalanceSnapshotRepository) FindLatest(
+	ctx context.Context,
+	ID domain.AccountID,
+	at time.Time,
+) (domain.BalanceSnapshot, error) {
+	var (
+		query = `
+			SELECT account_id, date, balance, created_at
+			FROM balance_snapshots
+			WHERE account_id = $1 AND date <= $2
+			ORDER BY date DESC
+			LIMIT 1
+		`
+		accountID string
+		date      time.Time
+		balance   int64
+		createdAt time.Time
+	)
+
+	row, err := b.handler.QueryContext(
+		ctx,
+		query,
+		ID,
+		domain.TruncateDay(at.Add(-24*time.Hour)).Format("2006-01-02"),
+	)
+	if err != nil {
+		return domain.BalanceSnapshot{}, errors.Wrap(err, "error fetching balance snapshot")
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		if err = row.Err(); err != nil {
+			return domain.BalanceSnapshot{}, errors.Wrap(err, "error fetching balance snapshot")
+		}
+
+		return domain.BalanceSnapshot{}, errors.Wrap(domain.ErrNotFound, "error fetching balance snapshot")
+	}
+
+	if err = row.Scan(&accountID, &date, &balance, &createdAt); err != nil {
+		return domain.BalanceSnapshot{}, errors.Wrap(err, "error fetching balance snapshot")
+	}
+
+	return domain.NewBalanceSnapshot(
+		domain.AccountID(accountID),
+		date,
+		domain.Money(balance),
+		createdAt,
+	), nil
+}
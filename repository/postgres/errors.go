@@ -0,0 +1,18 @@
+package postgres
+
+import (
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const uniqueViolation = "unique_violation"
+
+//isUniqueViolation verifica se o erro foi causado por uma violação de constraint UNIQUE
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code.Name() == uniqueViolation
+	}
+
+	return false
+}
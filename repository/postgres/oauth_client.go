@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const oauthClientColumns = `
+	id, name, secret_hash, scopes, account_id, created_at
+`
+
+//OAuthClientRepository armazena a estrutura de dados de um repositório de OAuthClient
+type OAuthClientRepository struct {
+	handler repository.SQLHandler
+}
+
+//NewOAuthClientRepository constrói um OAuthClientRepository com suas dependências
+func NewOAuthClientRepository(h repository.SQLHandler) OAuthClientRepository {
+	return OAuthClientRepository{handler: h}
+}
+
+//Store insere um OAuthClient no database
+func (o OAuthClientRepository) Store(ctx context.Context, client domain.OAuthClient) (domain.OAuthClient, error) {
+	query := `
+		INSERT INTO
+			oauth_clients (` + oauthClientColumns + `)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+	`
+
+	if err := o.handler.ExecuteContext(
+		ctx,
+		query,
+		client.ID(),
+		client.Name(),
+		client.SecretHash(),
+		pq.Array(scopesToStrings(client.Scopes())),
+		client.AccountID(),
+		client.CreatedAt(),
+	); err != nil {
+		return domain.OAuthClient{}, errors.Wrap(err, "error creating oauth client")
+	}
+
+	return client, nil
+}
+
+//Delete remove um OAuthClient do database
+func (o OAuthClientRepository) Delete(ctx context.Context, ID domain.OAuthClientID) error {
+	query := "DELETE FROM oauth_clients WHERE id = $1"
+
+	if err := o.handler.ExecuteContext(ctx, query, ID); err != nil {
+		return errors.Wrap(err, "error deleting oauth client")
+	}
+
+	return nil
+}
+
+//FindAll busca todos os OAuthClient no database
+func (o OAuthClientRepository) FindAll(ctx context.Context) ([]domain.OAuthClient, error) {
+	var (
+		clients = make([]domain.OAuthClient, 0)
+		query   = "SELECT " + oauthClientColumns + " FROM oauth_clients ORDER BY created_at, id"
+	)
+
+	rows, err := o.handler.QueryContext(ctx, query)
+	if err != nil {
+		return clients, errors.Wrap(err, "error listing oauth clients")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		client, err := scanOAuthClient(rows)
+		if err != nil {
+			return []domain.OAuthClient{}, errors.Wrap(err, "error listing oauth clients")
+		}
+
+		clients = append(clients, client)
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.OAuthClient{}, err
+	}
+
+	return clients, nil
+}
+
+//FindByID busca um OAuthClient por ID no database
+func (o OAuthClientRepository) FindByID(ctx context.Context, ID domain.OAuthClientID) (domain.OAuthClient, error) {
+	query := "SELECT " + oauthClientColumns + " FROM oauth_clients WHERE id = $1"
+
+	row, err := o.handler.QueryContext(ctx, query, ID)
+	if err != nil {
+		return domain.OAuthClient{}, errors.Wrap(err, "error fetching oauth client")
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		if err = row.Err(); err != nil {
+			return domain.OAuthClient{}, errors.Wrap(err, "error fetching oauth client")
+		}
+
+		return domain.OAuthClient{}, errors.Wrap(domain.ErrNotFound, "error fetching oauth client")
+	}
+
+	client, err := scanOAuthClient(row)
+	if err != nil {
+		return domain.OAuthClient{}, errors.Wrap(err, "error fetching oauth client")
+	}
+
+	return client, nil
+}
+
+func scanOAuthClient(row repository.Row) (domain.OAuthClient, error) {
+	var (
+		ID         string
+		name       string
+		secretHash string
+		scopes     []string
+		accountID  string
+		createdAt  time.Time
+	)
+
+	if err := row.Scan(&ID, &name, &secretHash, pq.Array(&scopes), &accountID, &createdAt); err != nil {
+		return domain.OAuthClient{}, err
+	}
+
+	return domain.NewOAuthClient(
+		domain.OAuthClientID(ID),
+		name,
+		secretHash,
+		stringsToScopes(scopes),
+		domain.AccountID(accountID),
+		createdAt,
+	), nil
+}
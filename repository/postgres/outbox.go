@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+//OutboxRepository armazena a estrutura de dados de um repositório de OutboxMessage
+type OutboxRepository struct {
+	handler repository.SQLHandler
+}
+
+//NewOutboxRepository constrói um OutboxRepository com suas dependências
+func NewOutboxRepository(h repository.SQLHandler) OutboxRepository {
+	return OutboxRepository{handler: h}
+}
+
+//Store insere as OutboxMessage no database, participando da transação carregada pelo contexto
+func (o OutboxRepository) Store(ctx context.Context, messages ...domain.OutboxMessage) error {
+	query := `
+		INSERT INTO
+			outbox (id, event_type, account_ids, payload, position, created_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+	`
+
+	for _, message := range messages {
+		var accountIDs = make([]string, 0, len(message.AccountIDs()))
+		for _, ID := range message.AccountIDs() {
+			accountIDs = append(accountIDs, ID.String())
+		}
+
+		if err := o.handler.ExecuteContext(
+			ctx,
+			query,
+			message.ID(),
+			message.EventType(),
+			pq.Array(accountIDs),
+			string(message.Payload()),
+			message.Position(),
+			message.CreatedAt(),
+		); err != nil {
+			return errors.Wrap(err, "error creating outbox message")
+		}
+	}
+
+	return nil
+}
+
+//FindPending busca no database as OutboxMessage ainda não publicadas nem marcadas como falha, na ordem em que foram
+//gravadas
+func (o OutboxRepository) FindPending(ctx context.Context, limit int) ([]domain.OutboxMessage, error) {
+	query := `
+		SELECT id, event_type, account_ids, payload, position, attempts, created_at, sent_at
+		FROM outbox
+		WHERE sent_at IS NULL AND failed_at IS NULL
+		ORDER BY created_at, position
+		LIMIT $1
+	`
+
+	messages, err := o.find(ctx, query, limit)
+	if err != nil {
+		return messages, errors.Wrap(err, "error listing pending outbox messages")
+	}
+
+	return messages, nil
+}
+
+//FindSentSince busca no database as OutboxMessage publicadas a partir de since, na ordem de publicação
+func (o OutboxRepository) FindSentSince(ctx context.Context, since time.Time, limit int) ([]domain.OutboxMessage, error) {
+	query := `
+		SELECT id, event_type, account_ids, payload, position, attempts, created_at, sent_at
+		FROM outbox
+		WHERE sent_at IS NOT NULL AND sent_at >= $1
+		ORDER BY sent_at, created_at, position
+		LIMIT $2
+	`
+
+	messages, err := o.find(ctx, query, since, limit)
+	if err != nil {
+		return messages, errors.Wrap(err, "error listing sent outbox messages")
+	}
+
+	return messages, nil
+}
+
+func (o OutboxRepository) find(ctx context.Context, query string, args ...interface{}) ([]domain.OutboxMessage, error) {
+	var messages = make([]domain.OutboxMessage, 0)
+
+	rows, err := o.handler.QueryContext(ctx, query, args...)
+	if err != nil {
+		return messages, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			ID         string
+			eventType  string
+			accountIDs []string
+			payload    string
+			position   int
+			attempts   int
+			createdAt  time.Time
+			sentAt     pq.NullTime
+		)
+
+		if err = rows.Scan(
+			&ID,
+			&eventType,
+			pq.Array(&accountIDs),
+			&payload,
+			&position,
+			&attempts,
+			&createdAt,
+			&sentAt,
+		); err != nil {
+			return []domain.OutboxMessage{}, err
+		}
+
+		var IDs = make([]domain.AccountID, 0, len(accountIDs))
+		for _, accountID := range accountIDs {
+			IDs = append(IDs, domain.AccountID(accountID))
+		}
+
+		messages = append(messages, domain.NewOutboxMessage(
+			domain.OutboxMessageID(ID),
+			domain.EventType(eventType),
+			IDs,
+			[]byte(payload),
+			position,
+			createdAt,
+			sentAt.Time,
+		).WithAttempts(attempts))
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.OutboxMessage{}, err
+	}
+
+	return messages, nil
+}
+
+//MarkSent registra no database a publicação de uma OutboxMessage
+func (o OutboxRepository) MarkSent(ctx context.Context, ID domain.OutboxMessageID, sentAt time.Time) error {
+	query := "UPDATE outbox SET sent_at = $1 WHERE id = $2"
+
+	if err := o.handler.ExecuteContext(ctx, query, sentAt, ID); err != nil {
+		return errors.Wrap(err, "error marking outbox message as sent")
+	}
+
+	return nil
+}
+
+//RecordFailure registra no database uma publicação da OutboxMessage que falhou, mantendo-a pendente
+func (o OutboxRepository) RecordFailure(ctx context.Context, ID domain.OutboxMessageID, reason string) error {
+	query := "UPDATE outbox SET attempts = attempts + 1, last_error = $1 WHERE id = $2"
+
+	if err := o.handler.ExecuteContext(ctx, query, reason, ID); err != nil {
+		return errors.Wrap(err, "error recording outbox message failure")
+	}
+
+	return nil
+}
+
+//MarkFailed registra no database a última publicação da OutboxMessage que falhou, retirando-a das pendentes
+func (o OutboxRepository) MarkFailed(
+	ctx context.Context,
+	ID domain.OutboxMessageID,
+	reason string,
+	failedAt time.Time,
+) error {
+	query := "UPDATE outbox SET attempts = attempts + 1, last_error = $1, failed_at = $2 WHERE id = $3"
+
+	if err := o.handler.ExecuteContext(ctx, query, reason, failedAt, ID); err != nil {
+		return errors.Wrap(err, "error marking outbox message as failed")
+	}
+
+	return nil
+}
+
+//DeleteSentBefore remove do database as OutboxMessage publicadas antes de before e retorna a quantidade removida
+func (o OutboxRepository) DeleteSentBefore(ctx context.Context, before time.Time) (int64, error) {
+	var (
+		deleted int64
+		query   = `
+			WITH deleted AS (
+				DELETE FROM outbox WHERE sent_at IS NOT NULL AND sent_at < $1 RETURNING id
+			)
+			SELECT COUNT(*) FROM deleted
+		`
+	)
+
+	row, err := o.handler.QueryContext(ctx, query, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "error deleting sent outbox messages")
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		return 0, errors.Wrap(row.Err(), "error deleting sent outbox messages")
+	}
+
+	if err = row.Scan(&deleted); err != nil {
+		return 0, errors.Wrap(err, "error deleting sent outbox messages")
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//conditions acumula as cláusulas do WHERE e seus argumentos, numerando os placeholders na ordem em que são adicionados
+type conditions struct {
+	clauses []string
+	args    []interface{}
+}
+
+//add inclui uma cláusula cujos placeholders "?" são substituídos pelos parâmetros posicionais do Postgres
+func (c *conditions) add(clause string, args ...interface{}) {
+	for _, arg := range args {
+		c.args = append(c.args, arg)
+		clause = strings.Replace(clause, "?", fmt.Sprintf("$%d", len(c.args)), 1)
+	}
+
+	c.clauses = append(c.clauses, clause)
+}
+
+func (c conditions) where() string {
+	if len(c.clauses) == 0 {
+		return ""
+	}
+
+	return " WHERE " + strings.Join(c.clauses, " AND ")
+}
+
+//paginate complementa a query com o filtro do cursor, a ordenação pela coluna informada e id e o limite da página
+func paginate(
+	query string,
+	where conditions,
+	column string,
+	descending bool,
+	after interface{},
+	pagination domain.Pagination,
+) (string, []interface{}) {
+	var operator, direction = ">", "ASC"
+	if descending {
+		operator, direction = "<", "DESC"
+	}
+
+	if !pagination.After.IsZero() {
+		where.add(fmt.Sprintf("(%s, id) %s (?, ?)", column, operator), after, pagination.After.ID())
+	}
+
+	query += where.where()
+	query += fmt.Sprintf(" ORDER BY %s %s, id %s", column, direction, direction)
+
+	where.args = append(where.args, pagination.Limit)
+	query += fmt.Sprintf(" LIMIT $%d", len(where.args))
+
+	return query, where.args
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/pkg/errors"
+)
+
+//SigningKeyRepository armazena a estrutura de dados de um repositório de SigningKey
+type SigningKeyRepository struct {
+	handler repository.SQLHandler
+}
+
+//NewSigningKeyRepository constrói um SigningKeyRepository com suas dependências
+func NewSigningKeyRepository(h repository.SQLHandler) SigningKeyRepository {
+	return SigningKeyRepository{handler: h}
+}
+
+//Store insere uma SigningKey no database, com a chave privada no formato PKCS #1
+func (s SigningKeyRepository) Store(ctx context.Context, key domain.SigningKey) (domain.SigningKey, error) {
+	query := "INSERT INTO signing_keys (id, private_key, created_at) VALUES ($1, $2, $3)"
+
+	if err := s.handler.ExecuteContext(
+		ctx,
+		query,
+		key.ID(),
+		x509.MarshalPKCS1PrivateKey(key.PrivateKey()),
+		key.CreatedAt(),
+	); err != nil {
+		return domain.SigningKey{}, errors.Wrap(err, "error creating signing key")
+	}
+
+	return key, nil
+}
+
+//FindCreatedAfter busca no database as SigningKey criadas após since, da mais recente para a mais antiga
+func (s SigningKeyRepository) FindCreatedAfter(ctx context.Context, since time.Time) ([]domain.SigningKey, error) {
+	var (
+		keys  = make([]domain.SigningKey, 0)
+		query = `
+			SELECT id, private_key, created_at
+			FROM signing_keys
+			WHERE created_at > $1
+			ORDER BY created_at DESC, id
+		`
+	)
+
+	rows, err := s.handler.QueryContext(ctx, query, since)
+	if err != nil {
+		return keys, errors.Wrap(err, "error listing signing keys")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			ID         string
+			privateKey []byte
+			createdAt  time.Time
+		)
+
+		if err = rows.Scan(&ID, &privateKey, &createdAt); err != nil {
+			return []domain.SigningKey{}, errors.Wrap(err, "error listing signing keys")
+		}
+
+		key, err := x509.ParsePKCS1PrivateKey(privateKey)
+		if err != nil {
+			return []domain.SigningKey{}, errors.Wrap(err, "error decoding signing key")
+		}
+
+		keys = append(keys, domain.NewSigningKey(domain.SigningKeyID(ID), key, createdAt))
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.SigningKey{}, err
+	}
+
+	return keys, nil
+}
@@ -24,9 +24,9 @@ func NewTransferRepository(h repository.SQLHandler) TransferRepository {
 func (t TransferRepository) Store(ctx context.Context, transfer domain.Transfer) (domain.Transfer, error) {
 	query := `
 		INSERT INTO 
-			transfers (id, account_origin_id, account_destination_id, amount, created_at)
+			transfers (id, account_origin_id, account_destination_id, amount, status, created_at)
 		VALUES 
-			($1, $2, $3, $4, $5)
+			($1, $2, $3, $4, $5, $6)
 	`
 
 	if err := t.handler.ExecuteContext(
@@ -36,6 +36,7 @@ func (t TransferRepository) Store(ctx context.Context, transfer domain.Transfer)
 		transfer.AccountOriginID(),
 		transfer.AccountDestinationID(),
 		transfer.Amount(),
+		transfer.Status(),
 		transfer.CreatedAt(),
 	); err != nil {
 		return domain.Transfer{}, errors.Wrap(err, "error creating transfer")
@@ -44,14 +45,22 @@ func (t TransferRepository) Store(ctx context.Context, transfer domain.Transfer)
 	return transfer, nil
 }
 
-//FindAll busca todas as Transfer no database
-func (t TransferRepository) FindAll(ctx context.Context) ([]domain.Transfer, error) {
+//FindAll busca uma página de Transfer no database que atendem aos critérios da TransferQuery
+func (t TransferRepository) FindAll(ctx context.Context, query domain.TransferQuery) ([]domain.Transfer, error) {
 	var (
-		transfers = make([]domain.Transfer, 0)
-		query     = "SELECT * FROM transfers"
+		transfers       = make([]domain.Transfer, 0)
+		column, after   = transferSortColumn(query)
+		statement, args = paginate(
+			"SELECT id, account_origin_id, account_destination_id, amount, status, created_at FROM transfers",
+			transferConditions(query.Filter),
+			column,
+			query.Sort.Descending(),
+			after,
+			query.Pagination,
+		)
 	)
 
-	rows, err := t.handler.QueryContext(ctx, query)
+	rows, err := t.handler.QueryContext(ctx, statement, args...)
 	if err != nil {
 		return transfers, errors.Wrap(err, "error listing transfers")
 	}
@@ -62,10 +71,11 @@ func (t TransferRepository) FindAll(ctx context.Context) ([]domain.Transfer, err
 			accountOriginID      string
 			accountDestinationID string
 			amount               int64
+			status               string
 			createdAt            time.Time
 		)
 
-		if err = rows.Scan(&ID, &accountOriginID, &accountDestinationID, &amount, &createdAt); err != nil {
+		if err = rows.Scan(&ID, &accountOriginID, &accountDestinationID, &amount, &status, &createdAt); err != nil {
 			return []domain.Transfer{}, errors.Wrap(err, "error listing transfers")
 		}
 
@@ -74,6 +84,62 @@ func (t TransferRepository) FindAll(ctx context.Context) ([]domain.Transfer, err
 			domain.AccountID(accountOriginID),
 			domain.AccountID(accountDestinationID),
 			domain.Money(amount),
+			domain.TransferStatus(status),
+			createdAt,
+		))
+	}
+	defer rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return []domain.Transfer{}, err
+	}
+
+	return transfers, nil
+}
+
+//FindByAccountID busca as Transfer enviadas ou recebidas por uma Account no período [from, to)
+func (t TransferRepository) FindByAccountID(
+	ctx context.Context,
+	ID domain.AccountID,
+	from time.Time,
+	to time.Time,
+) ([]domain.Transfer, error) {
+	var (
+		transfers = make([]domain.Transfer, 0)
+		query     = `
+			SELECT id, account_origin_id, account_destination_id, amount, status, created_at
+			FROM transfers
+			WHERE (account_origin_id = $1 OR account_destination_id = $1)
+				AND created_at >= $2 AND created_at < $3
+			ORDER BY created_at, id
+		`
+	)
+
+	rows, err := t.handler.QueryContext(ctx, query, ID, from, to)
+	if err != nil {
+		return transfers, errors.Wrap(err, "error listing account transfers")
+	}
+
+	for rows.Next() {
+		var (
+			ID                   string
+			accountOriginID      string
+			accountDestinationID string
+			amount               int64
+			status               string
+			createdAt            time.Time
+		)
+
+		if err = rows.Scan(&ID, &accountOriginID, &accountDestinationID, &amount, &status, &createdAt); err != nil {
+			return []domain.Transfer{}, errors.Wrap(err, "error listing account transfers")
+		}
+
+		transfers = append(transfers, domain.NewTransfer(
+			domain.TransferID(ID),
+			domain.AccountID(accountOriginID),
+			domain.AccountID(accountDestinationID),
+			domain.Money(amount),
+			domain.TransferStatus(status),
 			createdAt,
 		))
 	}
@@ -85,3 +151,86 @@ func (t TransferRepository) FindAll(ctx context.Context) ([]domain.Transfer, err
 
 	return transfers, nil
 }
+
+//NetAmountByAccountID soma as Transfer recebidas menos as enviadas por uma Account no período [from, to)
+func (t TransferRepository) NetAmountByAccountID(
+	ctx context.Context,
+	ID domain.AccountID,
+	from time.Time,
+	to time.Time,
+) (domain.Money, error) {
+	var (
+		amount int64
+		query  = `
+			SELECT COALESCE(SUM(CASE WHEN account_destination_id = $1 THEN amount ELSE -amount END), 0)
+			FROM transfers
+			WHERE (account_origin_id = $1 OR account_destination_id = $1)
+				AND created_at >= $2 AND created_at < $3
+		`
+	)
+
+	row, err := t.handler.QueryContext(ctx, query, ID, from, to)
+	if err != nil {
+		return 0, errors.Wrap(err, "error summing account transfers")
+	}
+
+	defer row.Close()
+	row.Next()
+	if err = row.Scan(&amount); err != nil {
+		return 0, errors.Wrap(err, "error summing account transfers")
+	}
+
+	if err = row.Err(); err != nil {
+		return 0, err
+	}
+
+	return domain.Money(amount), nil
+}
+
+//transferConditions traduz o TransferFilter para as cláusulas do WHERE
+func transferConditions(filter domain.TransferFilter) conditions {
+	var where conditions
+
+	if filter.AccountID != "" {
+		where.add("(account_origin_id = ? OR account_destination_id = ?)", filter.AccountID, filter.AccountID)
+	}
+
+	if filter.AccountOriginID != "" {
+		where.add("account_origin_id = ?", filter.AccountOriginID)
+	}
+
+	if filter.AccountDestinationID != "" {
+		where.add("account_destination_id = ?", filter.AccountDestinationID)
+	}
+
+	if filter.MinAmount > 0 {
+		where.add("amount >= ?", filter.MinAmount)
+	}
+
+	if filter.MaxAmount > 0 {
+		where.add("amount <= ?", filter.MaxAmount)
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		where.add("created_at >= ?", filter.CreatedFrom)
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		where.add("created_at < ?", filter.CreatedTo)
+	}
+
+	if filter.Status != "" {
+		where.add("status = ?", filter.Status)
+	}
+
+	return where
+}
+
+//transferSortColumn retorna a coluna da ordenação permitida e o valor do cursor correspondente
+func transferSortColumn(query domain.TransferQuery) (string, interface{}) {
+	if query.Sort.Field() == domain.TransferSortAmount.Field() {
+		return "amount", query.Pagination.After.Amount()
+	}
+
+	return "created_at", query.Pagination.After.CreatedAt()
+}
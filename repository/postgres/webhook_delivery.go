@@ -0,0 +1,216 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/pkg/errors"
+)
+
+const webhookDeliveryColumns = `id, subscription_id, message_id, event_type, payload, status, attempts,
+	next_attempt_at, last_error, created_at, updated_at`
+
+//WebhookDeliveryRepository armazena a estrutura de dados de um repositório de WebhookDelivery
+type WebhookDeliveryRepository struct {
+	handler repository.SQLHandler
+}
+
+//NewWebhookDeliveryRepository constrói um WebhookDeliveryRepository com suas dependências
+func NewWebhookDeliveryRepository(h repository.SQLHandler) WebhookDeliveryRepository {
+	return WebhookDeliveryRepository{handler: h}
+}
+
+//Store insere uma WebhookDelivery no database, permitindo uma única entrega por WebhookSubscription e OutboxMessage
+func (w WebhookDeliveryRepository) Store(
+	ctx context.Context,
+	delivery domain.WebhookDelivery,
+) (domain.WebhookDelivery, error) {
+	query := `
+		INSERT INTO
+			webhook_deliveries (` + webhookDeliveryColumns + `)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	if err := w.handler.ExecuteContext(
+		ctx,
+		query,
+		delivery.ID(),
+		delivery.SubscriptionID(),
+		delivery.MessageID(),
+		delivery.EventType(),
+		string(delivery.Payload()),
+		delivery.Status(),
+		delivery.Attempts(),
+		delivery.NextAttemptAt(),
+		delivery.LastError(),
+		delivery.CreatedAt(),
+		delivery.UpdatedAt(),
+	); err != nil {
+		if isUniqueViolation(err) {
+			return domain.WebhookDelivery{}, errors.Wrap(domain.ErrWebhookDeliveryAlreadyExists, "error creating webhook delivery")
+		}
+
+		return domain.WebhookDelivery{}, errors.Wrap(err, "error creating webhook delivery")
+	}
+
+	return delivery, nil
+}
+
+//Update altera a situação e as tentativas de uma WebhookDelivery no database
+func (w WebhookDeliveryRepository) Update(ctx context.Context, delivery domain.WebhookDelivery) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $1, attempts = $2, next_attempt_at = $3, last_error = $4, updated_at = $5
+		WHERE id = $6
+	`
+
+	if err := w.handler.ExecuteContext(
+		ctx,
+		query,
+		delivery.Status(),
+		delivery.Attempts(),
+		delivery.NextAttemptAt(),
+		delivery.LastError(),
+		delivery.UpdatedAt(),
+		delivery.ID(),
+	); err != nil {
+		return errors.Wrap(err, "error updating webhook delivery")
+	}
+
+	return nil
+}
+
+//FindByID busca uma WebhookDelivery por ID no database
+func (w WebhookDeliveryRepository) FindByID(
+	ctx context.Context,
+	ID domain.WebhookDeliveryID,
+) (domain.WebhookDelivery, error) {
+	query := "SELECT " + webhookDeliveryColumns + " FROM webhook_deliveries WHERE id = $1"
+
+	deliveries, err := w.find(ctx, query, ID)
+	if err != nil {
+		return domain.WebhookDelivery{}, errors.Wrap(err, "error fetching webhook delivery")
+	}
+
+	if len(deliveries) == 0 {
+		return domain.WebhookDelivery{}, errors.Wrap(domain.ErrNotFound, "error fetching webhook delivery")
+	}
+
+	return deliveries[0], nil
+}
+
+//FindBySubscriptionID busca no database as WebhookDelivery mais recentes de uma WebhookSubscription, filtrando
+//pela situação quando informada
+func (w WebhookDeliveryRepository) FindBySubscriptionID(
+	ctx context.Context,
+	ID domain.WebhookSubscriptionID,
+	status domain.WebhookDeliveryStatus,
+	limit int,
+) ([]domain.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE subscription_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3
+	`
+
+	deliveries, err := w.find(ctx, query, ID, status, limit)
+	if err != nil {
+		return deliveries, errors.Wrap(err, "error listing webhook deliveries")
+	}
+
+	return deliveries, nil
+}
+
+//FindDue busca no database as WebhookDelivery pendentes cuja próxima tentativa ocorre até at
+func (w WebhookDeliveryRepository) FindDue(
+	ctx context.Context,
+	at time.Time,
+	limit int,
+) ([]domain.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE status = $1 AND next_attempt_at <= $2
+		ORDER BY next_attempt_at, created_at
+		LIMIT $3
+	`
+
+	deliveries, err := w.find(ctx, query, domain.WebhookDeliveryStatusPending, at, limit)
+	if err != nil {
+		return deliveries, errors.Wrap(err, "error listing due webhook deliveries")
+	}
+
+	return deliveries, nil
+}
+
+func (w WebhookDeliveryRepository) find(
+	ctx context.Context,
+	query string,
+	args ...interface{},
+) ([]domain.WebhookDelivery, error) {
+	var deliveries = make([]domain.WebhookDelivery, 0)
+
+	rows, err := w.handler.QueryContext(ctx, query, args...)
+	if err != nil {
+		return deliveries, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			ID             string
+			subscriptionID string
+			messageID      string
+			eventType      string
+			payload        string
+			status         string
+			attempts       int
+			nextAttemptAt  time.Time
+			lastError      string
+			createdAt      time.Time
+			updatedAt      time.Time
+		)
+
+		if err = rows.Scan(
+			&ID,
+			&subscriptionID,
+			&messageID,
+			&eventType,
+			&payload,
+			&status,
+			&attempts,
+			&nextAttemptAt,
+			&lastError,
+			&createdAt,
+			&updatedAt,
+		); err != nil {
+			return []domain.WebhookDelivery{}, err
+		}
+
+		deliveries = append(deliveries, domain.NewWebhookDelivery(
+			domain.WebhookDeliveryID(ID),
+			domain.WebhookSubscriptionID(subscriptionID),
+			domain.OutboxMessageID(messageID),
+			domain.EventType(eventType),
+			[]byte(payload),
+			domain.WebhookDeliveryStatus(status),
+			attempts,
+			nextAttemptAt,
+			lastError,
+			createdAt,
+			updatedAt,
+		))
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.WebhookDelivery{}, err
+	}
+
+	return deliveries, nil
+}
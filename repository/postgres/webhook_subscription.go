@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/repository"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+//WebhookSubscriptionRepository armazena a estrutura de dados de um repositório de WebhookSubscription
+type WebhookSubscriptionRepository struct {
+	handler repository.SQLHandler
+}
+
+//NewWebhookSubscriptionRepository constrói um WebhookSubscriptionRepository com suas dependências
+func NewWebhookSubscriptionRepository(h repository.SQLHandler) WebhookSubscriptionRepository {
+	return WebhookSubscriptionRepository{handler: h}
+}
+
+//Store insere uma WebhookSubscription no database
+func (w WebhookSubscriptionRepository) Store(
+	ctx context.Context,
+	subscription domain.WebhookSubscription,
+) (domain.WebhookSubscription, error) {
+	query := `
+		INSERT INTO
+			webhook_subscriptions (id, url, secret, event_types, account_id, created_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+	`
+
+	if err := w.handler.ExecuteContext(
+		ctx,
+		query,
+		subscription.ID(),
+		subscription.URL(),
+		subscription.Secret(),
+		pq.Array(eventTypesToStrings(subscription.EventTypes())),
+		subscription.AccountID(),
+		subscription.CreatedAt(),
+	); err != nil {
+		return domain.WebhookSubscription{}, errors.Wrap(err, "error creating webhook subscription")
+	}
+
+	return subscription, nil
+}
+
+//Update altera a URL, os tipos de eventos e a Account de uma WebhookSubscription no database
+func (w WebhookSubscriptionRepository) Update(ctx context.Context, subscription domain.WebhookSubscription) error {
+	query := "UPDATE webhook_subscriptions SET url = $1, event_types = $2, account_id = $3 WHERE id = $4"
+
+	if err := w.handler.ExecuteContext(
+		ctx,
+		query,
+		subscription.URL(),
+		pq.Array(eventTypesToStrings(subscription.EventTypes())),
+		subscription.AccountID(),
+		subscription.ID(),
+	); err != nil {
+		return errors.Wrap(err, "error updating webhook subscription")
+	}
+
+	return nil
+}
+
+//Delete remove uma WebhookSubscription e as suas entregas do database
+func (w WebhookSubscriptionRepository) Delete(ctx context.Context, ID domain.WebhookSubscriptionID) error {
+	query := "DELETE FROM webhook_subscriptions WHERE id = $1"
+
+	if err := w.handler.ExecuteContext(ctx, query, ID); err != nil {
+		return errors.Wrap(err, "error deleting webhook subscription")
+	}
+
+	return nil
+}
+
+//FindAll busca todas as WebhookSubscription no database
+func (w WebhookSubscriptionRepository) FindAll(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	var (
+		subscriptions = make([]domain.WebhookSubscription, 0)
+		query         = `
+			SELECT id, url, secret, event_types, account_id, created_at
+			FROM webhook_subscriptions
+			ORDER BY created_at, id
+		`
+	)
+
+	rows, err := w.handler.QueryContext(ctx, query)
+	if err != nil {
+		return subscriptions, errors.Wrap(err, "error listing webhook subscriptions")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		subscription, err := scanWebhookSubscription(rows)
+		if err != nil {
+			return []domain.WebhookSubscription{}, errors.Wrap(err, "error listing webhook subscriptions")
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.WebhookSubscription{}, err
+	}
+
+	return subscriptions, nil
+}
+
+//FindByID busca uma WebhookSubscription por ID no database
+func (w WebhookSubscriptionRepository) FindByID(
+	ctx context.Context,
+	ID domain.WebhookSubscriptionID,
+) (domain.WebhookSubscription, error) {
+	query := `
+		SELECT id, url, secret, event_types, account_id, created_at
+		FROM webhook_subscriptions
+		WHERE id = $1
+	`
+
+	row, err := w.handler.QueryContext(ctx, query, ID)
+	if err != nil {
+		return domain.WebhookSubscription{}, errors.Wrap(err, "error fetching webhook subscription")
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		if err = row.Err(); err != nil {
+			return domain.WebhookSubscription{}, errors.Wrap(err, "error fetching webhook subscription")
+		}
+
+		return domain.WebhookSubscription{}, errors.Wrap(domain.ErrNotFound, "error fetching webhook subscription")
+	}
+
+	subscription, err := scanWebhookSubscription(row)
+	if err != nil {
+		return domain.WebhookSubscription{}, errors.Wrap(err, "error fetching webhook subscription")
+	}
+
+	return subscription, nil
+}
+
+func scanWebhookSubscription(row repository.Row) (domain.WebhookSubscription, error) {
+	var (
+		ID         string
+		url        string
+		secret     string
+		eventTypes []string
+		accountID  string
+		createdAt  time.Time
+	)
+
+	if err := row.Scan(&ID, &url, &secret, pq.Array(&eventTypes), &accountID, &createdAt); err != nil {
+		return domain.WebhookSubscription{}, err
+	}
+
+	var types = make([]domain.EventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		types = append(types, domain.EventType(eventType))
+	}
+
+	return domain.NewWebhookSubscription(
+		domain.WebhookSubscriptionID(ID),
+		url,
+		secret,
+		types,
+		domain.AccountID(accountID),
+		createdAt,
+	), nil
+}
+
+func eventTypesToStrings(eventTypes []domain.EventType) []string {
+	var types = make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		types = append(types, eventType.String())
+	}
+
+	return types
+}
@@ -0,0 +1,17 @@
+package repository
+
+import "github.com/gsabadini/go-bank-transfer/domain"
+
+//Repositories agrupa os repositórios e o controle de transação de um mesmo banco de dados
+type Repositories struct {
+	Account             domain.AccountRepository
+	Transfer            domain.TransferRepository
+	BalanceSnapshot     domain.BalanceSnapshotRepository
+	Outbox              domain.OutboxRepository
+	WebhookSubscription domain.WebhookSubscriptionRepository
+	WebhookDelivery     domain.WebhookDeliveryRepository
+	APIKey              domain.APIKeyRepository
+	OAuthClient         domain.OAuthClientRepository
+	SigningKey          domain.SigningKeyRepository
+	Transactor          domain.Transactor
+}
@@ -12,6 +12,42 @@ db.createUser({
 });
 
 accounts = db.createCollection('accounts');
-db.accounts.createIndex( { "cpf": 1 }, { unique: true } )
+db.accounts.createIndex( { "tax_id_type": 1, "tax_id": 1 }, { unique: true } )
+db.accounts.createIndex( { "created_at": 1, "id": 1 } )
 
 db.createCollection('transfers');
+db.transfers.createIndex( { "created_at": 1, "id": 1 } )
+db.transfers.createIndex( { "amount": 1, "id": 1 } )
+db.transfers.createIndex( { "status": 1, "created_at": 1, "id": 1 } )
+db.transfers.createIndex( { "account_origin_id": 1, "created_at": 1, "id": 1 } )
+db.transfers.createIndex( { "account_destination_id": 1, "created_at": 1, "id": 1 } )
+
+db.createCollection('balance_snapshots');
+db.balance_snapshots.createIndex( { "account_id": 1, "date": 1 }, { unique: true } )
+
+db.createCollection('balance_corrections');
+db.balance_corrections.createIndex( { "account_id": 1 }, { unique: true, partialFilterExpression: { "status": "pending" } } )
+
+db.createCollection('outbox');
+db.outbox.createIndex( { "id": 1 }, { unique: true } )
+db.outbox.createIndex( { "sent_at": 1, "failed_at": 1, "created_at": 1, "position": 1 } )
+
+db.createCollection('webhook_subscriptions');
+db.webhook_subscriptions.createIndex( { "id": 1 }, { unique: true } )
+
+db.createCollection('webhook_deliveries');
+db.webhook_deliveries.createIndex( { "id": 1 }, { unique: true } )
+db.webhook_deliveries.createIndex( { "subscription_id": 1, "message_id": 1 }, { unique: true } )
+db.webhook_deliveries.createIndex( { "subscription_id": 1, "created_at": -1 } )
+db.webhook_deliveries.createIndex( { "status": 1, "next_attempt_at": 1 } )
+
+db.createCollection('api_keys');
+db.api_keys.createIndex( { "id": 1 }, { unique: true } )
+db.api_keys.createIndex( { "hash": 1 }, { unique: true } )
+
+db.createCollection('oauth_clients');
+db.oauth_clients.createIndex( { "id": 1 }, { unique: true } )
+
+db.createCollection('signing_keys');
+db.signing_keys.createIndex( { "id": 1 }, { unique: true } )
+db.signing_keys.createIndex( { "created_at": -1 } )
@@ -0,0 +1,37 @@
+// Migra um database criado antes do tax_id: copia o cpf para tax_id e calcula o initial_balance pelo saldo atual
+// menos o resultado das transferências. Pode ser executado mais de uma vez.
+db = db.getSiblingDB('bank');
+
+db.transfers.updateMany( { "status": { "$exists": false } }, { "$set": { "status": "completed" } } )
+
+db.accounts.find( { "tax_id": { "$exists": false }, "cpf": { "$exists": true } } ).forEach(function (account) {
+    db.accounts.updateOne(
+        { "_id": account._id },
+        { "$set": { "tax_id": account.cpf, "tax_id_type": "cpf" }, "$unset": { "cpf": "" } }
+    );
+});
+
+db.accounts.find( { "initial_balance": { "$exists": false } } ).forEach(function (account) {
+    var net = db.transfers.aggregate([
+        { "$match": { "$or": [ { "account_origin_id": account.id }, { "account_destination_id": account.id } ] } },
+        { "$group": {
+            "_id": null,
+            "net": { "$sum": {
+                "$cond": [ { "$eq": [ "$account_destination_id", account.id ] }, "$amount", { "$multiply": [ "$amount", -1 ] } ]
+            } }
+        } }
+    ]).toArray();
+
+    db.accounts.updateOne(
+        { "_id": account._id },
+        { "$set": { "initial_balance": account.balance - (net.length > 0 ? net[0].net : 0) } }
+    );
+});
+
+if (db.accounts.getIndexes().some(function (index) { return index.name === "cpf_1"; })) {
+    db.accounts.dropIndex("cpf_1");
+}
+
+db.accounts.createIndex( { "tax_id_type": 1, "tax_id": 1 }, { unique: true } )
+db.accounts.createIndex( { "created_at": 1, "id": 1 } )
+db.transfers.createIndex( { "status": 1, "created_at": 1, "id": 1 } )
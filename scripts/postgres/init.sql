@@ -5,13 +5,116 @@ CREATE TABLE transfers (
     account_origin_id VARCHAR NOT NULL,
     account_destination_id VARCHAR NOT NULL,
     amount BIGINT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'completed',
     created_at TIMESTAMP NOT NULL
 );
 
+CREATE INDEX transfers_created_at_id_idx ON transfers (created_at, id);
+CREATE INDEX transfers_amount_id_idx ON transfers (amount, id);
+CREATE INDEX transfers_status_created_at_id_idx ON transfers (status, created_at, id);
+CREATE INDEX transfers_account_origin_id_created_at_idx ON transfers (account_origin_id, created_at, id);
+CREATE INDEX transfers_account_destination_id_created_at_idx ON transfers (account_destination_id, created_at, id);
+
 CREATE TABLE accounts (
     id VARCHAR(36) PRIMARY KEY NOT NULL,
     name VARCHAR NOT NULL,
-    cpf VARCHAR UNIQUE NOT NULL,
+    tax_id VARCHAR NOT NULL,
+    tax_id_type VARCHAR(4) NOT NULL,
+    balance BIGINT NOT NULL,
+    initial_balance BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    UNIQUE (tax_id_type, tax_id)
+);
+
+CREATE INDEX accounts_created_at_id_idx ON accounts (created_at, id);
+
+CREATE TABLE balance_snapshots (
+    account_id VARCHAR(36) NOT NULL,
+    date DATE NOT NULL,
     balance BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (account_id, date)
+);
+
+CREATE TABLE balance_corrections (
+    id VARCHAR(36) PRIMARY KEY NOT NULL,
+    account_id VARCHAR(36) NOT NULL,
+    amount BIGINT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX balance_corrections_pending_account_id_idx ON balance_corrections (account_id) WHERE status = 'pending';
+
+CREATE TABLE outbox (
+    id VARCHAR(36) PRIMARY KEY NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    account_ids VARCHAR(36)[] NOT NULL,
+    payload JSONB NOT NULL,
+    position INTEGER NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    sent_at TIMESTAMP,
+    failed_at TIMESTAMP
+);
+
+CREATE INDEX outbox_pending_idx ON outbox (created_at, position) WHERE sent_at IS NULL AND failed_at IS NULL;
+CREATE INDEX outbox_sent_at_idx ON outbox (sent_at) WHERE sent_at IS NOT NULL;
+
+CREATE TABLE webhook_subscriptions (
+    id VARCHAR(36) PRIMARY KEY NOT NULL,
+    url VARCHAR NOT NULL,
+    secret VARCHAR NOT NULL,
+    event_types VARCHAR(64)[] NOT NULL,
+    account_id VARCHAR(36) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE webhook_deliveries (
+    id VARCHAR(36) PRIMARY KEY NOT NULL,
+    subscription_id VARCHAR(36) NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    message_id VARCHAR(36) NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    last_error VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    UNIQUE (subscription_id, message_id)
+);
+
+CREATE INDEX webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX webhook_deliveries_subscription_id_idx ON webhook_deliveries (subscription_id, created_at);
+
+CREATE TABLE api_keys (
+    id VARCHAR(36) PRIMARY KEY NOT NULL,
+    name VARCHAR NOT NULL,
+    prefix VARCHAR(16) NOT NULL,
+    hash VARCHAR(64) NOT NULL UNIQUE,
+    scopes VARCHAR(32)[] NOT NULL,
+    account_id VARCHAR(36) NOT NULL DEFAULT '',
+    expires_at TIMESTAMP,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
     created_at TIMESTAMP NOT NULL
-);
\ No newline at end of file
+);
+
+CREATE TABLE oauth_clients (
+    id VARCHAR(36) PRIMARY KEY NOT NULL,
+    name VARCHAR NOT NULL,
+    secret_hash VARCHAR(64) NOT NULL,
+    scopes VARCHAR(32)[] NOT NULL,
+    account_id VARCHAR(36) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE signing_keys (
+    id VARCHAR(36) PRIMARY KEY NOT NULL,
+    private_key BYTEA NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX signing_keys_created_at_idx ON signing_keys (created_at);
@@ -0,0 +1,45 @@
+-- Migra um database criado antes do tax_id: copia o cpf para tax_id e calcula o initial_balance pelo saldo atual
+-- menos o resultado das transferências. Pode ser executado mais de uma vez.
+
+BEGIN;
+
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'completed';
+
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS tax_id VARCHAR;
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS tax_id_type VARCHAR(4);
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS initial_balance BIGINT;
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns WHERE table_name = 'accounts' AND column_name = 'cpf'
+    ) THEN
+        UPDATE accounts SET tax_id = cpf, tax_id_type = 'cpf' WHERE tax_id IS NULL;
+        ALTER TABLE accounts DROP COLUMN cpf;
+    END IF;
+END $$;
+
+UPDATE accounts a
+SET initial_balance = a.balance - COALESCE((
+    SELECT SUM(CASE WHEN t.account_destination_id = a.id THEN t.amount ELSE -t.amount END)
+    FROM transfers t
+    WHERE t.account_origin_id = a.id OR t.account_destination_id = a.id
+), 0)
+WHERE a.initial_balance IS NULL;
+
+ALTER TABLE accounts ALTER COLUMN tax_id SET NOT NULL;
+ALTER TABLE accounts ALTER COLUMN tax_id_type SET NOT NULL;
+ALTER TABLE accounts ALTER COLUMN initial_balance SET NOT NULL;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_constraint WHERE conname = 'accounts_tax_id_type_tax_id_key'
+    ) THEN
+        ALTER TABLE accounts ADD CONSTRAINT accounts_tax_id_type_tax_id_key UNIQUE (tax_id_type, tax_id);
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS accounts_created_at_id_idx ON accounts (created_at, id);
+
+COMMIT;
@@ -11,28 +11,95 @@ import (
 type Account struct {
 	repo       domain.AccountRepository
 	presenter  AccountPresenter
+	publisher  EventPublisher
+	transactor domain.Transactor
 	ctxTimeout time.Duration
 }
 
 //NewAccount constrói um Account com suas dependências
-func NewAccount(repo domain.AccountRepository, presenter AccountPresenter, t time.Duration) Account {
-	return Account{repo: repo, presenter: presenter, ctxTimeout: t}
+func NewAccount(
+	repo domain.AccountRepository,
+	presenter AccountPresenter,
+	publisher EventPublisher,
+	transactor domain.Transactor,
+	t time.Duration,
+) Account {
+	return Account{
+		repo:       repo,
+		presenter:  presenter,
+		publisher:  publisher,
+		transactor: transactor,
+		ctxTimeout: t,
+	}
 }
 
-//Store cria uma nova Account
-func (a Account) Store(ctx context.Context, name, CPF string, balance domain.Money) (AccountOutput, error) {
+//Store cria uma nova Account e publica a sua criação na mesma transação
+func (a Account) Store(
+	ctx context.Context,
+	name string,
+	taxID string,
+	taxIDType domain.TaxIDType,
+	balance domain.Money,
+) (AccountOutput, error) {
 	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
 	defer cancel()
 
 	var account = domain.NewAccount(
 		domain.AccountID(domain.NewUUID()),
 		name,
-		CPF,
+		taxID,
+		taxIDType,
+		balance,
 		balance,
 		time.Now(),
 	)
 
-	account, err := a.repo.Store(ctx, account)
+	err := a.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		account, err = a.repo.Store(ctx, account)
+		if err != nil {
+			return err
+		}
+
+		return a.publisher.Publish(ctx, domain.NewAccountCreated(account))
+	})
+	if err != nil {
+		return a.presenter.Output(domain.Account{}), err
+	}
+
+	return a.presenter.Output(account), nil
+}
+
+//FindAll retorna uma página de Accounts ordenada por data de criação
+func (a Account) FindAll(ctx context.Context, pagination domain.Pagination) (AccountListOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	pagination = domain.NewPagination(pagination.After, pagination.Limit)
+
+	accounts, err := a.repo.FindAll(ctx, domain.NewPagination(pagination.After, pagination.Limit+1))
+	if err != nil {
+		return a.presenter.OutputList([]domain.Account{}, domain.Cursor{}), err
+	}
+
+	var next domain.Cursor
+	if len(accounts) > pagination.Limit {
+		accounts = accounts[:pagination.Limit]
+
+		var last = accounts[len(accounts)-1]
+		next = domain.NewCursor(last.CreatedAt(), last.ID().String())
+	}
+
+	return a.presenter.OutputList(accounts, next), nil
+}
+
+//FindByID retorna uma Account
+func (a Account) FindByID(ctx context.Context, ID domain.AccountID) (AccountOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	account, err := a.repo.FindByID(ctx, ID)
 	if err != nil {
 		return a.presenter.Output(domain.Account{}), err
 	}
@@ -40,17 +107,26 @@ func (a Account) Store(ctx context.Context, name, CPF string, balance domain.Mon
 	return a.presenter.Output(account), nil
 }
 
-//FindAll retorna uma lista de Accounts
-func (a Account) FindAll(ctx context.Context) ([]AccountOutput, error) {
+//FindByIDs retorna as Accounts dos identificadores informados em uma única busca, identificadores inexistentes são ignorados
+func (a Account) FindByIDs(ctx context.Context, IDs []domain.AccountID) ([]AccountOutput, error) {
 	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
 	defer cancel()
 
-	accounts, err := a.repo.FindAll(ctx)
+	var outputs = make([]AccountOutput, 0, len(IDs))
+	if len(IDs) == 0 {
+		return outputs, nil
+	}
+
+	accounts, err := a.repo.FindByIDs(ctx, IDs)
 	if err != nil {
-		return a.presenter.OutputList([]domain.Account{}), err
+		return outputs, err
+	}
+
+	for _, account := range accounts {
+		outputs = append(outputs, a.presenter.Output(account))
 	}
 
-	return a.presenter.OutputList(accounts), nil
+	return outputs, nil
 }
 
 //FindBalance retorna o saldo de uma Account
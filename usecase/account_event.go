@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+const (
+	//AccountEventTransferIncoming identifica uma Transfer recebida pela Account acompanhada
+	AccountEventTransferIncoming = "transfer.incoming"
+
+	//AccountEventTransferOutgoing identifica uma Transfer enviada pela Account acompanhada
+	AccountEventTransferOutgoing = "transfer.outgoing"
+
+	//accountEventOverlap é a janela relida a cada busca, cobrindo mensagens marcadas como enviadas no mesmo instante
+	//ou confirmadas após a busca anterior
+	accountEventOverlap = 5 * time.Second
+
+	//accountEventPageLimit é a quantidade máxima de mensagens lidas por busca
+	accountEventPageLimit = 100
+
+	//accountEventSubscriberBuffer é a quantidade de eventos aguardando envio antes do cliente ser desconectado
+	accountEventSubscriberBuffer = 64
+)
+
+type accountEventSubscriber struct {
+	accountID domain.AccountID
+	events    chan AccountEventOutput
+}
+
+//AccountEvent armazena as dependências e o estado do acompanhamento em tempo real dos eventos das Account
+//
+//Os eventos vêm das OutboxMessage já publicadas pelo relay do outbox, lidas periodicamente por Poll. As mais
+//recentes ficam em um buffer limitado, usado para retomar o acompanhamento a partir do último evento recebido
+type AccountEvent struct {
+	outboxRepo  domain.OutboxRepository
+	accountRepo domain.AccountRepository
+	bufferSize  int
+	ctxTimeout  time.Duration
+	now         func() time.Time
+
+	mu          sync.Mutex
+	cursor      time.Time
+	seen        map[domain.OutboxMessageID]time.Time
+	buffer      []domain.OutboxMessage
+	subscribers map[*accountEventSubscriber]struct{}
+}
+
+//NewAccountEvent constrói um AccountEvent com suas dependências, mantendo até bufferSize eventos para retomada
+func NewAccountEvent(
+	outboxRepo domain.OutboxRepository,
+	accountRepo domain.AccountRepository,
+	bufferSize int,
+	t time.Duration,
+) *AccountEvent {
+	return &AccountEvent{
+		outboxRepo:  outboxRepo,
+		accountRepo: accountRepo,
+		bufferSize:  bufferSize,
+		ctxTimeout:  t,
+		now:         time.Now,
+		cursor:      time.Now(),
+		seen:        make(map[domain.OutboxMessageID]time.Time),
+		subscribers: make(map[*accountEventSubscriber]struct{}),
+	}
+}
+
+//Subscribe inscreve um cliente nos eventos de uma Account até o fim do contexto
+//
+//Quando lastEventID é informado, os eventos da Account posteriores a ele ainda presentes no buffer são retornados
+//para reenvio
+func (a *AccountEvent) Subscribe(
+	ctx context.Context,
+	accountID domain.AccountID,
+	lastEventID string,
+) (AccountEventSubscription, error) {
+	if err := a.findAccount(ctx, accountID); err != nil {
+		return AccountEventSubscription{}, err
+	}
+
+	var subscriber = &accountEventSubscriber{
+		accountID: accountID,
+		events:    make(chan AccountEventOutput, accountEventSubscriberBuffer),
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var subscription = AccountEventSubscription{Replay: make([]AccountEventOutput, 0), Events: subscriber.events}
+	if lastEventID != "" {
+		subscription.Replay, subscription.Resync = a.replay(accountID, domain.OutboxMessageID(lastEventID))
+	}
+
+	a.subscribers[subscriber] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		a.unsubscribe(subscriber)
+	}()
+
+	return subscription, nil
+}
+
+//Poll lê as OutboxMessage publicadas desde a última leitura e as entrega às inscrições das Account envolvidas
+func (a *AccountEvent) Poll(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	a.mu.Lock()
+	var since = a.cursor.Add(-accountEventOverlap)
+	a.mu.Unlock()
+
+	for {
+		messages, err := a.outboxRepo.FindSentSince(ctx, since, accountEventPageLimit)
+		if err != nil {
+			return err
+		}
+
+		var fresh = a.dispatch(messages)
+		if len(messages) < accountEventPageLimit || fresh == 0 {
+			return nil
+		}
+
+		since = messages[len(messages)-1].SentAt()
+	}
+}
+
+func (a *AccountEvent) findAccount(ctx context.Context, accountID domain.AccountID) error {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	_, err := a.accountRepo.FindByID(ctx, accountID)
+	return err
+}
+
+//dispatch registra no buffer e entrega as mensagens ainda não vistas, retornando a quantidade entregue
+func (a *AccountEvent) dispatch(messages []domain.OutboxMessage) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var fresh int
+	for _, message := range messages {
+		if _, ok := a.seen[message.ID()]; ok {
+			continue
+		}
+
+		fresh++
+		a.seen[message.ID()] = message.SentAt()
+		if message.SentAt().After(a.cursor) {
+			a.cursor = message.SentAt()
+		}
+
+		if message.EventType() != domain.EventBalanceChanged && message.EventType() != domain.EventTransferCompleted {
+			continue
+		}
+
+		a.buffer = append(a.buffer, message)
+		if len(a.buffer) > a.bufferSize {
+			a.buffer = append(a.buffer[:0:0], a.buffer[len(a.buffer)-a.bufferSize:]...)
+		}
+
+		for subscriber := range a.subscribers {
+			output, ok := accountEventOutput(subscriber.accountID, message)
+			if !ok {
+				continue
+			}
+
+			select {
+			case subscriber.events <- output:
+			default:
+				//o cliente não acompanha os eventos e deve se reconectar informando o último evento recebido
+				a.unsubscribe(subscriber)
+			}
+		}
+	}
+
+	for ID, sentAt := range a.seen {
+		if sentAt.Before(a.cursor.Add(-accountEventOverlap)) {
+			delete(a.seen, ID)
+		}
+	}
+
+	return fresh
+}
+
+//replay retorna os eventos da Account posteriores a lastEventID, ou indica que lastEventID saiu do buffer
+func (a *AccountEvent) replay(accountID domain.AccountID, lastEventID domain.OutboxMessageID) ([]AccountEventOutput, bool) {
+	var outputs = make([]AccountEventOutput, 0)
+	for i, message := range a.buffer {
+		if message.ID() != lastEventID {
+			continue
+		}
+
+		for _, next := range a.buffer[i+1:] {
+			if output, ok := accountEventOutput(accountID, next); ok {
+				outputs = append(outputs, output)
+			}
+		}
+
+		return outputs, false
+	}
+
+	return outputs, true
+}
+
+func (a *AccountEvent) unsubscribe(subscriber *accountEventSubscriber) {
+	if _, ok := a.subscribers[subscriber]; !ok {
+		return
+	}
+
+	delete(a.subscribers, subscriber)
+	close(subscriber.events)
+}
+
+//accountEventOutput converte uma OutboxMessage envolvendo a Account, identificando a direção das Transfer
+func accountEventOutput(accountID domain.AccountID, message domain.OutboxMessage) (AccountEventOutput, bool) {
+	var accountIDs = message.AccountIDs()
+
+	switch message.EventType() {
+	case domain.EventBalanceChanged:
+		if len(accountIDs) == 0 || accountIDs[0] != accountID {
+			return AccountEventOutput{}, false
+		}
+
+		return AccountEventOutput{
+			ID:   message.ID().String(),
+			Type: message.EventType().String(),
+			Data: message.Payload(),
+		}, true
+	case domain.EventTransferCompleted:
+		//os AccountIDs de um TransferCompleted são a Account de origem seguida da Account de destino
+		var eventType string
+		switch {
+		case len(accountIDs) > 0 && accountIDs[0] == accountID:
+			eventType = AccountEventTransferOutgoing
+		case len(accountIDs) > 1 && accountIDs[1] == accountID:
+			eventType = AccountEventTransferIncoming
+		default:
+			return AccountEventOutput{}, false
+		}
+
+		return AccountEventOutput{ID: message.ID().String(), Type: eventType, Data: message.Payload()}, true
+	default:
+		return AccountEventOutput{}, false
+	}
+}
@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+type mockOutboxRepoSent struct {
+	domain.OutboxRepository
+
+	messages *[]domain.OutboxMessage
+}
+
+func (m mockOutboxRepoSent) FindSentSince(_ context.Context, since time.Time, limit int) ([]domain.OutboxMessage, error) {
+	var messages = make([]domain.OutboxMessage, 0)
+	for _, message := range *m.messages {
+		if !message.SentAt().Before(since) && len(messages) < limit {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages, nil
+}
+
+func newSentOutboxMessage(
+	ID domain.OutboxMessageID,
+	eventType domain.EventType,
+	sentAt time.Time,
+	accountIDs ...domain.AccountID,
+) domain.OutboxMessage {
+	return domain.NewOutboxMessage(ID, eventType, accountIDs, []byte(`{"id":"`+ID.String()+`"}`), 0, sentAt, sentAt)
+}
+
+func receiveAccountEvents(events <-chan AccountEventOutput) []AccountEventOutput {
+	var outputs []AccountEventOutput
+	for {
+		select {
+		case output, ok := <-events:
+			if !ok {
+				return outputs
+			}
+
+			outputs = append(outputs, output)
+		default:
+			return outputs
+		}
+	}
+}
+
+func TestAccountEvent_Poll(t *testing.T) {
+	t.Parallel()
+
+	var (
+		start    = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		messages = []domain.OutboxMessage{
+			newSentOutboxMessage("1", domain.EventBalanceChanged, start.Add(time.Second), "a"),
+			newSentOutboxMessage("2", domain.EventTransferCompleted, start.Add(time.Second), "a", "b"),
+			newSentOutboxMessage("3", domain.EventTransferCompleted, start.Add(2*time.Second), "b", "a"),
+			newSentOutboxMessage("4", domain.EventAccountCreated, start.Add(2*time.Second), "a"),
+			newSentOutboxMessage("5", domain.EventBalanceChanged, start.Add(2*time.Second), "b"),
+		}
+		sent = messages[:2]
+		uc   = NewAccountEvent(mockOutboxRepoSent{messages: &sent}, mockAccountRepoFindByID{}, 10, time.Second)
+	)
+	uc.cursor = start
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subscription, err := uc.Subscribe(ctx, "a", "")
+	if err != nil {
+		t.Fatalf("[TestCase 'Subscribe'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	if err := uc.Poll(context.Background()); err != nil {
+		t.Fatalf("[TestCase 'Poll'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	//a segunda leitura relê a janela de sobreposição e entrega apenas as mensagens ainda não vistas
+	sent = messages
+	if err := uc.Poll(context.Background()); err != nil {
+		t.Fatalf("[TestCase 'Poll'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	var (
+		result   = receiveAccountEvents(subscription.Events)
+		expected = []AccountEventOutput{
+			{ID: "1", Type: "balance.changed", Data: []byte(`{"id":"1"}`)},
+			{ID: "2", Type: AccountEventTransferOutgoing, Data: []byte(`{"id":"2"}`)},
+			{ID: "3", Type: AccountEventTransferIncoming, Data: []byte(`{"id":"3"}`)},
+		}
+	)
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("[TestCase 'Poll'] Result: '%v' | Expected: '%v'", result, expected)
+	}
+}
+
+func TestAccountEvent_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	var (
+		start    = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		messages = []domain.OutboxMessage{
+			newSentOutboxMessage("1", domain.EventBalanceChanged, start.Add(time.Second), "a"),
+			newSentOutboxMessage("2", domain.EventBalanceChanged, start.Add(time.Second), "b"),
+			newSentOutboxMessage("3", domain.EventTransferCompleted, start.Add(time.Second), "a", "b"),
+			newSentOutboxMessage("4", domain.EventBalanceChanged, start.Add(time.Second), "a"),
+		}
+	)
+
+	tests := []struct {
+		name           string
+		lastEventID    string
+		repository     domain.AccountRepository
+		expectedReplay []string
+		expectedResync bool
+		expectedError  error
+	}{
+		{
+			name:           "Subscribe without last event",
+			repository:     mockAccountRepoFindByID{},
+			expectedReplay: []string{},
+		},
+		{
+			name:           "Subscribe resuming from the buffer",
+			lastEventID:    "2",
+			repository:     mockAccountRepoFindByID{},
+			expectedReplay: []string{"3", "4"},
+		},
+		{
+			name:           "Subscribe resuming from an event evicted from the buffer",
+			lastEventID:    "1",
+			repository:     mockAccountRepoFindByID{},
+			expectedReplay: []string{},
+			expectedResync: true,
+		},
+		{
+			name:          "Subscribe account not found",
+			repository:    mockAccountRepoFindByID{err: domain.ErrNotFound},
+			expectedError: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var uc = NewAccountEvent(mockOutboxRepoSent{messages: &messages}, tt.repository, 3, time.Second)
+			uc.cursor = start
+
+			if err := uc.Poll(context.Background()); err != nil {
+				t.Fatalf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, nil)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			subscription, err := uc.Subscribe(ctx, "a", tt.lastEventID)
+			if err != tt.expectedError {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if err != nil {
+				return
+			}
+
+			var replay = make([]string, 0)
+			for _, output := range subscription.Replay {
+				replay = append(replay, output.ID)
+			}
+
+			if !reflect.DeepEqual(replay, tt.expectedReplay) || subscription.Resync != tt.expectedResync {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' '%v' | Expected: '%v' '%v'",
+					tt.name,
+					replay,
+					subscription.Resync,
+					tt.expectedReplay,
+					tt.expectedResync,
+				)
+			}
+
+			cancel()
+			if _, ok := <-subscription.Events; ok {
+				t.Errorf("[TestCase '%s'] Expected events to be closed after the context ends", tt.name)
+			}
+		})
+	}
+}
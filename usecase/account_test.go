@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/event"
 	"reflect"
 	"testing"
 	"time"
@@ -30,34 +31,54 @@ func (m mockAccountPresenterStore) Output(_ domain.Account) AccountOutput {
 	return m.result
 }
 
+type mockTransactor struct{}
+
+func (m mockTransactor) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type mockEventPublisherErr struct {
+	err error
+}
+
+func (m mockEventPublisherErr) Publish(_ context.Context, _ ...domain.Event) error {
+	return m.err
+}
+
 func TestAccount_Store(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		name, CPF string
-		balance   domain.Money
+		name, taxID string
+		taxIDType   domain.TaxIDType
+		balance     domain.Money
 	}
 
 	tests := []struct {
-		name          string
-		args          args
-		repository    domain.AccountRepository
-		presenter     AccountPresenter
-		expected      AccountOutput
-		expectedError interface{}
+		name           string
+		args           args
+		repository     domain.AccountRepository
+		presenter      AccountPresenter
+		expected       AccountOutput
+		publishErr     error
+		expectedEvents []domain.EventType
+		expectedError  interface{}
 	}{
 		{
 			name: "Create account successful",
 			args: args{
-				name:    "Test",
-				CPF:     "02815517078",
-				balance: 19944,
+				name:      "Test",
+				taxID:     "02815517078",
+				taxIDType: domain.TaxIDTypeCPF,
+				balance:   19944,
 			},
 			repository: mockAccountRepoStore{
 				result: domain.NewAccount(
 					"3c096a40-ccba-4b58-93ed-57379ab04680",
 					"Test",
 					"02815517078",
+					domain.TaxIDTypeCPF,
+					19944,
 					19944,
 					time.Time{},
 				),
@@ -67,7 +88,8 @@ func TestAccount_Store(t *testing.T) {
 				result: AccountOutput{
 					ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
 					Name:      "Test",
-					CPF:       "02815517078",
+					TaxID:     "02815517078",
+					TaxIDType: "cpf",
 					Balance:   199.44,
 					CreatedAt: time.Time{},
 				},
@@ -75,23 +97,28 @@ func TestAccount_Store(t *testing.T) {
 			expected: AccountOutput{
 				ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
 				Name:      "Test",
-				CPF:       "02815517078",
+				TaxID:     "02815517078",
+				TaxIDType: "cpf",
 				Balance:   199.44,
 				CreatedAt: time.Time{},
 			},
+			expectedEvents: []domain.EventType{domain.EventAccountCreated},
 		},
 		{
 			name: "Create account successful",
 			args: args{
-				name:    "Test",
-				CPF:     "02815517078",
-				balance: 2350,
+				name:      "Test",
+				taxID:     "02815517078",
+				taxIDType: domain.TaxIDTypeCPF,
+				balance:   2350,
 			},
 			repository: mockAccountRepoStore{
 				result: domain.NewAccount(
 					"3c096a40-ccba-4b58-93ed-57379ab04680",
 					"Test",
 					"02815517078",
+					domain.TaxIDTypeCPF,
+					2350,
 					2350,
 					time.Time{},
 				),
@@ -101,7 +128,8 @@ func TestAccount_Store(t *testing.T) {
 				result: AccountOutput{
 					ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
 					Name:      "Test",
-					CPF:       "02815517078",
+					TaxID:     "02815517078",
+					TaxIDType: "cpf",
 					Balance:   23.5,
 					CreatedAt: time.Time{},
 				},
@@ -109,17 +137,20 @@ func TestAccount_Store(t *testing.T) {
 			expected: AccountOutput{
 				ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
 				Name:      "Test",
-				CPF:       "02815517078",
+				TaxID:     "02815517078",
+				TaxIDType: "cpf",
 				Balance:   23.5,
 				CreatedAt: time.Time{},
 			},
+			expectedEvents: []domain.EventType{domain.EventAccountCreated},
 		},
 		{
 			name: "Create account generic error",
 			args: args{
-				name:    "",
-				CPF:     "",
-				balance: 0,
+				name:      "",
+				taxID:     "",
+				taxIDType: "",
+				balance:   0,
 			},
 			repository: mockAccountRepoStore{
 				result: domain.Account{},
@@ -128,16 +159,59 @@ func TestAccount_Store(t *testing.T) {
 			presenter: mockAccountPresenterStore{
 				result: AccountOutput{},
 			},
-			expectedError: "error",
-			expected:      AccountOutput{},
+			expectedError:  "error",
+			expected:       AccountOutput{},
+			expectedEvents: []domain.EventType{},
+		},
+		{
+			name: "Create account error publishing event",
+			args: args{
+				name:      "Test",
+				taxID:     "02815517078",
+				taxIDType: domain.TaxIDTypeCPF,
+				balance:   19944,
+			},
+			repository: mockAccountRepoStore{
+				result: domain.NewAccount(
+					"3c096a40-ccba-4b58-93ed-57379ab04680",
+					"Test",
+					"02815517078",
+					domain.TaxIDTypeCPF,
+					19944,
+					19944,
+					time.Time{},
+				),
+			},
+			presenter: mockAccountPresenterStore{
+				result: AccountOutput{},
+			},
+			publishErr:     errors.New("error storing outbox message"),
+			expectedError:  "error storing outbox message",
+			expected:       AccountOutput{},
+			expectedEvents: []domain.EventType{},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var uc = NewAccount(tt.repository, tt.presenter, time.Second)
+			var (
+				recorder                 = event.NewRecorder()
+				publisher EventPublisher = recorder
+			)
+
+			if tt.publishErr != nil {
+				publisher = mockEventPublisherErr{err: tt.publishErr}
+			}
+
+			var uc = NewAccount(tt.repository, tt.presenter, publisher, mockTransactor{}, time.Second)
 
-			result, err := uc.Store(context.TODO(), tt.args.name, tt.args.CPF, tt.args.balance)
+			result, err := uc.Store(
+				context.TODO(),
+				tt.args.name,
+				tt.args.taxID,
+				tt.args.taxIDType,
+				tt.args.balance,
+			)
 			if (err != nil) && (err.Error() != tt.expectedError) {
 				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
 			}
@@ -145,6 +219,10 @@ func TestAccount_Store(t *testing.T) {
 			if !reflect.DeepEqual(result, tt.expected) {
 				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
 			}
+
+			if !reflect.DeepEqual(recorder.Types(), tt.expectedEvents) {
+				t.Errorf("[TestCase '%s'] Events: '%v' | Expected: '%v'", tt.name, recorder.Types(), tt.expectedEvents)
+			}
 		})
 	}
 }
@@ -156,116 +234,144 @@ type mockAccountRepoFindAll struct {
 	err    error
 }
 
-func (m mockAccountRepoFindAll) FindAll(_ context.Context) ([]domain.Account, error) {
+func (m mockAccountRepoFindAll) FindAll(_ context.Context, pagination domain.Pagination) ([]domain.Account, error) {
+	if len(m.result) > pagination.Limit {
+		return m.result[:pagination.Limit], m.err
+	}
+
 	return m.result, m.err
 }
 
 type mockAccountPresenterFindAll struct {
 	AccountPresenter
-
-	result []AccountOutput
 }
 
-func (m mockAccountPresenterFindAll) OutputList(_ []domain.Account) []AccountOutput {
-	return m.result
+func (m mockAccountPresenterFindAll) OutputList(accounts []domain.Account, next domain.Cursor) AccountListOutput {
+	var output = make([]AccountOutput, 0)
+
+	for _, account := range accounts {
+		output = append(output, AccountOutput{
+			ID:        account.ID().String(),
+			Name:      account.Name(),
+			TaxID:     account.TaxID(),
+			TaxIDType: account.TaxIDType().String(),
+			Balance:   account.Balance().Float64(),
+			CreatedAt: account.CreatedAt(),
+		})
+	}
+
+	return AccountListOutput{Data: output, NextCursor: next.Encode()}
 }
 
 func TestAccount_FindAll(t *testing.T) {
 	t.Parallel()
 
+	var (
+		createdAt = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		accounts  = []domain.Account{
+			domain.NewAccount(
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				"Test",
+				"02815517078",
+				domain.TaxIDTypeCPF,
+				125,
+				125,
+				createdAt,
+			),
+			domain.NewAccount(
+				"3c096a40-ccba-4b58-93ed-57379ab04681",
+				"Test",
+				"02815517071",
+				domain.TaxIDTypeCPF,
+				99999,
+				99999,
+				createdAt,
+			),
+		}
+	)
+
 	tests := []struct {
 		name          string
+		pagination    domain.Pagination
 		repository    domain.AccountRepository
-		presenter     AccountPresenter
-		expected      []AccountOutput
+		expected      AccountListOutput
 		expectedError interface{}
 	}{
 		{
-			name: "Success when returning the account list",
+			name:       "Success when returning the account list",
+			pagination: domain.NewPagination(domain.Cursor{}, 0),
 			repository: mockAccountRepoFindAll{
-				result: []domain.Account{
-					domain.NewAccount(
-						"3c096a40-ccba-4b58-93ed-57379ab04680",
-						"Test",
-						"02815517078",
-						125,
-						time.Time{},
-					),
-					domain.NewAccount(
-						"3c096a40-ccba-4b58-93ed-57379ab04681",
-						"Test",
-						"02815517071",
-						99999,
-						time.Time{},
-					),
-				},
-				err: nil,
+				result: accounts,
+				err:    nil,
 			},
-			presenter: mockAccountPresenterFindAll{
-				result: []AccountOutput{
+			expected: AccountListOutput{
+				Data: []AccountOutput{
 					{
 						ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
 						Name:      "Test",
-						CPF:       "02815517078",
+						TaxID:     "02815517078",
+						TaxIDType: "cpf",
 						Balance:   1.25,
-						CreatedAt: time.Time{},
+						CreatedAt: createdAt,
 					},
 					{
 						ID:        "3c096a40-ccba-4b58-93ed-57379ab04681",
 						Name:      "Test",
-						CPF:       "02815517071",
+						TaxID:     "02815517071",
+						TaxIDType: "cpf",
 						Balance:   999.99,
-						CreatedAt: time.Time{},
+						CreatedAt: createdAt,
 					},
 				},
 			},
-			expected: []AccountOutput{
-				{
-					ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
-					Name:      "Test",
-					CPF:       "02815517078",
-					Balance:   1.25,
-					CreatedAt: time.Time{},
-				},
-				{
-					ID:        "3c096a40-ccba-4b58-93ed-57379ab04681",
-					Name:      "Test",
-					CPF:       "02815517071",
-					Balance:   999.99,
-					CreatedAt: time.Time{},
+		},
+		{
+			name:       "Success when returning a page with next cursor",
+			pagination: domain.NewPagination(domain.Cursor{}, 1),
+			repository: mockAccountRepoFindAll{
+				result: accounts,
+				err:    nil,
+			},
+			expected: AccountListOutput{
+				Data: []AccountOutput{
+					{
+						ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+						Name:      "Test",
+						TaxID:     "02815517078",
+						TaxIDType: "cpf",
+						Balance:   1.25,
+						CreatedAt: createdAt,
+					},
 				},
+				NextCursor: domain.NewCursor(createdAt, "3c096a40-ccba-4b58-93ed-57379ab04680").Encode(),
 			},
 		},
 		{
-			name: "Success when returning the empty account list",
+			name:       "Success when returning the empty account list",
+			pagination: domain.NewPagination(domain.Cursor{}, 0),
 			repository: mockAccountRepoFindAll{
 				result: []domain.Account{},
 				err:    nil,
 			},
-			presenter: mockAccountPresenterFindAll{
-				result: []AccountOutput{},
-			},
-			expected: []AccountOutput{},
+			expected: AccountListOutput{Data: []AccountOutput{}},
 		},
 		{
-			name: "Error when returning the list of accounts",
+			name:       "Error when returning the list of accounts",
+			pagination: domain.NewPagination(domain.Cursor{}, 0),
 			repository: mockAccountRepoFindAll{
 				result: []domain.Account{},
 				err:    errors.New("error"),
 			},
-			presenter: mockAccountPresenterFindAll{
-				result: []AccountOutput{},
-			},
 			expectedError: "error",
-			expected:      []AccountOutput{},
+			expected:      AccountListOutput{Data: []AccountOutput{}},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var uc = NewAccount(tt.repository, tt.presenter, time.Second)
+			var uc = NewAccount(tt.repository, mockAccountPresenterFindAll{}, event.NewRecorder(), mockTransactor{}, time.Second)
 
-			result, err := uc.FindAll(context.Background())
+			result, err := uc.FindAll(context.Background(), tt.pagination)
 			if (err != nil) && (err.Error() != tt.expectedError) {
 				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
 			}
@@ -277,6 +383,196 @@ func TestAccount_FindAll(t *testing.T) {
 	}
 }
 
+type mockAccountRepoFindByID struct {
+	domain.AccountRepository
+
+	result domain.Account
+	err    error
+}
+
+func (m mockAccountRepoFindByID) FindByID(_ context.Context, _ domain.AccountID) (domain.Account, error) {
+	return m.result, m.err
+}
+
+func TestAccount_FindByID(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ID domain.AccountID
+	}
+
+	tests := []struct {
+		name          string
+		args          args
+		repository    domain.AccountRepository
+		presenter     AccountPresenter
+		expected      AccountOutput
+		expectedError interface{}
+	}{
+		{
+			name: "Success when returning the account",
+			args: args{
+				ID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+			},
+			repository: mockAccountRepoFindByID{
+				result: domain.NewAccount(
+					"3c096a40-ccba-4b58-93ed-57379ab04680",
+					"Test",
+					"02815517078",
+					domain.TaxIDTypeCPF,
+					125,
+					125,
+					time.Time{},
+				),
+				err: nil,
+			},
+			presenter: mockAccountPresenterStore{
+				result: AccountOutput{
+					ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+					Name:      "Test",
+					TaxID:     "02815517078",
+					TaxIDType: "cpf",
+					Balance:   1.25,
+					CreatedAt: time.Time{},
+				},
+			},
+			expected: AccountOutput{
+				ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+				Name:      "Test",
+				TaxID:     "02815517078",
+				TaxIDType: "cpf",
+				Balance:   1.25,
+				CreatedAt: time.Time{},
+			},
+		},
+		{
+			name: "Error returning account",
+			args: args{
+				ID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+			},
+			repository: mockAccountRepoFindByID{
+				result: domain.Account{},
+				err:    domain.ErrNotFound,
+			},
+			presenter: mockAccountPresenterStore{
+				result: AccountOutput{},
+			},
+			expectedError: "not found",
+			expected:      AccountOutput{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var uc = NewAccount(tt.repository, tt.presenter, event.NewRecorder(), mockTransactor{}, time.Second)
+
+			result, err := uc.FindByID(context.Background(), tt.args.ID)
+			if (err != nil) && (err.Error() != tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
+
+type mockAccountRepoFindByIDs struct {
+	domain.AccountRepository
+
+	calls *[][]domain.AccountID
+	err   error
+}
+
+func (m mockAccountRepoFindByIDs) FindByIDs(_ context.Context, IDs []domain.AccountID) ([]domain.Account, error) {
+	*m.calls = append(*m.calls, IDs)
+
+	var accounts []domain.Account
+	for _, ID := range IDs {
+		if ID == "a5cb1ba2-d5ba-4d1b-8a5a-37ab5c2d2fa5" {
+			continue
+		}
+
+		accounts = append(accounts, domain.NewAccount(ID, "Test", "02815517078", domain.TaxIDTypeCPF, 125, 125, time.Time{}))
+	}
+
+	return accounts, m.err
+}
+
+func TestAccount_FindByIDs(t *testing.T) {
+	t.Parallel()
+
+	var output = AccountOutput{
+		ID:        "3c096a40-ccba-4b58-93ed-57379ab04680",
+		Name:      "Test",
+		TaxID:     "02815517078",
+		TaxIDType: "cpf",
+		Balance:   1.25,
+	}
+
+	tests := []struct {
+		name          string
+		IDs           []domain.AccountID
+		err           error
+		expected      []AccountOutput
+		expectedCalls int
+		expectedError interface{}
+	}{
+		{
+			name: "Success ignoring accounts not found",
+			IDs: []domain.AccountID{
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				"a5cb1ba2-d5ba-4d1b-8a5a-37ab5c2d2fa5",
+			},
+			expected:      []AccountOutput{output},
+			expectedCalls: 1,
+		},
+		{
+			name:          "Success without ids does not query the repository",
+			IDs:           []domain.AccountID{},
+			expected:      []AccountOutput{},
+			expectedCalls: 0,
+		},
+		{
+			name:          "Error returning accounts",
+			IDs:           []domain.AccountID{"3c096a40-ccba-4b58-93ed-57379ab04680"},
+			err:           errors.New("db error"),
+			expected:      []AccountOutput{},
+			expectedCalls: 1,
+			expectedError: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				calls [][]domain.AccountID
+				uc    = NewAccount(
+					mockAccountRepoFindByIDs{calls: &calls, err: tt.err},
+					mockAccountPresenterStore{result: output},
+					event.NewRecorder(),
+					mockTransactor{},
+					time.Second,
+				)
+			)
+
+			result, err := uc.FindByIDs(context.Background(), tt.IDs)
+			if (err != nil) && (err.Error() != tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+
+			if len(calls) != tt.expectedCalls {
+				t.Errorf("[TestCase '%s'] Calls: '%v' | Expected: '%v'", tt.name, len(calls), tt.expectedCalls)
+			}
+		})
+	}
+}
+
 type mockAccountRepoFindBalance struct {
 	domain.AccountRepository
 
@@ -359,7 +655,7 @@ func TestAccount_FindBalance(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		var uc = NewAccount(tt.repository, tt.presenter, time.Second)
+		var uc = NewAccount(tt.repository, tt.presenter, event.NewRecorder(), mockTransactor{}, time.Second)
 
 		result, err := uc.FindBalance(context.Background(), tt.args.ID)
 		if (err != nil) && (err.Error() != tt.expectedError) {
@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+const (
+	//APIKeyPrefix identifica as chaves geradas para as APIKey, diferenciando-as dos demais tokens de acesso
+	APIKeyPrefix = "gbk_"
+
+	//apiKeyPrefixLength é a quantidade de caracteres da chave armazenados para identificá-la nas listagens
+	apiKeyPrefixLength = len(APIKeyPrefix) + 8
+
+	//apiKeyLastUsedInterval limita a atualização da data de último uso a uma escrita por intervalo
+	apiKeyLastUsedInterval = time.Minute
+)
+
+//APIKey armazena as dependências para os casos de uso de APIKey
+type APIKey struct {
+	repo       domain.APIKeyRepository
+	presenter  APIKeyPresenter
+	ctxTimeout time.Duration
+	now        func() time.Time
+}
+
+//NewAPIKey constrói um APIKey com suas dependências
+func NewAPIKey(repo domain.APIKeyRepository, presenter APIKeyPresenter, t time.Duration) APIKey {
+	return APIKey{
+		repo:       repo,
+		presenter:  presenter,
+		ctxTimeout: t,
+		now:        time.Now,
+	}
+}
+
+//Store cria uma nova APIKey, que expira em expiresAt quando informado. A chave é retornada apenas na criação
+func (a APIKey) Store(
+	ctx context.Context,
+	name string,
+	scopes []domain.Scope,
+	accountID domain.AccountID,
+	expiresAt time.Time,
+) (APIKeyOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	return a.store(ctx, name, scopes, accountID, expiresAt)
+}
+
+//Rotate cria uma nova APIKey com o nome, os escopos, a Account e a validade da atual, que continua aceita durante
+//overlap para que os clientes troquem de chave sem indisponibilidade
+func (a APIKey) Rotate(ctx context.Context, ID domain.APIKeyID, overlap time.Duration) (APIKeyOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	current, err := a.repo.FindByID(ctx, ID)
+	if err != nil {
+		return a.presenter.Output(domain.APIKey{}), err
+	}
+
+	var now = a.now()
+	if !current.IsActive(now) {
+		return a.presenter.Output(domain.APIKey{}), domain.ErrAPIKeyInactive
+	}
+
+	var expiresAt time.Time
+	if lifetime := current.Lifetime(); lifetime > 0 {
+		expiresAt = now.Add(lifetime)
+	}
+
+	output, err := a.store(ctx, current.Name(), current.Scopes(), current.AccountID(), expiresAt)
+	if err != nil {
+		return a.presenter.Output(domain.APIKey{}), err
+	}
+
+	current.ExpireAfter(now, overlap)
+	if err := a.repo.Update(ctx, current); err != nil {
+		return a.presenter.Output(domain.APIKey{}), err
+	}
+
+	return output, nil
+}
+
+//Revoke revoga uma APIKey, que deixa de ser aceita imediatamente
+func (a APIKey) Revoke(ctx context.Context, ID domain.APIKeyID) error {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	key, err := a.repo.FindByID(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	key.Revoke(a.now())
+
+	return a.repo.Update(ctx, key)
+}
+
+//FindAll retorna todas as APIKey, sem as chaves
+func (a APIKey) FindAll(ctx context.Context) ([]APIKeyOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	keys, err := a.repo.FindAll(ctx)
+	if err != nil {
+		return a.presenter.OutputList([]domain.APIKey{}), err
+	}
+
+	return a.presenter.OutputList(keys), nil
+}
+
+//Authenticate retorna a APIKey ativa correspondente à chave, registrando o seu uso
+//
+//Retorna domain.ErrNotFound para chaves desconhecidas e domain.ErrAPIKeyInactive para chaves revogadas ou expiradas
+func (a APIKey) Authenticate(ctx context.Context, key string) (APIKeyOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	apiKey, err := a.repo.FindByHash(ctx, hashAPIKey(key))
+	if err != nil {
+		return a.presenter.Output(domain.APIKey{}), err
+	}
+
+	var now = a.now()
+	if !apiKey.IsActive(now) {
+		return a.presenter.Output(domain.APIKey{}), domain.ErrAPIKeyInactive
+	}
+
+	if now.Sub(apiKey.LastUsedAt()) >= apiKeyLastUsedInterval {
+		if err := a.repo.UpdateLastUsedAt(ctx, apiKey.ID(), now); err != nil {
+			return a.presenter.Output(domain.APIKey{}), err
+		}
+	}
+
+	return a.presenter.Output(apiKey), nil
+}
+
+func (a APIKey) store(
+	ctx context.Context,
+	name string,
+	scopes []domain.Scope,
+	accountID domain.AccountID,
+	expiresAt time.Time,
+) (APIKeyOutput, error) {
+	key, err := newAPIKey()
+	if err != nil {
+		return a.presenter.Output(domain.APIKey{}), err
+	}
+
+	apiKey, err := a.repo.Store(ctx, domain.NewAPIKey(
+		domain.APIKeyID(domain.NewUUID()),
+		name,
+		key[:apiKeyPrefixLength],
+		hashAPIKey(key),
+		scopes,
+		accountID,
+		expiresAt,
+		time.Time{},
+		time.Time{},
+		a.now(),
+	))
+	if err != nil {
+		return a.presenter.Output(domain.APIKey{}), err
+	}
+
+	var output = a.presenter.Output(apiKey)
+	output.Key = key
+
+	return output, nil
+}
+
+func newAPIKey() (string, error) {
+	var key = make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	return APIKeyPrefix + base64.RawURLEncoding.EncodeToString(key), nil
+}
+
+//hashAPIKey retorna o SHA-256 da chave, suficiente para chaves aleatórias de 256 bits e que permite buscá-las pelo hash
+func hashAPIKey(key string) string {
+	var sum = sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
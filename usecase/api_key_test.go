@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+type mockAPIKeyRepo struct {
+	domain.APIKeyRepository
+
+	keys     map[domain.APIKeyID]domain.APIKey
+	lastUsed *[]domain.APIKeyID
+}
+
+func newMockAPIKeyRepo(keys ...domain.APIKey) mockAPIKeyRepo {
+	var repo = mockAPIKeyRepo{keys: map[domain.APIKeyID]domain.APIKey{}, lastUsed: &[]domain.APIKeyID{}}
+	for _, key := range keys {
+		repo.keys[key.ID()] = key
+	}
+
+	return repo
+}
+
+func (m mockAPIKeyRepo) Store(_ context.Context, key domain.APIKey) (domain.APIKey, error) {
+	m.keys[key.ID()] = key
+	return key, nil
+}
+
+func (m mockAPIKeyRepo) Update(_ context.Context, key domain.APIKey) error {
+	m.keys[key.ID()] = key
+	return nil
+}
+
+func (m mockAPIKeyRepo) UpdateLastUsedAt(_ context.Context, ID domain.APIKeyID, _ time.Time) error {
+	*m.lastUsed = append(*m.lastUsed, ID)
+	return nil
+}
+
+func (m mockAPIKeyRepo) FindByID(_ context.Context, ID domain.APIKeyID) (domain.APIKey, error) {
+	key, ok := m.keys[ID]
+	if !ok {
+		return domain.APIKey{}, domain.ErrNotFound
+	}
+
+	return key, nil
+}
+
+func (m mockAPIKeyRepo) FindByHash(_ context.Context, hash string) (domain.APIKey, error) {
+	for _, key := range m.keys {
+		if key.Hash() == hash {
+			return key, nil
+		}
+	}
+
+	return domain.APIKey{}, domain.ErrNotFound
+}
+
+type mockAPIKeyPresenter struct {
+	APIKeyPresenter
+}
+
+func (m mockAPIKeyPresenter) Output(key domain.APIKey) APIKeyOutput {
+	return APIKeyOutput{ID: key.ID().String(), Name: key.Name(), Prefix: key.Prefix(), AccountID: key.AccountID().String()}
+}
+
+func newAPIKeyFixture(
+	ID domain.APIKeyID,
+	key string,
+	expiresAt time.Time,
+	lastUsedAt time.Time,
+	revokedAt time.Time,
+	createdAt time.Time,
+) domain.APIKey {
+	return domain.NewAPIKey(
+		ID,
+		"batch",
+		key[:apiKeyPrefixLength],
+		hashAPIKey(key),
+		[]domain.Scope{domain.ScopeTransfersWrite},
+		"3c096a40-ccba-4b58-93ed-57379ab04680",
+		expiresAt,
+		lastUsedAt,
+		revokedAt,
+		createdAt,
+	)
+}
+
+func TestAPIKey_Store(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repo = newMockAPIKeyRepo()
+		uc   = NewAPIKey(repo, mockAPIKeyPresenter{}, time.Second)
+	)
+
+	result, err := uc.Store(
+		context.Background(),
+		"batch",
+		[]domain.Scope{domain.ScopeTransfersWrite},
+		"3c096a40-ccba-4b58-93ed-57379ab04680",
+		time.Time{},
+	)
+	if err != nil {
+		t.Fatalf("[TestCase 'Store'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	if !strings.HasPrefix(result.Key, APIKeyPrefix) || !strings.HasPrefix(result.Key, result.Prefix) {
+		t.Errorf("[TestCase 'Store'] Result: '%v' | Expected a generated key", result)
+	}
+
+	var stored = repo.keys[domain.APIKeyID(result.ID)]
+	if stored.Hash() != hashAPIKey(result.Key) || strings.Contains(stored.Hash(), result.Key) {
+		t.Errorf("[TestCase 'Store'] Result: '%v' | Expected only the hash of the key", stored.Hash())
+	}
+}
+
+func TestAPIKey_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now    = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		active = APIKeyPrefix + "active-key"
+	)
+
+	var repo = newMockAPIKeyRepo(
+		newAPIKeyFixture("1", active, time.Time{}, now.Add(-time.Hour), time.Time{}, now),
+		newAPIKeyFixture("2", APIKeyPrefix+"recent-key", time.Time{}, now.Add(-time.Second), time.Time{}, now),
+		newAPIKeyFixture("3", APIKeyPrefix+"expired-key", now, time.Time{}, time.Time{}, now.Add(-time.Hour)),
+		newAPIKeyFixture("4", APIKeyPrefix+"revoked-key", time.Time{}, time.Time{}, now.Add(-time.Hour), now),
+	)
+
+	tests := []struct {
+		name             string
+		key              string
+		expectedID       string
+		expectedLastUsed bool
+		expectedError    error
+	}{
+		{
+			name:             "Active key",
+			key:              active,
+			expectedID:       "1",
+			expectedLastUsed: true,
+		},
+		{
+			name:       "Key used within the last used interval",
+			key:        APIKeyPrefix + "recent-key",
+			expectedID: "2",
+		},
+		{
+			name:          "Expired key",
+			key:           APIKeyPrefix + "expired-key",
+			expectedError: domain.ErrAPIKeyInactive,
+		},
+		{
+			name:          "Revoked key",
+			key:           APIKeyPrefix + "revoked-key",
+			expectedError: domain.ErrAPIKeyInactive,
+		},
+		{
+			name:          "Unknown key",
+			key:           APIKeyPrefix + "unknown-key",
+			expectedError: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		*repo.lastUsed = nil
+
+		var uc = NewAPIKey(repo, mockAPIKeyPresenter{}, time.Second)
+		uc.now = func() time.Time { return now }
+
+		result, err := uc.Authenticate(context.Background(), tt.key)
+		if !errors.Is(err, tt.expectedError) {
+			t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+		}
+
+		if result.ID != tt.expectedID {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.ID, tt.expectedID)
+		}
+
+		if lastUsed := len(*repo.lastUsed) == 1; lastUsed != tt.expectedLastUsed {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, lastUsed, tt.expectedLastUsed)
+		}
+	}
+}
+
+func TestAPIKey_Rotate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now     = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		created = now.Add(-24 * time.Hour)
+		current = newAPIKeyFixture("1", APIKeyPrefix+"current-key", created.Add(90*24*time.Hour), time.Time{}, time.Time{}, created)
+		revoked = newAPIKeyFixture("2", APIKeyPrefix+"revoked-key", time.Time{}, time.Time{}, now, created)
+		repo    = newMockAPIKeyRepo(current, revoked)
+		uc      = NewAPIKey(repo, mockAPIKeyPresenter{}, time.Second)
+	)
+	uc.now = func() time.Time { return now }
+
+	result, err := uc.Rotate(context.Background(), "1", time.Hour)
+	if err != nil {
+		t.Fatalf("[TestCase 'Rotate'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	var rotated = repo.keys[domain.APIKeyID(result.ID)]
+	if rotated.Name() != current.Name() ||
+		rotated.AccountID() != current.AccountID() ||
+		!rotated.ExpiresAt().Equal(now.Add(90*24*time.Hour)) ||
+		rotated.Hash() != hashAPIKey(result.Key) {
+		t.Errorf("[TestCase 'Rotate'] Result: '%v' | Expected a copy of the current key", rotated)
+	}
+
+	if expiresAt := repo.keys["1"].ExpiresAt(); !expiresAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("[TestCase 'Rotate overlap'] Result: '%v' | Expected: '%v'", expiresAt, now.Add(time.Hour))
+	}
+
+	if _, err := uc.Rotate(context.Background(), "2", time.Hour); err != domain.ErrAPIKeyInactive {
+		t.Errorf("[TestCase 'Rotate revoked key'] Result: '%v' | ExpectedError: '%v'", err, domain.ErrAPIKeyInactive)
+	}
+}
+
+func TestAPIKey_Revoke(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now  = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		repo = newMockAPIKeyRepo(newAPIKeyFixture("1", APIKeyPrefix+"revocable-key", time.Time{}, time.Time{}, time.Time{}, now))
+		uc   = NewAPIKey(repo, mockAPIKeyPresenter{}, time.Second)
+	)
+	uc.now = func() time.Time { return now }
+
+	if err := uc.Revoke(context.Background(), "1"); err != nil {
+		t.Fatalf("[TestCase 'Revoke'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	if revokedAt := repo.keys["1"].RevokedAt(); !revokedAt.Equal(now) {
+		t.Errorf("[TestCase 'Revoke'] Result: '%v' | Expected: '%v'", revokedAt, now)
+	}
+
+	if err := uc.Revoke(context.Background(), "2"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("[TestCase 'Revoke not found'] Result: '%v' | ExpectedError: '%v'", err, domain.ErrNotFound)
+	}
+}
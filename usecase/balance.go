@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//Balance armazena as dependências para os casos de uso de saldo histórico
+type Balance struct {
+	accountRepo  domain.AccountRepository
+	transferRepo domain.TransferRepository
+	snapshotRepo domain.BalanceSnapshotRepository
+	presenter    AccountPresenter
+	ctxTimeout   time.Duration
+}
+
+//NewBalance constrói um Balance com suas dependências
+func NewBalance(
+	accountRepo domain.AccountRepository,
+	transferRepo domain.TransferRepository,
+	snapshotRepo domain.BalanceSnapshotRepository,
+	presenter AccountPresenter,
+	t time.Duration,
+) Balance {
+	return Balance{
+		accountRepo:  accountRepo,
+		transferRepo: transferRepo,
+		snapshotRepo: snapshotRepo,
+		presenter:    presenter,
+		ctxTimeout:   t,
+	}
+}
+
+//FindAt retorna o saldo de uma Account no instante at, considerando as movimentações anteriores a at
+//
+//O cálculo parte do BalanceSnapshot mais recente fechado até at e, na falta dele, do saldo inicial da Account
+func (b Balance) FindAt(ctx context.Context, ID domain.AccountID, at time.Time) (AccountBalanceOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.ctxTimeout)
+	defer cancel()
+
+	account, err := b.accountRepo.FindByID(ctx, ID)
+	if err != nil {
+		return b.presenter.OutputBalanceAt(domain.Money(0), at), err
+	}
+
+	balance, err := balanceAt(ctx, b.transferRepo, b.snapshotRepo, account, at)
+	if err != nil {
+		return b.presenter.OutputBalanceAt(domain.Money(0), at), err
+	}
+
+	return b.presenter.OutputBalanceAt(balance, at), nil
+}
+
+//balanceAt calcula o saldo de uma Account em at somando as Transfer ao BalanceSnapshot mais recente fechado até at
+//ou, na falta dele, ao saldo inicial da Account
+func balanceAt(
+	ctx context.Context,
+	transferRepo domain.TransferRepository,
+	snapshotRepo domain.BalanceSnapshotRepository,
+	account domain.Account,
+	at time.Time,
+) (domain.Money, error) {
+	if !account.CreatedAt().Before(at) {
+		return 0, nil
+	}
+
+	var (
+		balance = account.InitialBalance()
+		since   time.Time
+	)
+
+	snapshot, err := snapshotRepo.FindLatest(ctx, account.ID(), at)
+	switch {
+	case err == nil:
+		balance = snapshot.Balance()
+		since = snapshot.ClosedAt()
+	case !errors.Is(err, domain.ErrNotFound):
+		return 0, err
+	}
+
+	movements, err := transferRepo.NetAmountByAccountID(ctx, account.ID(), since, at)
+	if err != nil {
+		return 0, err
+	}
+
+	return balance + movements, nil
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//BalanceCorrection armazena as dependências para os casos de uso de aprovação das BalanceCorrection
+type BalanceCorrection struct {
+	correctionRepo domain.BalanceCorrectionRepository
+	accountRepo    domain.AccountRepository
+	presenter      BalanceCorrectionPresenter
+	publisher      EventPublisher
+	transactor     domain.Transactor
+	ctxTimeout     time.Duration
+	now            func() time.Time
+}
+
+//NewBalanceCorrection constrói um BalanceCorrection com suas dependências
+func NewBalanceCorrection(
+	correctionRepo domain.BalanceCorrectionRepository,
+	accountRepo domain.AccountRepository,
+	presenter BalanceCorrectionPresenter,
+	publisher EventPublisher,
+	transactor domain.Transactor,
+	t time.Duration,
+) BalanceCorrection {
+	return BalanceCorrection{
+		correctionRepo: correctionRepo,
+		accountRepo:    accountRepo,
+		presenter:      presenter,
+		publisher:      publisher,
+		transactor:     transactor,
+		ctxTimeout:     t,
+		now:            time.Now,
+	}
+}
+
+//Approve aprova uma BalanceCorrection pendente e soma o seu valor ao Balance da Account
+//
+//A decisão, o novo Balance e o BalanceChanged são gravados na mesma transação, liberando a Account para uma nova
+//BalanceCorrection
+func (b BalanceCorrection) Approve(ctx context.Context, ID domain.BalanceCorrectionID) (BalanceCorrectionOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.ctxTimeout)
+	defer cancel()
+
+	var correction domain.BalanceCorrection
+	err := b.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		correction, err = b.decide(ctx, ID, (*domain.BalanceCorrection).Approve)
+		if err != nil {
+			return err
+		}
+
+		account, err := b.accountRepo.FindByID(ctx, correction.AccountID())
+		if err != nil {
+			return err
+		}
+
+		var previous = account.Balance()
+		account.Correct(correction)
+
+		if err = b.accountRepo.UpdateBalance(ctx, account.ID(), account.Balance()); err != nil {
+			return err
+		}
+
+		return b.publisher.Publish(ctx, domain.NewBalanceChanged(account.ID(), previous, account.Balance(), b.now()))
+	})
+	if err != nil {
+		return b.presenter.Output(domain.BalanceCorrection{}), err
+	}
+
+	return b.presenter.Output(correction), nil
+}
+
+//Reject recusa uma BalanceCorrection pendente sem alterar o Balance da Account
+func (b BalanceCorrection) Reject(ctx context.Context, ID domain.BalanceCorrectionID) (BalanceCorrectionOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.ctxTimeout)
+	defer cancel()
+
+	var correction domain.BalanceCorrection
+	err := b.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		correction, err = b.decide(ctx, ID, (*domain.BalanceCorrection).Reject)
+		return err
+	})
+	if err != nil {
+		return b.presenter.Output(domain.BalanceCorrection{}), err
+	}
+
+	return b.presenter.Output(correction), nil
+}
+
+//decide aplica a decisão a uma BalanceCorrection pendente e grava o seu novo status
+func (b BalanceCorrection) decide(
+	ctx context.Context,
+	ID domain.BalanceCorrectionID,
+	decision func(*domain.BalanceCorrection) error,
+) (domain.BalanceCorrection, error) {
+	correction, err := b.correctionRepo.FindByID(ctx, ID)
+	if err != nil {
+		return domain.BalanceCorrection{}, err
+	}
+
+	if err = decision(&correction); err != nil {
+		return domain.BalanceCorrection{}, err
+	}
+
+	if err = b.correctionRepo.UpdateStatus(ctx, correction.ID(), correction.Status()); err != nil {
+		return domain.BalanceCorrection{}, err
+	}
+
+	return correction, nil
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/event"
+)
+
+type mockBalanceCorrectionRepoDecision struct {
+	domain.BalanceCorrectionRepository
+
+	correction domain.BalanceCorrection
+	status     *domain.BalanceCorrectionStatus
+	err        error
+}
+
+func (m mockBalanceCorrectionRepoDecision) FindByID(
+	_ context.Context,
+	_ domain.BalanceCorrectionID,
+) (domain.BalanceCorrection, error) {
+	return m.correction, m.err
+}
+
+func (m mockBalanceCorrectionRepoDecision) UpdateStatus(
+	_ context.Context,
+	_ domain.BalanceCorrectionID,
+	status domain.BalanceCorrectionStatus,
+) error {
+	*m.status = status
+	return nil
+}
+
+type mockAccountRepoCorrection struct {
+	domain.AccountRepository
+
+	account domain.Account
+	balance *domain.Money
+}
+
+func (m mockAccountRepoCorrection) FindByID(_ context.Context, _ domain.AccountID) (domain.Account, error) {
+	return m.account, nil
+}
+
+func (m mockAccountRepoCorrection) UpdateBalance(_ context.Context, _ domain.AccountID, balance domain.Money) error {
+	*m.balance = balance
+	return nil
+}
+
+type mockBalanceCorrectionPresenter struct{}
+
+func (m mockBalanceCorrectionPresenter) Output(correction domain.BalanceCorrection) BalanceCorrectionOutput {
+	return BalanceCorrectionOutput{
+		ID:        correction.ID().String(),
+		AccountID: correction.AccountID().String(),
+		Amount:    correction.Amount().Float64(),
+		Status:    correction.Status().String(),
+	}
+}
+
+func TestBalanceCorrection_Decide(t *testing.T) {
+	t.Parallel()
+
+	var (
+		account = domain.NewAccount(
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			"Test",
+			"02815517078",
+			domain.TaxIDTypeCPF,
+			500,
+			100,
+			time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC),
+		)
+		pending = domain.NewBalanceCorrection(
+			"b51cd6c7-a55c-491e-9140-91903fe66fa9",
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			-150,
+			domain.BalanceCorrectionStatusPending,
+			time.Time{},
+		)
+		approved = domain.NewBalanceCorrection(
+			"b51cd6c7-a55c-491e-9140-91903fe66fa9",
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			-150,
+			domain.BalanceCorrectionStatusApproved,
+			time.Time{},
+		)
+	)
+
+	tests := []struct {
+		name            string
+		approve         bool
+		correction      domain.BalanceCorrection
+		err             error
+		expected        BalanceCorrectionOutput
+		expectedStatus  domain.BalanceCorrectionStatus
+		expectedBalance domain.Money
+		expectedEvents  []domain.EventType
+		expectedError   error
+	}{
+		{
+			name:       "Success approving a pending correction",
+			approve:    true,
+			correction: pending,
+			expected: BalanceCorrectionOutput{
+				ID:        "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+				AccountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+				Amount:    -1.5,
+				Status:    "approved",
+			},
+			expectedStatus:  domain.BalanceCorrectionStatusApproved,
+			expectedBalance: 350,
+			expectedEvents:  []domain.EventType{domain.EventBalanceChanged},
+		},
+		{
+			name:       "Success rejecting a pending correction",
+			correction: pending,
+			expected: BalanceCorrectionOutput{
+				ID:        "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+				AccountID: "3c096a40-ccba-4b58-93ed-57379ab04680",
+				Amount:    -1.5,
+				Status:    "rejected",
+			},
+			expectedStatus: domain.BalanceCorrectionStatusRejected,
+			expectedEvents: []domain.EventType{},
+		},
+		{
+			name:           "Error approving a decided correction",
+			approve:        true,
+			correction:     approved,
+			expectedEvents: []domain.EventType{},
+			expectedError:  domain.ErrBalanceCorrectionNotPending,
+		},
+		{
+			name:           "Error correction not found",
+			approve:        true,
+			err:            domain.ErrNotFound,
+			expectedEvents: []domain.EventType{},
+			expectedError:  domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				status    domain.BalanceCorrectionStatus
+				balance   domain.Money
+				publisher = event.NewRecorder()
+				uc        = NewBalanceCorrection(
+					mockBalanceCorrectionRepoDecision{correction: tt.correction, status: &status, err: tt.err},
+					mockAccountRepoCorrection{account: account, balance: &balance},
+					mockBalanceCorrectionPresenter{},
+					publisher,
+					mockTransactor{},
+					time.Second,
+				)
+			)
+
+			var decide = uc.Reject
+			if tt.approve {
+				decide = uc.Approve
+			}
+
+			result, err := decide(context.Background(), "b51cd6c7-a55c-491e-9140-91903fe66fa9")
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+
+			if status != tt.expectedStatus {
+				t.Errorf("[TestCase '%s'] Status: '%v' | Expected: '%v'", tt.name, status, tt.expectedStatus)
+			}
+
+			if balance != tt.expectedBalance {
+				t.Errorf("[TestCase '%s'] Balance: '%v' | Expected: '%v'", tt.name, balance, tt.expectedBalance)
+			}
+
+			if !reflect.DeepEqual(publisher.Types(), tt.expectedEvents) {
+				t.Errorf("[TestCase '%s'] Events: '%v' | Expected: '%v'", tt.name, publisher.Types(), tt.expectedEvents)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+type mockTransferRepoBalance struct {
+	domain.TransferRepository
+
+	from      time.Time
+	netAmount domain.Money
+	err       error
+}
+
+func (m mockTransferRepoBalance) NetAmountByAccountID(
+	_ context.Context,
+	_ domain.AccountID,
+	from time.Time,
+	_ time.Time,
+) (domain.Money, error) {
+	if !from.Equal(m.from) {
+		return 0, errors.New("unexpected period")
+	}
+
+	return m.netAmount, m.err
+}
+
+type mockBalanceSnapshotRepo struct {
+	domain.BalanceSnapshotRepository
+
+	result domain.BalanceSnapshot
+	err    error
+}
+
+func (m mockBalanceSnapshotRepo) FindLatest(
+	_ context.Context,
+	_ domain.AccountID,
+	_ time.Time,
+) (domain.BalanceSnapshot, error) {
+	return m.result, m.err
+}
+
+type mockAccountPresenterBalanceAt struct {
+	AccountPresenter
+}
+
+func (m mockAccountPresenterBalanceAt) OutputBalanceAt(balance domain.Money, _ time.Time) AccountBalanceOutput {
+	return AccountBalanceOutput{Balance: balance.Float64()}
+}
+
+func TestBalance_FindAt(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Date(2020, 1, 10, 12, 0, 0, 0, time.UTC)
+		at        = time.Date(2020, 3, 31, 23, 59, 0, 0, time.UTC)
+		account   = domain.NewAccount(
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			"Test",
+			"02815517078",
+			domain.TaxIDTypeCPF,
+			500,
+			100,
+			createdAt,
+		)
+	)
+
+	tests := []struct {
+		name          string
+		at            time.Time
+		accountRepo   domain.AccountRepository
+		transferRepo  domain.TransferRepository
+		snapshotRepo  domain.BalanceSnapshotRepository
+		expected      AccountBalanceOutput
+		expectedError interface{}
+	}{
+		{
+			name:        "Success when returning the balance from the latest snapshot",
+			at:          at,
+			accountRepo: mockAccountRepoFindByID{result: account},
+			transferRepo: mockTransferRepoBalance{
+				from:      time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC),
+				netAmount: -50,
+			},
+			snapshotRepo: mockBalanceSnapshotRepo{
+				result: domain.NewBalanceSnapshot(
+					"3c096a40-ccba-4b58-93ed-57379ab04680",
+					time.Date(2020, 3, 30, 0, 0, 0, 0, time.UTC),
+					300,
+					time.Time{},
+				),
+			},
+			expected: AccountBalanceOutput{Balance: 2.5},
+		},
+		{
+			name:        "Success when returning the balance from the initial balance without snapshot",
+			at:          at,
+			accountRepo: mockAccountRepoFindByID{result: account},
+			transferRepo: mockTransferRepoBalance{
+				from:      time.Time{},
+				netAmount: 150,
+			},
+			snapshotRepo: mockBalanceSnapshotRepo{
+				err: domain.ErrNotFound,
+			},
+			expected: AccountBalanceOutput{Balance: 2.5},
+		},
+		{
+			name:        "Success when the account did not exist yet",
+			at:          createdAt,
+			accountRepo: mockAccountRepoFindByID{result: account},
+			transferRepo: mockTransferRepoBalance{
+				err: errors.New("must not be called"),
+			},
+			snapshotRepo: mockBalanceSnapshotRepo{
+				err: errors.New("must not be called"),
+			},
+			expected: AccountBalanceOutput{Balance: 0},
+		},
+		{
+			name: "Error account not found",
+			at:   at,
+			accountRepo: mockAccountRepoFindByID{
+				err: domain.ErrNotFound,
+			},
+			transferRepo:  mockTransferRepoBalance{},
+			snapshotRepo:  mockBalanceSnapshotRepo{},
+			expectedError: "not found",
+			expected:      AccountBalanceOutput{},
+		},
+		{
+			name:         "Error fetching balance snapshot",
+			at:           at,
+			accountRepo:  mockAccountRepoFindByID{result: account},
+			transferRepo: mockTransferRepoBalance{},
+			snapshotRepo: mockBalanceSnapshotRepo{
+				err: errors.New("error fetching balance snapshot"),
+			},
+			expectedError: "error fetching balance snapshot",
+			expected:      AccountBalanceOutput{},
+		},
+		{
+			name:        "Error summing account transfers",
+			at:          at,
+			accountRepo: mockAccountRepoFindByID{result: account},
+			transferRepo: mockTransferRepoBalance{
+				err: errors.New("error summing account transfers"),
+			},
+			snapshotRepo: mockBalanceSnapshotRepo{
+				err: domain.ErrNotFound,
+			},
+			expectedError: "error summing account transfers",
+			expected:      AccountBalanceOutput{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var uc = NewBalance(
+				tt.accountRepo,
+				tt.transferRepo,
+				tt.snapshotRepo,
+				mockAccountPresenterBalanceAt{},
+				time.Second,
+			)
+
+			result, err := uc.FindAt(context.Background(), "3c096a40-ccba-4b58-93ed-57379ab04680", tt.at)
+			if (err != nil) && (err.Error() != tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
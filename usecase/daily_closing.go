@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//DailyClosing armazena as dependências para os casos de uso de fechamento diário de saldos
+type DailyClosing struct {
+	accountRepo  domain.AccountRepository
+	transferRepo domain.TransferRepository
+	snapshotRepo domain.BalanceSnapshotRepository
+	presenter    DailyClosingPresenter
+	ctxTimeout   time.Duration
+	now          func() time.Time
+}
+
+//NewDailyClosing constrói um DailyClosing com suas dependências
+func NewDailyClosing(
+	accountRepo domain.AccountRepository,
+	transferRepo domain.TransferRepository,
+	snapshotRepo domain.BalanceSnapshotRepository,
+	presenter DailyClosingPresenter,
+	t time.Duration,
+) DailyClosing {
+	return DailyClosing{
+		accountRepo:  accountRepo,
+		transferRepo: transferRepo,
+		snapshotRepo: snapshotRepo,
+		presenter:    presenter,
+		ctxTimeout:   t,
+		now:          time.Now,
+	}
+}
+
+//Execute registra o saldo de fechamento do dia de date, em UTC, para cada Account existente no fim do dia
+//
+//O saldo de fechamento é o Balance atual descontado das Transfer posteriores ao fim do dia e é verificado contra o
+//fechamento anterior somado às movimentações do dia. Account já fechadas no dia são ignoradas, o que permite
+//executar novamente o fechamento de um mesmo dia
+func (d DailyClosing) Execute(ctx context.Context, date time.Time) (DailyClosingOutput, error) {
+	var closing = domain.NewDailyClosing(date)
+	if closing.ClosedAt().After(d.now()) {
+		return d.presenter.Output(closing), domain.ErrDayNotClosed
+	}
+
+	var pagination = domain.NewPagination(domain.Cursor{}, domain.MaxPageLimit)
+	for {
+		accounts, err := d.findAccounts(ctx, pagination)
+		if err != nil {
+			return d.presenter.Output(closing), err
+		}
+
+		for _, account := range accounts {
+			if !account.CreatedAt().Before(closing.ClosedAt()) {
+				return d.presenter.Output(closing), nil
+			}
+
+			if err := d.close(ctx, &closing, account); err != nil {
+				return d.presenter.Output(closing), err
+			}
+		}
+
+		if len(accounts) < pagination.Limit {
+			break
+		}
+
+		var last = accounts[len(accounts)-1]
+		pagination = domain.NewPagination(domain.NewCursor(last.CreatedAt(), last.ID().String()), domain.MaxPageLimit)
+	}
+
+	return d.presenter.Output(closing), nil
+}
+
+func (d DailyClosing) findAccounts(ctx context.Context, pagination domain.Pagination) ([]domain.Account, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	return d.accountRepo.FindAll(ctx, pagination)
+}
+
+//close calcula e registra o BalanceSnapshot de uma Account no DailyClosing
+func (d DailyClosing) close(ctx context.Context, closing *domain.DailyClosing, account domain.Account) error {
+	ctx, cancel := context.WithTimeout(ctx, d.ctxTimeout)
+	defer cancel()
+
+	expected, err := balanceAt(ctx, d.transferRepo, d.snapshotRepo, account, closing.ClosedAt())
+	if err != nil {
+		return err
+	}
+
+	var now = d.now()
+
+	current, err := d.accountRepo.FindBalance(ctx, account.ID())
+	if err != nil {
+		return err
+	}
+
+	movements, err := d.transferRepo.NetAmountByAccountID(ctx, account.ID(), closing.ClosedAt(), now)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := d.snapshotRepo.Store(
+		ctx,
+		domain.NewBalanceSnapshot(account.ID(), closing.Date(), current.Balance()-movements, now),
+	)
+	switch {
+	case errors.Is(err, domain.ErrBalanceSnapshotAlreadyExists):
+		closing.Skip(account.ID())
+		return nil
+	case err != nil:
+		return err
+	}
+
+	closing.Close(snapshot, expected)
+
+	return nil
+}
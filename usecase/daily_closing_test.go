@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+type mockAccountRepoDailyClosing struct {
+	domain.AccountRepository
+
+	accounts []domain.Account
+	balance  domain.Money
+}
+
+func (m mockAccountRepoDailyClosing) FindAll(_ context.Context, _ domain.Pagination) ([]domain.Account, error) {
+	return m.accounts, nil
+}
+
+func (m mockAccountRepoDailyClosing) FindBalance(_ context.Context, _ domain.AccountID) (domain.Account, error) {
+	return domain.NewAccountBalance(m.balance), nil
+}
+
+type mockTransferRepoDailyClosing struct {
+	domain.TransferRepository
+
+	closedAt       time.Time
+	dayMovements   domain.Money
+	laterMovements domain.Money
+}
+
+func (m mockTransferRepoDailyClosing) NetAmountByAccountID(
+	_ context.Context,
+	_ domain.AccountID,
+	from time.Time,
+	_ time.Time,
+) (domain.Money, error) {
+	if from.Equal(m.closedAt) {
+		return m.laterMovements, nil
+	}
+
+	return m.dayMovements, nil
+}
+
+type mockBalanceSnapshotRepoDailyClosing struct {
+	domain.BalanceSnapshotRepository
+
+	previous domain.BalanceSnapshot
+	storeErr error
+}
+
+func (m mockBalanceSnapshotRepoDailyClosing) Store(
+	_ context.Context,
+	snapshot domain.BalanceSnapshot,
+) (domain.BalanceSnapshot, error) {
+	if m.storeErr != nil {
+		return domain.BalanceSnapshot{}, m.storeErr
+	}
+
+	return snapshot, nil
+}
+
+func (m mockBalanceSnapshotRepoDailyClosing) FindLatest(
+	_ context.Context,
+	_ domain.AccountID,
+	_ time.Time,
+) (domain.BalanceSnapshot, error) {
+	return m.previous, nil
+}
+
+type mockDailyClosingPresenter struct{}
+
+func (m mockDailyClosingPresenter) Output(closing domain.DailyClosing) DailyClosingOutput {
+	var discrepancies = make([]BalanceDiscrepancyOutput, 0)
+
+	for _, discrepancy := range closing.Discrepancies() {
+		discrepancies = append(discrepancies, BalanceDiscrepancyOutput{
+			AccountID:  discrepancy.AccountID().String(),
+			Expected:   discrepancy.Expected().Float64(),
+			Closing:    discrepancy.Closing().Float64(),
+			Difference: discrepancy.Difference().Float64(),
+		})
+	}
+
+	return DailyClosingOutput{
+		Date:          closing.Date(),
+		Closed:        len(closing.Snapshots()),
+		AlreadyClosed: len(closing.AlreadyClosed()),
+		Discrepancies: discrepancies,
+	}
+}
+
+func TestDailyClosing_Execute(t *testing.T) {
+	t.Parallel()
+
+	var (
+		date     = time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC)
+		closedAt = date.Add(24 * time.Hour)
+		now      = closedAt.Add(6 * time.Hour)
+		account  = domain.NewAccount(
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			"Test",
+			"02815517078",
+			domain.TaxIDTypeCPF,
+			500,
+			100,
+			date.Add(-48*time.Hour),
+		)
+		previous = domain.NewBalanceSnapshot(
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			date.Add(-24*time.Hour),
+			300,
+			time.Time{},
+		)
+	)
+
+	tests := []struct {
+		name          string
+		date          time.Time
+		accountRepo   domain.AccountRepository
+		transferRepo  domain.TransferRepository
+		snapshotRepo  domain.BalanceSnapshotRepository
+		expected      DailyClosingOutput
+		expectedError interface{}
+	}{
+		{
+			name: "Success when the closing matches the previous close plus the day movements",
+			date: date,
+			accountRepo: mockAccountRepoDailyClosing{
+				accounts: []domain.Account{account},
+				balance:  500,
+			},
+			transferRepo: mockTransferRepoDailyClosing{
+				closedAt:       closedAt,
+				dayMovements:   150,
+				laterMovements: 50,
+			},
+			snapshotRepo: mockBalanceSnapshotRepoDailyClosing{previous: previous},
+			expected: DailyClosingOutput{
+				Date:          date,
+				Closed:        1,
+				Discrepancies: []BalanceDiscrepancyOutput{},
+			},
+		},
+		{
+			name: "Success flagging a discrepancy",
+			date: date,
+			accountRepo: mockAccountRepoDailyClosing{
+				accounts: []domain.Account{account},
+				balance:  500,
+			},
+			transferRepo: mockTransferRepoDailyClosing{
+				closedAt:       closedAt,
+				dayMovements:   100,
+				laterMovements: 50,
+			},
+			snapshotRepo: mockBalanceSnapshotRepoDailyClosing{previous: previous},
+			expected: DailyClosingOutput{
+				Date:   date,
+				Closed: 1,
+				Discrepancies: []BalanceDiscrepancyOutput{
+					{
+						AccountID:  "3c096a40-ccba-4b58-93ed-57379ab04680",
+						Expected:   4,
+						Closing:    4.5,
+						Difference: 0.5,
+					},
+				},
+			},
+		},
+		{
+			name: "Success skipping accounts already closed",
+			date: date,
+			accountRepo: mockAccountRepoDailyClosing{
+				accounts: []domain.Account{account},
+				balance:  500,
+			},
+			transferRepo: mockTransferRepoDailyClosing{closedAt: closedAt},
+			snapshotRepo: mockBalanceSnapshotRepoDailyClosing{
+				previous: previous,
+				storeErr: domain.ErrBalanceSnapshotAlreadyExists,
+			},
+			expected: DailyClosingOutput{
+				Date:          date,
+				AlreadyClosed: 1,
+				Discrepancies: []BalanceDiscrepancyOutput{},
+			},
+		},
+		{
+			name: "Success ignoring accounts created after the day",
+			date: date.Add(-72 * time.Hour),
+			accountRepo: mockAccountRepoDailyClosing{
+				accounts: []domain.Account{account},
+			},
+			transferRepo: mockTransferRepoDailyClosing{},
+			snapshotRepo: mockBalanceSnapshotRepoDailyClosing{
+				storeErr: errors.New("must not be called"),
+			},
+			expected: DailyClosingOutput{
+				Date:          date.Add(-72 * time.Hour),
+				Discrepancies: []BalanceDiscrepancyOutput{},
+			},
+		},
+		{
+			name:          "Error day has not ended yet",
+			date:          now,
+			accountRepo:   mockAccountRepoDailyClosing{},
+			transferRepo:  mockTransferRepoDailyClosing{},
+			snapshotRepo:  mockBalanceSnapshotRepoDailyClosing{},
+			expectedError: "day has not ended yet",
+			expected: DailyClosingOutput{
+				Date:          closedAt,
+				Discrepancies: []BalanceDiscrepancyOutput{},
+			},
+		},
+		{
+			name: "Error storing balance snapshot",
+			date: date,
+			accountRepo: mockAccountRepoDailyClosing{
+				accounts: []domain.Account{account},
+				balance:  500,
+			},
+			transferRepo: mockTransferRepoDailyClosing{closedAt: closedAt},
+			snapshotRepo: mockBalanceSnapshotRepoDailyClosing{
+				previous: previous,
+				storeErr: errors.New("error creating balance snapshot"),
+			},
+			expectedError: "error creating balance snapshot",
+			expected: DailyClosingOutput{
+				Date:          date,
+				Discrepancies: []BalanceDiscrepancyOutput{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var uc = NewDailyClosing(
+				tt.accountRepo,
+				tt.transferRepo,
+				tt.snapshotRepo,
+				mockDailyClosingPresenter{},
+				time.Second,
+			)
+			uc.now = func() time.Time { return now }
+
+			result, err := uc.Execute(context.Background(), tt.date)
+			if (err != nil) && (err.Error() != tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
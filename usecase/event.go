@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//EventPublisher é uma abstração para a publicação dos eventos de domínio gerados pelos casos de uso
+//
+//Quando executado dentro de uma transação, o erro retornado desfaz a operação que gerou os eventos
+type EventPublisher interface {
+	Publish(context.Context, ...domain.Event) error
+}
+
+//MessagePublisher é uma abstração para a entrega das OutboxMessage ao seu destino final
+type MessagePublisher interface {
+	Publish(context.Context, domain.OutboxMessage) error
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//OAuthClient armazena as dependências para os casos de uso de OAuthClient
+type OAuthClient struct {
+	repo       domain.OAuthClientRepository
+	presenter  OAuthClientPresenter
+	ctxTimeout time.Duration
+	now        func() time.Time
+}
+
+//NewOAuthClient constrói um OAuthClient com suas dependências
+func NewOAuthClient(repo domain.OAuthClientRepository, presenter OAuthClientPresenter, t time.Duration) OAuthClient {
+	return OAuthClient{
+		repo:       repo,
+		presenter:  presenter,
+		ctxTimeout: t,
+		now:        time.Now,
+	}
+}
+
+//Store cadastra um novo OAuthClient. O secret é retornado apenas no cadastro
+func (o OAuthClient) Store(
+	ctx context.Context,
+	name string,
+	scopes []domain.Scope,
+	accountID domain.AccountID,
+) (OAuthClientOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.ctxTimeout)
+	defer cancel()
+
+	secret, err := newClientSecret()
+	if err != nil {
+		return o.presenter.Output(domain.OAuthClient{}), err
+	}
+
+	client, err := o.repo.Store(ctx, domain.NewOAuthClient(
+		domain.OAuthClientID(domain.NewUUID()),
+		name,
+		hashClientSecret(secret),
+		scopes,
+		accountID,
+		o.now(),
+	))
+	if err != nil {
+		return o.presenter.Output(domain.OAuthClient{}), err
+	}
+
+	var output = o.presenter.Output(client)
+	output.ClientSecret = secret
+
+	return output, nil
+}
+
+//Delete remove o cadastro de um OAuthClient, que deixa de obter novos tokens
+func (o OAuthClient) Delete(ctx context.Context, ID domain.OAuthClientID) error {
+	ctx, cancel := context.WithTimeout(ctx, o.ctxTimeout)
+	defer cancel()
+
+	if _, err := o.repo.FindByID(ctx, ID); err != nil {
+		return err
+	}
+
+	return o.repo.Delete(ctx, ID)
+}
+
+//FindAll retorna todos os OAuthClient, sem os secrets
+func (o OAuthClient) FindAll(ctx context.Context) ([]OAuthClientOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.ctxTimeout)
+	defer cancel()
+
+	clients, err := o.repo.FindAll(ctx)
+	if err != nil {
+		return o.presenter.OutputList([]domain.OAuthClient{}), err
+	}
+
+	return o.presenter.OutputList(clients), nil
+}
+
+//Authenticate verifica as credenciais do OAuthClient e retorna o cadastro com os escopos concedidos ao token
+//
+//Retorna domain.ErrInvalidClient para clientes desconhecidos ou secrets incorretos e domain.ErrInvalidScope para
+//escopos não concedidos ao OAuthClient
+func (o OAuthClient) Authenticate(
+	ctx context.Context,
+	ID domain.OAuthClientID,
+	secret string,
+	scopes []domain.Scope,
+) (OAuthClientOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.ctxTimeout)
+	defer cancel()
+
+	client, err := o.repo.FindByID(ctx, ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return o.presenter.Output(domain.OAuthClient{}), domain.ErrInvalidClient
+		}
+
+		return o.presenter.Output(domain.OAuthClient{}), err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashClientSecret(secret)), []byte(client.SecretHash())) != 1 {
+		return o.presenter.Output(domain.OAuthClient{}), domain.ErrInvalidClient
+	}
+
+	granted, err := client.GrantScopes(scopes)
+	if err != nil {
+		return o.presenter.Output(domain.OAuthClient{}), err
+	}
+
+	var output = o.presenter.Output(client)
+	output.Scopes = scopesToStrings(granted)
+
+	return output, nil
+}
+
+func newClientSecret() (string, error) {
+	var secret = make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+//hashClientSecret retorna o SHA-256 do secret, suficiente para secrets aleatórios de 256 bits
+func hashClientSecret(secret string) string {
+	var sum = sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func scopesToStrings(scopes []domain.Scope) []string {
+	var result = make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		result = append(result, scope.String())
+	}
+
+	return result
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+type mockOAuthClientRepo struct {
+	domain.OAuthClientRepository
+
+	clients map[domain.OAuthClientID]domain.OAuthClient
+}
+
+func (m mockOAuthClientRepo) Store(_ context.Context, client domain.OAuthClient) (domain.OAuthClient, error) {
+	m.clients[client.ID()] = client
+	return client, nil
+}
+
+func (m mockOAuthClientRepo) FindByID(_ context.Context, ID domain.OAuthClientID) (domain.OAuthClient, error) {
+	client, ok := m.clients[ID]
+	if !ok {
+		return domain.OAuthClient{}, domain.ErrNotFound
+	}
+
+	return client, nil
+}
+
+type mockOAuthClientPresenter struct {
+	OAuthClientPresenter
+}
+
+func (m mockOAuthClientPresenter) Output(client domain.OAuthClient) OAuthClientOutput {
+	return OAuthClientOutput{
+		ClientID:  client.ID().String(),
+		Name:      client.Name(),
+		Scopes:    scopesToStrings(client.Scopes()),
+		AccountID: client.AccountID().String(),
+	}
+}
+
+func TestOAuthClient_Store(t *testing.T) {
+	t.Parallel()
+
+	var (
+		repo = mockOAuthClientRepo{clients: map[domain.OAuthClientID]domain.OAuthClient{}}
+		uc   = NewOAuthClient(repo, mockOAuthClientPresenter{}, time.Second)
+	)
+
+	result, err := uc.Store(context.Background(), "partner", []domain.Scope{domain.ScopeAccountsRead}, "")
+	if err != nil {
+		t.Fatalf("[TestCase 'Store'] Result: '%v' | ExpectedError: '%v'", err, nil)
+	}
+
+	if result.ClientID == "" || result.ClientSecret == "" {
+		t.Errorf("[TestCase 'Store'] Result: '%v' | Expected generated credentials", result)
+	}
+
+	var stored = repo.clients[domain.OAuthClientID(result.ClientID)]
+	if stored.SecretHash() != hashClientSecret(result.ClientSecret) {
+		t.Errorf("[TestCase 'Store'] Result: '%v' | Expected only the hash of the secret", stored.SecretHash())
+	}
+}
+
+func TestOAuthClient_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		client = domain.NewOAuthClient(
+			"a5cb1ba2-d5ba-4d1b-8a5a-37ab5c2d2fa5",
+			"partner",
+			hashClientSecret("secret"),
+			[]domain.Scope{domain.ScopeAccountsRead, domain.ScopeTransfersWrite},
+			"3c096a40-ccba-4b58-93ed-57379ab04680",
+			time.Time{},
+		)
+		repo = mockOAuthClientRepo{clients: map[domain.OAuthClientID]domain.OAuthClient{client.ID(): client}}
+		uc   = NewOAuthClient(repo, mockOAuthClientPresenter{}, time.Second)
+	)
+
+	tests := []struct {
+		name           string
+		clientID       domain.OAuthClientID
+		secret         string
+		scopes         []domain.Scope
+		expectedScopes []string
+		expectedError  error
+	}{
+		{
+			name:           "Client credentials without scopes",
+			clientID:       client.ID(),
+			secret:         "secret",
+			expectedScopes: []string{"accounts:read", "transfers:write"},
+		},
+		{
+			name:           "Client credentials with requested scopes",
+			clientID:       client.ID(),
+			secret:         "secret",
+			scopes:         []domain.Scope{domain.ScopeTransfersWrite},
+			expectedScopes: []string{"transfers:write"},
+		},
+		{
+			name:          "Scope not granted to the client",
+			clientID:      client.ID(),
+			secret:        "secret",
+			scopes:        []domain.Scope{domain.ScopeAdmin},
+			expectedError: domain.ErrInvalidScope,
+		},
+		{
+			name:          "Invalid secret",
+			clientID:      client.ID(),
+			secret:        "other",
+			expectedError: domain.ErrInvalidClient,
+		},
+		{
+			name:          "Unknown client",
+			clientID:      "3c096a40-ccba-4b58-93ed-57379ab04680",
+			secret:        "secret",
+			expectedError: domain.ErrInvalidClient,
+		},
+	}
+
+	for _, tt := range tests {
+		result, err := uc.Authenticate(context.Background(), tt.clientID, tt.secret, tt.scopes)
+		if !errors.Is(err, tt.expectedError) {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, err, tt.expectedError)
+			continue
+		}
+
+		if err != nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(result.Scopes, tt.expectedScopes) {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.Scopes, tt.expectedScopes)
+		}
+
+		if result.AccountID != client.AccountID().String() {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result.AccountID, client.AccountID())
+		}
+	}
+}
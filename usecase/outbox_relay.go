@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//OutboxRelay armazena as dependências para os casos de uso de publicação das mensagens do outbox
+type OutboxRelay struct {
+	repo        domain.OutboxRepository
+	publisher   MessagePublisher
+	maxAttempts int
+	ctxTimeout  time.Duration
+	now         func() time.Time
+}
+
+//NewOutboxRelay constrói um OutboxRelay com suas dependências
+//
+//maxAttempts é a quantidade de publicações de uma OutboxMessage que podem falhar antes que ela seja marcada como falha
+func NewOutboxRelay(
+	repo domain.OutboxRepository,
+	publisher MessagePublisher,
+	maxAttempts int,
+	t time.Duration,
+) OutboxRelay {
+	return OutboxRelay{
+		repo:        repo,
+		publisher:   publisher,
+		maxAttempts: maxAttempts,
+		ctxTimeout:  t,
+		now:         time.Now,
+	}
+}
+
+//Relay publica até limit OutboxMessage pendentes, na ordem em que foram gravadas, marcando cada uma como enviada
+//
+//A marcação ocorre após a publicação, então uma falha entre as duas etapas faz a mensagem ser publicada
+//novamente (at-least-once). Quando a publicação de uma mensagem falha, as mensagens seguintes das mesmas
+//Account são adiadas para a próxima execução, preservando a ordem por Account. Ao esgotar maxAttempts a mensagem
+//é marcada como falha com o último erro, liberando as mensagens seguintes das suas Account
+func (o OutboxRelay) Relay(ctx context.Context, limit int) (OutboxRelayOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.ctxTimeout)
+	defer cancel()
+
+	var output = OutboxRelayOutput{Failures: make([]OutboxFailureOutput, 0)}
+
+	messages, err := o.repo.FindPending(ctx, limit)
+	if err != nil {
+		return output, err
+	}
+
+	var blocked = make(map[domain.AccountID]bool)
+	for _, message := range messages {
+		if isBlocked(blocked, message.AccountIDs()) {
+			output.Deferred++
+			continue
+		}
+
+		if err := o.publisher.Publish(ctx, message); err != nil {
+			failure, err := o.fail(ctx, message, err)
+			if err != nil {
+				return output, err
+			}
+
+			if !failure.Failed {
+				for _, ID := range message.AccountIDs() {
+					blocked[ID] = true
+				}
+			}
+
+			output.Failures = append(output.Failures, failure)
+			continue
+		}
+
+		if err := o.repo.MarkSent(ctx, message.ID(), o.now()); err != nil {
+			return output, err
+		}
+
+		output.Sent++
+	}
+
+	return output, nil
+}
+
+//fail registra a publicação que falhou, marcando a OutboxMessage como falha quando ela esgota as tentativas
+func (o OutboxRelay) fail(
+	ctx context.Context,
+	message domain.OutboxMessage,
+	cause error,
+) (OutboxFailureOutput, error) {
+	var failure = OutboxFailureOutput{
+		MessageID: message.ID().String(),
+		EventType: message.EventType().String(),
+		Error:     cause.Error(),
+		Attempts:  message.Attempts() + 1,
+		Failed:    message.Attempts()+1 >= o.maxAttempts,
+	}
+
+	if failure.Failed {
+		return failure, o.repo.MarkFailed(ctx, message.ID(), cause.Error(), o.now())
+	}
+
+	return failure, o.repo.RecordFailure(ctx, message.ID(), cause.Error())
+}
+
+//Cleanup remove as OutboxMessage enviadas há mais tempo que retention e retorna a quantidade removida
+func (o OutboxRelay) Cleanup(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.ctxTimeout)
+	defer cancel()
+
+	return o.repo.DeleteSentBefore(ctx, o.now().Add(-retention))
+}
+
+func isBlocked(blocked map[domain.AccountID]bool, accountIDs []domain.AccountID) bool {
+	for _, ID := range accountIDs {
+		if blocked[ID] {
+			return true
+		}
+	}
+
+	return false
+}
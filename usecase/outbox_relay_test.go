@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+type mockOutboxRepo struct {
+	domain.OutboxRepository
+
+	pending    []domain.OutboxMessage
+	findErr    error
+	markErr    error
+	sent       *[]domain.OutboxMessageID
+	failures   *[]domain.OutboxMessageID
+	failed     *[]domain.OutboxMessageID
+	deleted    int64
+	deletedAt  *time.Time
+	deletedErr error
+}
+
+func (m mockOutboxRepo) FindPending(_ context.Context, limit int) ([]domain.OutboxMessage, error) {
+	if len(m.pending) > limit {
+		return m.pending[:limit], m.findErr
+	}
+
+	return m.pending, m.findErr
+}
+
+func (m mockOutboxRepo) MarkSent(_ context.Context, ID domain.OutboxMessageID, _ time.Time) error {
+	if m.markErr != nil {
+		return m.markErr
+	}
+
+	*m.sent = append(*m.sent, ID)
+	return nil
+}
+
+func (m mockOutboxRepo) RecordFailure(_ context.Context, ID domain.OutboxMessageID, _ string) error {
+	*m.failures = append(*m.failures, ID)
+	return nil
+}
+
+func (m mockOutboxRepo) MarkFailed(_ context.Context, ID domain.OutboxMessageID, _ string, _ time.Time) error {
+	*m.failed = append(*m.failed, ID)
+	return nil
+}
+
+func (m mockOutboxRepo) DeleteSentBefore(_ context.Context, before time.Time) (int64, error) {
+	*m.deletedAt = before
+	return m.deleted, m.deletedErr
+}
+
+type mockMessagePublisher struct {
+	failing   map[domain.OutboxMessageID]bool
+	published *[]domain.OutboxMessageID
+}
+
+func (m mockMessagePublisher) Publish(_ context.Context, message domain.OutboxMessage) error {
+	if m.failing[message.ID()] {
+		return errors.New("broker unavailable")
+	}
+
+	*m.published = append(*m.published, message.ID())
+	return nil
+}
+
+func newOutboxMessage(ID domain.OutboxMessageID, eventType domain.EventType, accountIDs ...domain.AccountID) domain.OutboxMessage {
+	return domain.NewOutboxMessage(ID, eventType, accountIDs, []byte("{}"), 0, time.Time{}, time.Time{})
+}
+
+func TestOutboxRelay_Relay(t *testing.T) {
+	t.Parallel()
+
+	var pending = []domain.OutboxMessage{
+		newOutboxMessage("1", domain.EventBalanceChanged, "a"),
+		newOutboxMessage("2", domain.EventBalanceChanged, "b"),
+		newOutboxMessage("3", domain.EventTransferCompleted, "a", "b"),
+		newOutboxMessage("4", domain.EventBalanceChanged, "c"),
+		newOutboxMessage("5", domain.EventAccountCreated, "b"),
+		newOutboxMessage("6", domain.EventBalanceChanged, "d").WithAttempts(9),
+		newOutboxMessage("7", domain.EventBalanceChanged, "d"),
+	}
+
+	tests := []struct {
+		name              string
+		limit             int
+		failing           map[domain.OutboxMessageID]bool
+		findErr           error
+		markErr           error
+		expected          OutboxRelayOutput
+		expectedPublished []domain.OutboxMessageID
+		expectedSent      []domain.OutboxMessageID
+		expectedFailures  []domain.OutboxMessageID
+		expectedFailed    []domain.OutboxMessageID
+		expectedError     interface{}
+	}{
+		{
+			name:              "Success publishing all pending messages in order",
+			limit:             100,
+			expected:          OutboxRelayOutput{Sent: 7, Failures: []OutboxFailureOutput{}},
+			expectedPublished: []domain.OutboxMessageID{"1", "2", "3", "4", "5", "6", "7"},
+			expectedSent:      []domain.OutboxMessageID{"1", "2", "3", "4", "5", "6", "7"},
+			expectedFailures:  []domain.OutboxMessageID{},
+			expectedFailed:    []domain.OutboxMessageID{},
+		},
+		{
+			name:              "Success publishing up to the limit",
+			limit:             2,
+			expected:          OutboxRelayOutput{Sent: 2, Failures: []OutboxFailureOutput{}},
+			expectedPublished: []domain.OutboxMessageID{"1", "2"},
+			expectedSent:      []domain.OutboxMessageID{"1", "2"},
+			expectedFailures:  []domain.OutboxMessageID{},
+			expectedFailed:    []domain.OutboxMessageID{},
+		},
+		{
+			name:    "Failure defers the following messages of the same accounts",
+			limit:   100,
+			failing: map[domain.OutboxMessageID]bool{"2": true},
+			expected: OutboxRelayOutput{
+				Sent:     4,
+				Deferred: 2,
+				Failures: []OutboxFailureOutput{
+					{MessageID: "2", EventType: "balance.changed", Error: "broker unavailable", Attempts: 1},
+				},
+			},
+			expectedPublished: []domain.OutboxMessageID{"1", "4", "6", "7"},
+			expectedSent:      []domain.OutboxMessageID{"1", "4", "6", "7"},
+			expectedFailures:  []domain.OutboxMessageID{"2"},
+			expectedFailed:    []domain.OutboxMessageID{},
+		},
+		{
+			name:    "Failure exhausting the attempts marks the message as failed and releases its accounts",
+			limit:   100,
+			failing: map[domain.OutboxMessageID]bool{"6": true},
+			expected: OutboxRelayOutput{
+				Sent: 6,
+				Failures: []OutboxFailureOutput{
+					{MessageID: "6", EventType: "balance.changed", Error: "broker unavailable", Attempts: 10, Failed: true},
+				},
+			},
+			expectedPublished: []domain.OutboxMessageID{"1", "2", "3", "4", "5", "7"},
+			expectedSent:      []domain.OutboxMessageID{"1", "2", "3", "4", "5", "7"},
+			expectedFailures:  []domain.OutboxMessageID{},
+			expectedFailed:    []domain.OutboxMessageID{"6"},
+		},
+		{
+			name:              "Error marking message as sent keeps it pending",
+			limit:             100,
+			markErr:           errors.New("error marking outbox message as sent"),
+			expected:          OutboxRelayOutput{Failures: []OutboxFailureOutput{}},
+			expectedPublished: []domain.OutboxMessageID{"1"},
+			expectedSent:      []domain.OutboxMessageID{},
+			expectedFailures:  []domain.OutboxMessageID{},
+			expectedFailed:    []domain.OutboxMessageID{},
+			expectedError:     "error marking outbox message as sent",
+		},
+		{
+			name:              "Error listing pending messages",
+			limit:             100,
+			findErr:           errors.New("error listing pending outbox messages"),
+			expected:          OutboxRelayOutput{Failures: []OutboxFailureOutput{}},
+			expectedPublished: []domain.OutboxMessageID{},
+			expectedSent:      []domain.OutboxMessageID{},
+			expectedFailures:  []domain.OutboxMessageID{},
+			expectedFailed:    []domain.OutboxMessageID{},
+			expectedError:     "error listing pending outbox messages",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				published = make([]domain.OutboxMessageID, 0)
+				sent      = make([]domain.OutboxMessageID, 0)
+				failures  = make([]domain.OutboxMessageID, 0)
+				failed    = make([]domain.OutboxMessageID, 0)
+				repo      = mockOutboxRepo{
+					pending:  pending,
+					findErr:  tt.findErr,
+					markErr:  tt.markErr,
+					sent:     &sent,
+					failures: &failures,
+					failed:   &failed,
+				}
+				uc = NewOutboxRelay(repo, mockMessagePublisher{failing: tt.failing, published: &published}, 10, time.Second)
+			)
+
+			result, err := uc.Relay(context.Background(), tt.limit)
+			if (err != nil) && (err.Error() != tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+
+			if !reflect.DeepEqual(published, tt.expectedPublished) {
+				t.Errorf("[TestCase '%s'] Published: '%v' | Expected: '%v'", tt.name, published, tt.expectedPublished)
+			}
+
+			if !reflect.DeepEqual(sent, tt.expectedSent) {
+				t.Errorf("[TestCase '%s'] Sent: '%v' | Expected: '%v'", tt.name, sent, tt.expectedSent)
+			}
+
+			if !reflect.DeepEqual(failures, tt.expectedFailures) {
+				t.Errorf("[TestCase '%s'] Failures: '%v' | Expected: '%v'", tt.name, failures, tt.expectedFailures)
+			}
+
+			if !reflect.DeepEqual(failed, tt.expectedFailed) {
+				t.Errorf("[TestCase '%s'] Failed: '%v' | Expected: '%v'", tt.name, failed, tt.expectedFailed)
+			}
+		})
+	}
+}
+
+func TestOutboxRelay_Cleanup(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now       = time.Date(2020, 6, 8, 12, 0, 0, 0, time.UTC)
+		deletedAt time.Time
+		uc        = NewOutboxRelay(mockOutboxRepo{deleted: 3, deletedAt: &deletedAt}, mockMessagePublisher{}, 10, time.Second)
+	)
+	uc.now = func() time.Time { return now }
+
+	result, err := uc.Cleanup(context.Background(), 7*24*time.Hour)
+	if err != nil {
+		t.Errorf("[TestCase 'Cleanup'] Unexpected error: '%v'", err)
+	}
+
+	if result != 3 {
+		t.Errorf("[TestCase 'Cleanup'] Result: '%v' | Expected: '%v'", result, 3)
+	}
+
+	if expected := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC); !deletedAt.Equal(expected) {
+		t.Errorf("[TestCase 'Cleanup'] Deleted before: '%v' | Expected: '%v'", deletedAt, expected)
+	}
+}
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"crypto/rsa"
 	"time"
 
 	"github.com/gsabadini/go-bank-transfer/domain"
@@ -9,7 +10,7 @@ import (
 //TransferPresenter é uma abstração para a apresentação de Account
 type TransferPresenter interface {
 	Output(domain.Transfer) TransferOutput
-	OutputList([]domain.Transfer) []TransferOutput
+	OutputList([]domain.Transfer, domain.Cursor) TransferListOutput
 }
 
 //TransferOutput armazena a estrutura de dados de retorno do caso de uso
@@ -18,26 +19,248 @@ type TransferOutput struct {
 	AccountOriginID      string    `json:"account_origin_id"`
 	AccountDestinationID string    `json:"account_destination_id"`
 	Amount               float64   `json:"amount"`
+	Status               string    `json:"status"`
 	CreatedAt            time.Time `json:"created_at"`
 }
 
+//TransferListOutput armazena a estrutura de dados de uma página de Transfer
+type TransferListOutput struct {
+	Data       []TransferOutput `json:"data"`
+	NextCursor string           `json:"next_cursor"`
+}
+
 //AccountPresenter é uma abstração para os apresentação de Account
 type AccountPresenter interface {
 	Output(domain.Account) AccountOutput
-	OutputList([]domain.Account) []AccountOutput
+	OutputList([]domain.Account, domain.Cursor) AccountListOutput
 	OutputBalance(domain.Money) AccountBalanceOutput
+	OutputBalanceAt(domain.Money, time.Time) AccountBalanceOutput
 }
 
 //AccountOutput armazena a estrutura de dados de retorno do caso de uso
 type AccountOutput struct {
 	ID        string    `json:"id"`
 	Name      string    `json:"name"`
-	CPF       string    `json:"cpf"`
+	TaxID     string    `json:"tax_id"`
+	TaxIDType string    `json:"tax_id_type"`
 	Balance   float64   `json:"balance"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+//AccountListOutput armazena a estrutura de dados de uma página de Account
+type AccountListOutput struct {
+	Data       []AccountOutput `json:"data"`
+	NextCursor string          `json:"next_cursor"`
+}
+
 //AccountBalanceOutput armazena a estrutura de dados de retorno do caso de uso
 type AccountBalanceOutput struct {
-	Balance float64 `json:"balance"`
+	Balance float64    `json:"balance"`
+	At      *time.Time `json:"at,omitempty"`
+}
+
+//StatementPresenter é uma abstração para a apresentação do extrato de uma Account
+type StatementPresenter interface {
+	Output(domain.Statement) StatementOutput
+}
+
+//StatementOutput armazena a estrutura de dados de retorno do caso de uso
+type StatementOutput struct {
+	AccountID      string                 `json:"account_id"`
+	From           time.Time              `json:"from"`
+	To             time.Time              `json:"to"`
+	OpeningBalance float64                `json:"opening_balance"`
+	ClosingBalance float64                `json:"closing_balance"`
+	Entries        []StatementEntryOutput `json:"entries"`
+}
+
+//StatementEntryOutput armazena a estrutura de dados de um lançamento do extrato
+type StatementEntryOutput struct {
+	ID                    string    `json:"id"`
+	Type                  string    `json:"type"`
+	CounterpartyAccountID string    `json:"counterparty_account_id,omitempty"`
+	Amount                float64   `json:"amount"`
+	Balance               float64   `json:"balance"`
+	CreatedAt             time.Time `json:"created_at"`
+}
+
+//DailyClosingPresenter é uma abstração para a apresentação do fechamento diário de saldos
+type DailyClosingPresenter interface {
+	Output(domain.DailyClosing) DailyClosingOutput
+}
+
+//DailyClosingOutput armazena a estrutura de dados de retorno do caso de uso
+type DailyClosingOutput struct {
+	Date          time.Time                  `json:"date"`
+	Closed        int                        `json:"closed"`
+	AlreadyClosed int                        `json:"already_closed"`
+	Discrepancies []BalanceDiscrepancyOutput `json:"discrepancies"`
+}
+
+//BalanceDiscrepancyOutput armazena a estrutura de dados de uma divergência do fechamento diário
+type BalanceDiscrepancyOutput struct {
+	AccountID  string  `json:"account_id"`
+	Expected   float64 `json:"expected"`
+	Closing    float64 `json:"closing"`
+	Difference float64 `json:"difference"`
+}
+
+//ReconciliationPresenter é uma abstração para a apresentação da conciliação de saldos
+type ReconciliationPresenter interface {
+	Output(domain.Reconciliation) ReconciliationOutput
+}
+
+//ReconciliationOutput armazena a estrutura de dados de retorno do caso de uso
+type ReconciliationOutput struct {
+	GeneratedAt time.Time                  `json:"generated_at"`
+	Checked     int                        `json:"checked"`
+	Divergent   int                        `json:"divergent"`
+	Items       []ReconciliationItemOutput `json:"items"`
+}
+
+//ReconciliationItemOutput armazena a estrutura de dados de uma Account com saldo divergente
+type ReconciliationItemOutput struct {
+	AccountID       string  `json:"account_id"`
+	StoredBalance   float64 `json:"stored_balance"`
+	ComputedBalance float64 `json:"computed_balance"`
+	Difference      float64 `json:"difference"`
+	CorrectionID    string  `json:"correction_id,omitempty"`
+}
+
+//BalanceCorrectionPresenter é uma abstração para a apresentação das BalanceCorrection
+type BalanceCorrectionPresenter interface {
+	Output(domain.BalanceCorrection) BalanceCorrectionOutput
+}
+
+//BalanceCorrectionOutput armazena a estrutura de dados de retorno de uma BalanceCorrection
+type BalanceCorrectionOutput struct {
+	ID        string    `json:"id"`
+	AccountID string    `json:"account_id"`
+	Amount    float64   `json:"amount"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+//OutboxRelayOutput armazena a estrutura de dados do resultado de uma execução do relay do outbox
+type OutboxRelayOutput struct {
+	Sent     int                   `json:"sent"`
+	Deferred int                   `json:"deferred"`
+	Failures []OutboxFailureOutput `json:"failures"`
+}
+
+//OutboxFailureOutput armazena a estrutura de dados de uma OutboxMessage cuja publicação falhou
+//
+//Failed indica que a OutboxMessage esgotou as tentativas e não será mais publicada
+type OutboxFailureOutput struct {
+	MessageID string `json:"message_id"`
+	EventType string `json:"event_type"`
+	Error     string `json:"error"`
+	Attempts  int    `json:"attempts"`
+	Failed    bool   `json:"failed"`
+}
+
+//WebhookPresenter é uma abstração para a apresentação das WebhookSubscription e das suas entregas
+type WebhookPresenter interface {
+	Output(domain.WebhookSubscription) WebhookOutput
+	OutputList([]domain.WebhookSubscription) []WebhookOutput
+	OutputDelivery(domain.WebhookDelivery) WebhookDeliveryOutput
+	OutputDeliveries([]domain.WebhookDelivery) []WebhookDeliveryOutput
+}
+
+//WebhookOutput armazena a estrutura de dados de retorno de uma WebhookSubscription
+type WebhookOutput struct {
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	AccountID  string    `json:"account_id,omitempty"`
+	Secret     string    `json:"secret,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+//WebhookDeliveryOutput armazena a estrutura de dados de retorno de uma WebhookDelivery
+type WebhookDeliveryOutput struct {
+	ID             string     `json:"id"`
+	SubscriptionID string     `json:"subscription_id"`
+	MessageID      string     `json:"message_id"`
+	EventType      string     `json:"event_type"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+//APIKeyPresenter é uma abstração para a apresentação das APIKey
+type APIKeyPresenter interface {
+	Output(domain.APIKey) APIKeyOutput
+	OutputList([]domain.APIKey) []APIKeyOutput
+}
+
+//APIKeyOutput armazena a estrutura de dados de retorno de uma APIKey, a chave é retornada apenas na criação e na
+//rotação
+type APIKeyOutput struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	AccountID  string     `json:"account_id,omitempty"`
+	Key        string     `json:"key,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+//OAuthClientPresenter é uma abstração para a apresentação dos OAuthClient
+type OAuthClientPresenter interface {
+	Output(domain.OAuthClient) OAuthClientOutput
+	OutputList([]domain.OAuthClient) []OAuthClientOutput
+}
+
+//OAuthClientOutput armazena a estrutura de dados de retorno de um OAuthClient, o secret é retornado apenas no
+//cadastro
+type OAuthClientOutput struct {
+	ClientID     string    `json:"client_id"`
+	ClientSecret string    `json:"client_secret,omitempty"`
+	Name         string    `json:"name"`
+	Scopes       []string  `json:"scopes"`
+	AccountID    string    `json:"account_id,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+//SigningKeyPresenter é uma abstração para a apresentação das SigningKey
+type SigningKeyPresenter interface {
+	OutputList([]domain.SigningKey) []SigningKeyOutput
+}
+
+//SigningKeyOutput armazena a estrutura de dados de retorno de uma SigningKey, a chave privada nunca é serializada
+type SigningKeyOutput struct {
+	ID         string          `json:"id"`
+	PrivateKey *rsa.PrivateKey `json:"-"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+//WebhookDispatchOutput armazena a estrutura de dados do resultado de uma execução do envio de webhooks
+type WebhookDispatchOutput struct {
+	Succeeded int `json:"succeeded"`
+	Retrying  int `json:"retrying"`
+	Dead      int `json:"dead"`
+}
+
+//AccountEventOutput armazena a estrutura de dados de um evento enviado no acompanhamento de uma Account
+type AccountEventOutput struct {
+	ID   string
+	Type string
+	Data []byte
+}
+
+//AccountEventSubscription armazena os eventos a reenviar desde o último recebido e o canal dos próximos eventos
+//
+//Resync indica que o último evento recebido não está mais disponível e o cliente deve recarregar o estado da
+//Account. O canal é fechado ao final do contexto da inscrição ou quando o cliente não acompanha os eventos
+type AccountEventSubscription struct {
+	Replay []AccountEventOutput
+	Resync bool
+	Events <-chan AccountEventOutput
 }
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//Reconciliation armazena as dependências para os casos de uso de conciliação de saldos
+type Reconciliation struct {
+	accountRepo    domain.AccountRepository
+	transferRepo   domain.TransferRepository
+	correctionRepo domain.BalanceCorrectionRepository
+	presenter      ReconciliationPresenter
+	ctxTimeout     time.Duration
+	now            func() time.Time
+}
+
+//NewReconciliation constrói um Reconciliation com suas dependências
+func NewReconciliation(
+	accountRepo domain.AccountRepository,
+	transferRepo domain.TransferRepository,
+	correctionRepo domain.BalanceCorrectionRepository,
+	presenter ReconciliationPresenter,
+	t time.Duration,
+) Reconciliation {
+	return Reconciliation{
+		accountRepo:    accountRepo,
+		transferRepo:   transferRepo,
+		correctionRepo: correctionRepo,
+		presenter:      presenter,
+		ctxTimeout:     t,
+		now:            time.Now,
+	}
+}
+
+//Execute compara o Balance de cada Account com o saldo inicial somado às suas Transfer
+//
+//Quando openCorrections é informado, abre uma BalanceCorrection pendente de aprovação para cada divergência,
+//mantendo e reportando a pendente já existente de uma Account
+func (r Reconciliation) Execute(ctx context.Context, openCorrections bool) (ReconciliationOutput, error) {
+	var (
+		reconciliation = domain.NewReconciliation(r.now())
+		pagination     = domain.NewPagination(domain.Cursor{}, domain.MaxPageLimit)
+	)
+
+	for {
+		accounts, err := r.findAccounts(ctx, pagination)
+		if err != nil {
+			return r.presenter.Output(reconciliation), err
+		}
+
+		for _, account := range accounts {
+			if err := r.reconcile(ctx, &reconciliation, account, openCorrections); err != nil {
+				return r.presenter.Output(reconciliation), err
+			}
+		}
+
+		if len(accounts) < pagination.Limit {
+			break
+		}
+
+		var last = accounts[len(accounts)-1]
+		pagination = domain.NewPagination(domain.NewCursor(last.CreatedAt(), last.ID().String()), domain.MaxPageLimit)
+	}
+
+	return r.presenter.Output(reconciliation), nil
+}
+
+func (r Reconciliation) findAccounts(ctx context.Context, pagination domain.Pagination) ([]domain.Account, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	return r.accountRepo.FindAll(ctx, pagination)
+}
+
+//reconcile recalcula o saldo de uma Account e registra a divergência na Reconciliation
+func (r Reconciliation) reconcile(
+	ctx context.Context,
+	reconciliation *domain.Reconciliation,
+	account domain.Account,
+	openCorrection bool,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	var now = r.now()
+
+	movements, err := r.transferRepo.NetAmountByAccountID(ctx, account.ID(), time.Time{}, now)
+	if err != nil {
+		return err
+	}
+
+	current, err := r.accountRepo.FindBalance(ctx, account.ID())
+	if err != nil {
+		return err
+	}
+
+	var computed = account.InitialBalance() + movements
+	if !reconciliation.Check(account.ID(), current.Balance(), computed) || !openCorrection {
+		return nil
+	}
+
+	correction, err := r.correctionRepo.Store(ctx, domain.NewBalanceCorrection(
+		domain.BalanceCorrectionID(domain.NewUUID()),
+		account.ID(),
+		computed-current.Balance(),
+		domain.BalanceCorrectionStatusPending,
+		now,
+	))
+	if errors.Is(err, domain.ErrBalanceCorrectionAlreadyExists) {
+		//a divergência continua associada à BalanceCorrection pendente até a sua aprovação ou recusa
+		correction, err = r.correctionRepo.FindPendingByAccountID(ctx, account.ID())
+		if errors.Is(err, domain.ErrNotFound) {
+			//a pendente foi decidida entre as duas operações, a divergência é reportada na próxima conciliação
+			return nil
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	reconciliation.Correct(correction)
+
+	return nil
+}
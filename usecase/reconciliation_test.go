@@ -0,0 +1,254 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+type mockTransferRepoReconciliation struct {
+	domain.TransferRepository
+
+	netAmount domain.Money
+	err       error
+}
+
+func (m mockTransferRepoReconciliation) NetAmountByAccountID(
+	_ context.Context,
+	_ domain.AccountID,
+	_ time.Time,
+	_ time.Time,
+) (domain.Money, error) {
+	return m.netAmount, m.err
+}
+
+type mockBalanceCorrectionRepo struct {
+	domain.BalanceCorrectionRepository
+
+	pending    domain.BalanceCorrection
+	pendingErr error
+	err        error
+}
+
+func (m mockBalanceCorrectionRepo) Store(
+	_ context.Context,
+	correction domain.BalanceCorrection,
+) (domain.BalanceCorrection, error) {
+	if m.err != nil {
+		return domain.BalanceCorrection{}, m.err
+	}
+
+	return domain.NewBalanceCorrection(
+		"b51cd6c7-a55c-491e-9140-91903fe66fa9",
+		correction.AccountID(),
+		correction.Amount(),
+		correction.Status(),
+		correction.CreatedAt(),
+	), nil
+}
+
+func (m mockBalanceCorrectionRepo) FindPendingByAccountID(
+	_ context.Context,
+	_ domain.AccountID,
+) (domain.BalanceCorrection, error) {
+	return m.pending, m.pendingErr
+}
+
+type mockReconciliationPresenter struct{}
+
+func (m mockReconciliationPresenter) Output(reconciliation domain.Reconciliation) ReconciliationOutput {
+	var items = make([]ReconciliationItemOutput, 0)
+
+	for _, item := range reconciliation.Items() {
+		items = append(items, ReconciliationItemOutput{
+			AccountID:       item.AccountID().String(),
+			StoredBalance:   item.Stored().Float64(),
+			ComputedBalance: item.Computed().Float64(),
+			Difference:      item.Difference().Float64(),
+			CorrectionID:    item.CorrectionID().String(),
+		})
+	}
+
+	return ReconciliationOutput{
+		Checked:   reconciliation.Checked(),
+		Divergent: len(items),
+		Items:     items,
+	}
+}
+
+func TestReconciliation_Execute(t *testing.T) {
+	t.Parallel()
+
+	var account = domain.NewAccount(
+		"3c096a40-ccba-4b58-93ed-57379ab04680",
+		"Test",
+		"02815517078",
+		domain.TaxIDTypeCPF,
+		500,
+		100,
+		time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC),
+	)
+
+	tests := []struct {
+		name            string
+		openCorrections bool
+		accountRepo     domain.AccountRepository
+		transferRepo    domain.TransferRepository
+		correctionRepo  domain.BalanceCorrectionRepository
+		expected        ReconciliationOutput
+		expectedError   interface{}
+	}{
+		{
+			name: "Success when balances match the history",
+			accountRepo: mockAccountRepoDailyClosing{
+				accounts: []domain.Account{account},
+				balance:  500,
+			},
+			transferRepo:   mockTransferRepoReconciliation{netAmount: 400},
+			correctionRepo: mockBalanceCorrectionRepo{err: errors.New("must not be called")},
+			expected: ReconciliationOutput{
+				Checked: 1,
+				Items:   []ReconciliationItemOutput{},
+			},
+		},
+		{
+			name: "Success reporting a divergent balance without corrections",
+			accountRepo: mockAccountRepoDailyClosing{
+				accounts: []domain.Account{account},
+				balance:  500,
+			},
+			transferRepo:   mockTransferRepoReconciliation{netAmount: 350},
+			correctionRepo: mockBalanceCorrectionRepo{err: errors.New("must not be called")},
+			expected: ReconciliationOutput{
+				Checked:   1,
+				Divergent: 1,
+				Items: []ReconciliationItemOutput{
+					{
+						AccountID:       "3c096a40-ccba-4b58-93ed-57379ab04680",
+						StoredBalance:   5,
+						ComputedBalance: 4.5,
+						Difference:      0.5,
+					},
+				},
+			},
+		},
+		{
+			name:            "Success opening a correction for a divergent balance",
+			openCorrections: true,
+			accountRepo: mockAccountRepoDailyClosing{
+				accounts: []domain.Account{account},
+				balance:  500,
+			},
+			transferRepo:   mockTransferRepoReconciliation{netAmount: 350},
+			correctionRepo: mockBalanceCorrectionRepo{},
+			expected: ReconciliationOutput{
+				Checked:   1,
+				Divergent: 1,
+				Items: []ReconciliationItemOutput{
+					{
+						AccountID:       "3c096a40-ccba-4b58-93ed-57379ab04680",
+						StoredBalance:   5,
+						ComputedBalance: 4.5,
+						Difference:      0.5,
+						CorrectionID:    "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+					},
+				},
+			},
+		},
+		{
+			name:            "Success reporting the pending correction already open",
+			openCorrections: true,
+			accountRepo: mockAccountRepoDailyClosing{
+				accounts: []domain.Account{account},
+				balance:  500,
+			},
+			transferRepo: mockTransferRepoReconciliation{netAmount: 350},
+			correctionRepo: mockBalanceCorrectionRepo{
+				err: domain.ErrBalanceCorrectionAlreadyExists,
+				pending: domain.NewBalanceCorrection(
+					"0c83e1d2-cc5a-4b48-8e0e-2ec2e2a3a8b4",
+					"3c096a40-ccba-4b58-93ed-57379ab04680",
+					-50,
+					domain.BalanceCorrectionStatusPending,
+					time.Date(2020, 5, 31, 12, 0, 0, 0, time.UTC),
+				),
+			},
+			expected: ReconciliationOutput{
+				Checked:   1,
+				Divergent: 1,
+				Items: []ReconciliationItemOutput{
+					{
+						AccountID:       "3c096a40-ccba-4b58-93ed-57379ab04680",
+						StoredBalance:   5,
+						ComputedBalance: 4.5,
+						Difference:      0.5,
+						CorrectionID:    "0c83e1d2-cc5a-4b48-8e0e-2ec2e2a3a8b4",
+					},
+				},
+			},
+		},
+		{
+			name:            "Success when the pending correction was decided meanwhile",
+			openCorrections: true,
+			accountRepo: mockAccountRepoDailyClosing{
+				accounts: []domain.Account{account},
+				balance:  500,
+			},
+			transferRepo: mockTransferRepoReconciliation{netAmount: 350},
+			correctionRepo: mockBalanceCorrectionRepo{
+				err:        domain.ErrBalanceCorrectionAlreadyExists,
+				pendingErr: domain.ErrNotFound,
+			},
+			expected: ReconciliationOutput{
+				Checked:   1,
+				Divergent: 1,
+				Items: []ReconciliationItemOutput{
+					{
+						AccountID:       "3c096a40-ccba-4b58-93ed-57379ab04680",
+						StoredBalance:   5,
+						ComputedBalance: 4.5,
+						Difference:      0.5,
+					},
+				},
+			},
+		},
+		{
+			name: "Error summing account transfers",
+			accountRepo: mockAccountRepoDailyClosing{
+				accounts: []domain.Account{account},
+				balance:  500,
+			},
+			transferRepo:   mockTransferRepoReconciliation{err: errors.New("error summing account transfers")},
+			correctionRepo: mockBalanceCorrectionRepo{},
+			expectedError:  "error summing account transfers",
+			expected: ReconciliationOutput{
+				Items: []ReconciliationItemOutput{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var uc = NewReconciliation(
+				tt.accountRepo,
+				tt.transferRepo,
+				tt.correctionRepo,
+				mockReconciliationPresenter{},
+				time.Second,
+			)
+
+			result, err := uc.Execute(context.Background(), tt.openCorrections)
+			if (err != nil) && (err.Error() != tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
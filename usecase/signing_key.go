@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//signingKeyBits é o tamanho das chaves RSA geradas para assinar os tokens de acesso
+const signingKeyBits = 2048
+
+//SigningKey armazena as dependências para os casos de uso de SigningKey
+type SigningKey struct {
+	repo       domain.SigningKeyRepository
+	presenter  SigningKeyPresenter
+	rotation   time.Duration
+	tokenTTL   time.Duration
+	refresh    time.Duration
+	ctxTimeout time.Duration
+	now        func() time.Time
+	generate   func() (*rsa.PrivateKey, error)
+}
+
+//NewSigningKey constrói um SigningKey com suas dependências
+//
+//Uma nova chave é gerada a cada rotation, e as anteriores continuam publicadas por tokenTTL mais o intervalo refresh
+//dos KeyRing, já que uma instância pode seguir assinando com a chave anterior até reler as chaves, para que os tokens
+//assinados por elas sejam aceitos até expirarem
+func NewSigningKey(
+	repo domain.SigningKeyRepository,
+	presenter SigningKeyPresenter,
+	rotation time.Duration,
+	tokenTTL time.Duration,
+	refresh time.Duration,
+	t time.Duration,
+) SigningKey {
+	return SigningKey{
+		repo:       repo,
+		presenter:  presenter,
+		rotation:   rotation,
+		tokenTTL:   tokenTTL,
+		refresh:    refresh,
+		ctxTimeout: t,
+		now:        time.Now,
+		generate: func() (*rsa.PrivateKey, error) {
+			return rsa.GenerateKey(rand.Reader, signingKeyBits)
+		},
+	}
+}
+
+//FindPublished retorna as SigningKey publicadas, da mais recente para a mais antiga, gerando uma nova chave quando
+//a mais recente completou o período de rotação. A primeira chave é a que assina os novos tokens
+func (s SigningKey) FindPublished(ctx context.Context) ([]SigningKeyOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	var now = s.now()
+
+	keys, err := s.repo.FindCreatedAfter(ctx, now.Add(-(s.rotation + s.tokenTTL + s.refresh)))
+	if err != nil {
+		return s.presenter.OutputList([]domain.SigningKey{}), err
+	}
+
+	if len(keys) > 0 && now.Sub(keys[0].CreatedAt()) < s.rotation {
+		return s.presenter.OutputList(keys), nil
+	}
+
+	privateKey, err := s.generate()
+	if err != nil {
+		return s.presenter.OutputList([]domain.SigningKey{}), err
+	}
+
+	key, err := s.repo.Store(ctx, domain.NewSigningKey(domain.SigningKeyID(domain.NewUUID()), privateKey, now))
+	if err != nil {
+		return s.presenter.OutputList([]domain.SigningKey{}), err
+	}
+
+	return s.presenter.OutputList(append([]domain.SigningKey{key}, keys...)), nil
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rsa"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+type mockSigningKeyRepo struct {
+	domain.SigningKeyRepository
+
+	keys  []domain.SigningKey
+	since *time.Time
+}
+
+func (m *mockSigningKeyRepo) Store(_ context.Context, key domain.SigningKey) (domain.SigningKey, error) {
+	m.keys = append([]domain.SigningKey{key}, m.keys...)
+	return key, nil
+}
+
+func (m *mockSigningKeyRepo) FindCreatedAfter(_ context.Context, since time.Time) ([]domain.SigningKey, error) {
+	*m.since = since
+
+	var keys []domain.SigningKey
+	for _, key := range m.keys {
+		if key.CreatedAt().After(since) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+type mockSigningKeyPresenter struct{}
+
+func (mockSigningKeyPresenter) OutputList(keys []domain.SigningKey) []SigningKeyOutput {
+	var outputs = make([]SigningKeyOutput, 0, len(keys))
+	for _, key := range keys {
+		outputs = append(outputs, SigningKeyOutput{ID: key.ID().String(), CreatedAt: key.CreatedAt()})
+	}
+
+	return outputs
+}
+
+func TestSigningKey_FindPublished(t *testing.T) {
+	t.Parallel()
+
+	var now = time.Date(2020, 6, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		keys          []domain.SigningKey
+		expectedKeys  int
+		expectedNewID bool
+		expectedIDs   []string
+	}{
+		{
+			name:          "Key generated when there is no key",
+			expectedKeys:  1,
+			expectedNewID: true,
+		},
+		{
+			name: "Current key within the rotation period",
+			keys: []domain.SigningKey{
+				domain.NewSigningKey("current", nil, now.Add(-time.Hour)),
+				domain.NewSigningKey("previous", nil, now.Add(-24*time.Hour-10*time.Minute)),
+			},
+			expectedKeys: 2,
+			expectedIDs:  []string{"current", "previous"},
+		},
+		{
+			name: "Key rotated after the rotation period",
+			keys: []domain.SigningKey{
+				domain.NewSigningKey("current", nil, now.Add(-24*time.Hour)),
+			},
+			expectedKeys:  2,
+			expectedNewID: true,
+		},
+		{
+			name: "Previous key published while the key rings refresh after its token expiration",
+			keys: []domain.SigningKey{
+				domain.NewSigningKey("current", nil, now.Add(-15*time.Minute-30*time.Second)),
+				domain.NewSigningKey("previous", nil, now.Add(-24*time.Hour-15*time.Minute-30*time.Second)),
+			},
+			expectedKeys: 2,
+			expectedIDs:  []string{"current", "previous"},
+		},
+		{
+			name: "Previous key retired once the key rings refreshed after its token expiration",
+			keys: []domain.SigningKey{
+				domain.NewSigningKey("current", nil, now.Add(-16*time.Minute)),
+				domain.NewSigningKey("previous", nil, now.Add(-24*time.Hour-16*time.Minute)),
+			},
+			expectedKeys: 1,
+			expectedIDs:  []string{"current"},
+		},
+		{
+			name: "Retired keys are not published",
+			keys: []domain.SigningKey{
+				domain.NewSigningKey("retired", nil, now.Add(-25*time.Hour)),
+			},
+			expectedKeys:  1,
+			expectedNewID: true,
+		},
+	}
+
+	for _, tt := range tests {
+		var (
+			since     time.Time
+			repo      = &mockSigningKeyRepo{keys: tt.keys, since: &since}
+			uc        = NewSigningKey(repo, mockSigningKeyPresenter{}, 24*time.Hour, 15*time.Minute, time.Minute, time.Second)
+			generated = 0
+		)
+
+		uc.now = func() time.Time { return now }
+		uc.generate = func() (*rsa.PrivateKey, error) {
+			generated++
+			return nil, nil
+		}
+
+		result, err := uc.FindPublished(context.Background())
+		if err != nil {
+			t.Fatalf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, nil)
+		}
+
+		if len(result) != tt.expectedKeys {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, len(result), tt.expectedKeys)
+		}
+
+		if (generated == 1) != tt.expectedNewID {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, generated == 1, tt.expectedNewID)
+		}
+
+		if tt.expectedIDs != nil {
+			var IDs []string
+			for _, key := range result {
+				IDs = append(IDs, key.ID)
+			}
+
+			if !reflect.DeepEqual(IDs, tt.expectedIDs) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, IDs, tt.expectedIDs)
+			}
+		}
+
+		if expected := now.Add(-(24*time.Hour + 15*time.Minute + time.Minute)); !since.Equal(expected) {
+			t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, since, expected)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//Statement armazena as dependências para os casos de uso de extrato
+type Statement struct {
+	accountRepo  domain.AccountRepository
+	transferRepo domain.TransferRepository
+	presenter    StatementPresenter
+	ctxTimeout   time.Duration
+}
+
+//NewStatement constrói um Statement com suas dependências
+func NewStatement(
+	accountRepo domain.AccountRepository,
+	transferRepo domain.TransferRepository,
+	presenter StatementPresenter,
+	t time.Duration,
+) Statement {
+	return Statement{
+		accountRepo:  accountRepo,
+		transferRepo: transferRepo,
+		presenter:    presenter,
+		ctxTimeout:   t,
+	}
+}
+
+//Find retorna o extrato de uma Account no período [from, to)
+func (s Statement) Find(
+	ctx context.Context,
+	ID domain.AccountID,
+	from time.Time,
+	to time.Time,
+) (StatementOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.ctxTimeout)
+	defer cancel()
+
+	account, err := s.accountRepo.FindByID(ctx, ID)
+	if err != nil {
+		return s.presenter.Output(domain.Statement{}), err
+	}
+
+	var previousMovements domain.Money
+	if from.After(account.CreatedAt()) {
+		previousMovements, err = s.transferRepo.NetAmountByAccountID(ctx, ID, time.Time{}, from)
+		if err != nil {
+			return s.presenter.Output(domain.Statement{}), err
+		}
+	}
+
+	transfers, err := s.transferRepo.FindByAccountID(ctx, ID, from, to)
+	if err != nil {
+		return s.presenter.Output(domain.Statement{}), err
+	}
+
+	return s.presenter.Output(domain.NewStatement(account, from, to, previousMovements, transfers)), nil
+}
@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+type mockTransferRepoStatement struct {
+	domain.TransferRepository
+
+	result    []domain.Transfer
+	err       error
+	netAmount domain.Money
+	netErr    error
+}
+
+func (m mockTransferRepoStatement) FindByAccountID(
+	_ context.Context,
+	_ domain.AccountID,
+	_ time.Time,
+	_ time.Time,
+) ([]domain.Transfer, error) {
+	return m.result, m.err
+}
+
+func (m mockTransferRepoStatement) NetAmountByAccountID(
+	_ context.Context,
+	_ domain.AccountID,
+	_ time.Time,
+	_ time.Time,
+) (domain.Money, error) {
+	return m.netAmount, m.netErr
+}
+
+type mockStatementPresenter struct{}
+
+func (m mockStatementPresenter) Output(statement domain.Statement) StatementOutput {
+	var entries = make([]StatementEntryOutput, 0)
+
+	for _, entry := range statement.Entries() {
+		entries = append(entries, StatementEntryOutput{
+			ID:      entry.ID(),
+			Type:    entry.Type().String(),
+			Amount:  entry.Amount().Float64(),
+			Balance: entry.Balance().Float64(),
+		})
+	}
+
+	return StatementOutput{
+		AccountID:      statement.AccountID().String(),
+		OpeningBalance: statement.OpeningBalance().Float64(),
+		ClosingBalance: statement.ClosingBalance().Float64(),
+		Entries:        entries,
+	}
+}
+
+func TestStatement_Find(t *testing.T) {
+	t.Parallel()
+
+	var createdAt = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	type args struct {
+		ID   domain.AccountID
+		from time.Time
+		to   time.Time
+	}
+
+	tests := []struct {
+		name          string
+		args          args
+		accountRepo   domain.AccountRepository
+		transferRepo  domain.TransferRepository
+		expected      StatementOutput
+		expectedError interface{}
+	}{
+		{
+			name: "Success when returning the statement with previous movements",
+			args: args{
+				ID:   "3c096a40-ccba-4b58-93ed-57379ab04680",
+				from: createdAt.Add(24 * time.Hour),
+				to:   createdAt.Add(72 * time.Hour),
+			},
+			accountRepo: mockAccountRepoFindByID{
+				result: domain.NewAccount(
+					"3c096a40-ccba-4b58-93ed-57379ab04680",
+					"Test",
+					"02815517078",
+					domain.TaxIDTypeCPF,
+					150,
+					100,
+					createdAt,
+				),
+			},
+			transferRepo: mockTransferRepoStatement{
+				result: []domain.Transfer{
+					domain.NewTransfer(
+						"b51cd6c7-a55c-491e-9140-91903fe66fa9",
+						"3c096a40-ccba-4b58-93ed-57379ab04680",
+						"3c096a40-ccba-4b58-93ed-57379ab04699",
+						50,
+						domain.TransferStatusCompleted,
+						createdAt.Add(48*time.Hour),
+					),
+				},
+				netAmount: 100,
+			},
+			expected: StatementOutput{
+				AccountID:      "3c096a40-ccba-4b58-93ed-57379ab04680",
+				OpeningBalance: 2,
+				ClosingBalance: 1.5,
+				Entries: []StatementEntryOutput{
+					{
+						ID:      "b51cd6c7-a55c-491e-9140-91903fe66fa9",
+						Type:    "transfer_out",
+						Amount:  -0.5,
+						Balance: 1.5,
+					},
+				},
+			},
+		},
+		{
+			name: "Success when returning the statement since account creation",
+			args: args{
+				ID:   "3c096a40-ccba-4b58-93ed-57379ab04680",
+				from: createdAt.Add(-24 * time.Hour),
+				to:   createdAt.Add(24 * time.Hour),
+			},
+			accountRepo: mockAccountRepoFindByID{
+				result: domain.NewAccount(
+					"3c096a40-ccba-4b58-93ed-57379ab04680",
+					"Test",
+					"02815517078",
+					domain.TaxIDTypeCPF,
+					100,
+					100,
+					createdAt,
+				),
+			},
+			transferRepo: mockTransferRepoStatement{
+				result: []domain.Transfer{},
+				netErr: errors.New("must not be called"),
+			},
+			expected: StatementOutput{
+				AccountID:      "3c096a40-ccba-4b58-93ed-57379ab04680",
+				OpeningBalance: 0,
+				ClosingBalance: 1,
+				Entries: []StatementEntryOutput{
+					{
+						ID:      "3c096a40-ccba-4b58-93ed-57379ab04680",
+						Type:    "initial_deposit",
+						Amount:  1,
+						Balance: 1,
+					},
+				},
+			},
+		},
+		{
+			name: "Error account not found",
+			args: args{
+				ID:   "3c096a40-ccba-4b58-93ed-57379ab04680",
+				from: createdAt,
+				to:   createdAt.Add(24 * time.Hour),
+			},
+			accountRepo: mockAccountRepoFindByID{
+				err: domain.ErrNotFound,
+			},
+			transferRepo:  mockTransferRepoStatement{},
+			expectedError: "not found",
+			expected: StatementOutput{
+				Entries: []StatementEntryOutput{},
+			},
+		},
+		{
+			name: "Error listing account transfers",
+			args: args{
+				ID:   "3c096a40-ccba-4b58-93ed-57379ab04680",
+				from: createdAt.Add(24 * time.Hour),
+				to:   createdAt.Add(48 * time.Hour),
+			},
+			accountRepo: mockAccountRepoFindByID{
+				result: domain.NewAccount(
+					"3c096a40-ccba-4b58-93ed-57379ab04680",
+					"Test",
+					"02815517078",
+					domain.TaxIDTypeCPF,
+					100,
+					100,
+					createdAt,
+				),
+			},
+			transferRepo: mockTransferRepoStatement{
+				err: errors.New("error listing account transfers"),
+			},
+			expectedError: "error listing account transfers",
+			expected: StatementOutput{
+				Entries: []StatementEntryOutput{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var uc = NewStatement(tt.accountRepo, tt.transferRepo, mockStatementPresenter{}, time.Second)
+
+			result, err := uc.Find(context.Background(), tt.args.ID, tt.args.from, tt.args.to)
+			if (err != nil) && (err.Error() != tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/gsabadini/go-bank-transfer/domain"
@@ -12,6 +13,8 @@ type Transfer struct {
 	transferRepo domain.TransferRepository
 	accountRepo  domain.AccountRepository
 	presenter    TransferPresenter
+	publisher    EventPublisher
+	transactor   domain.Transactor
 	ctxTimeout   time.Duration
 }
 
@@ -20,17 +23,24 @@ func NewTransfer(
 	transferRepo domain.TransferRepository,
 	accountRepo domain.AccountRepository,
 	presenter TransferPresenter,
+	publisher EventPublisher,
+	transactor domain.Transactor,
 	t time.Duration,
 ) Transfer {
 	return Transfer{
 		transferRepo: transferRepo,
 		accountRepo:  accountRepo,
 		presenter:    presenter,
+		publisher:    publisher,
+		transactor:   transactor,
 		ctxTimeout:   t,
 	}
 }
 
-//Store cria uma nova Transfer
+//Store cria uma nova Transfer e publica as alterações de saldo junto com o seu resultado
+//
+//Os saldos, a Transfer e os eventos são gravados na mesma transação. Em caso de falha a transação é desfeita
+//e apenas o TransferFailed é publicado
 func (t Transfer) Store(
 	ctx context.Context,
 	accountOriginID domain.AccountID,
@@ -40,68 +50,108 @@ func (t Transfer) Store(
 	ctx, cancel := context.WithTimeout(ctx, t.ctxTimeout)
 	defer cancel()
 
-	if err := t.process(ctx, accountOriginID, accountDestinationID, amount); err != nil {
-		return t.presenter.Output(domain.Transfer{}), err
-	}
-
-	var transfer = domain.NewTransfer(
-		domain.TransferID(domain.NewUUID()),
-		accountOriginID,
-		accountDestinationID,
-		amount,
-		time.Now(),
-	)
-
-	transfer, err := t.transferRepo.Store(ctx, transfer)
+	var transfer domain.Transfer
+	err := t.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		events, err := t.process(ctx, accountOriginID, accountDestinationID, amount)
+		if err != nil {
+			return err
+		}
+
+		transfer, err = t.transferRepo.Store(ctx, domain.NewTransfer(
+			domain.TransferID(domain.NewUUID()),
+			accountOriginID,
+			accountDestinationID,
+			amount,
+			domain.TransferStatusCompleted,
+			time.Now(),
+		))
+		if err != nil {
+			return err
+		}
+
+		return t.publisher.Publish(ctx, append(events, domain.NewTransferCompleted(transfer))...)
+	})
 	if err != nil {
+		//o erro da Transfer é o relevante para o cliente, uma falha ao publicar o TransferFailed não o substitui
+		_ = t.publisher.Publish(
+			ctx,
+			domain.NewTransferFailed(accountOriginID, accountDestinationID, amount, err, time.Now()),
+		)
+
 		return t.presenter.Output(domain.Transfer{}), err
 	}
 
 	return t.presenter.Output(transfer), nil
 }
 
+//process atualiza os saldos das Account e retorna os eventos das alterações efetivadas
 func (t Transfer) process(
 	ctx context.Context,
 	accountOriginID domain.AccountID,
 	accountDestinationID domain.AccountID,
 	amount domain.Money,
-) error {
+) ([]domain.Event, error) {
+	var events = make([]domain.Event, 0, 2)
+
 	origin, err := t.accountRepo.FindByID(ctx, accountOriginID)
 	if err != nil {
-		return err
+		if errors.Is(err, domain.ErrNotFound) {
+			return events, domain.ErrAccountOriginNotFound
+		}
+
+		return events, err
 	}
 
+	var originPrevious = origin.Balance()
 	if err := origin.Withdraw(amount); err != nil {
-		return err
+		return events, err
 	}
 
 	destination, err := t.accountRepo.FindByID(ctx, accountDestinationID)
 	if err != nil {
-		return err
+		if errors.Is(err, domain.ErrNotFound) {
+			return events, domain.ErrAccountDestinationNotFound
+		}
+
+		return events, err
 	}
 
+	var destinationPrevious = destination.Balance()
 	destination.Deposit(amount)
 
 	if err = t.accountRepo.UpdateBalance(ctx, origin.ID(), origin.Balance()); err != nil {
-		return err
+		return events, err
 	}
+	events = append(events, domain.NewBalanceChanged(origin.ID(), originPrevious, origin.Balance(), time.Now()))
 
 	if err = t.accountRepo.UpdateBalance(ctx, destination.ID(), destination.Balance()); err != nil {
-		return err
+		return events, err
 	}
+	events = append(events, domain.NewBalanceChanged(destination.ID(), destinationPrevious, destination.Balance(), time.Now()))
 
-	return nil
+	return events, nil
 }
 
-//FindAll retorna uma lista de transferências
-func (t Transfer) FindAll(ctx context.Context) ([]TransferOutput, error) {
+//FindAll retorna uma página de transferências que atendem aos critérios da TransferQuery
+func (t Transfer) FindAll(ctx context.Context, query domain.TransferQuery) (TransferListOutput, error) {
 	ctx, cancel := context.WithTimeout(ctx, t.ctxTimeout)
 	defer cancel()
 
-	transfers, err := t.transferRepo.FindAll(ctx)
+	var pagination = domain.NewPagination(query.Pagination.After, query.Pagination.Limit)
+	query.Pagination = domain.NewPagination(pagination.After, pagination.Limit+1)
+
+	transfers, err := t.transferRepo.FindAll(ctx, query)
 	if err != nil {
-		return t.presenter.OutputList([]domain.Transfer{}), err
+		return t.presenter.OutputList([]domain.Transfer{}, domain.Cursor{}), err
+	}
+
+	var next domain.Cursor
+	if len(transfers) > pagination.Limit {
+		transfers = transfers[:pagination.Limit]
+
+		var last = transfers[len(transfers)-1]
+		next = domain.NewCursor(last.CreatedAt(), last.ID().String()).WithAmount(last.Amount())
 	}
 
-	return t.presenter.OutputList(transfers), nil
+	return t.presenter.OutputList(transfers, next), nil
 }
@@ -3,11 +3,13 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/gsabadini/go-bank-transfer/domain"
+	"github.com/gsabadini/go-bank-transfer/infrastructure/event"
 )
 
 type mockTransferRepoStore struct {
@@ -79,13 +81,14 @@ func TestTransfer_Store(t *testing.T) {
 	}
 
 	tests := []struct {
-		name          string
-		args          args
-		transferRepo  domain.TransferRepository
-		accountRepo   domain.AccountRepository
-		presenter     TransferPresenter
-		expected      TransferOutput
-		expectedError string
+		name           string
+		args           args
+		transferRepo   domain.TransferRepository
+		accountRepo    domain.AccountRepository
+		presenter      TransferPresenter
+		expected       TransferOutput
+		expectedEvents []domain.EventType
+		expectedError  string
 	}{
 		{
 			name: "Create transfer successful",
@@ -100,6 +103,7 @@ func TestTransfer_Store(t *testing.T) {
 					"3c096a40-ccba-4b58-93ed-57379ab04681",
 					"3c096a40-ccba-4b58-93ed-57379ab04682",
 					2999,
+					domain.TransferStatusCompleted,
 					time.Time{},
 				),
 				err: nil,
@@ -116,6 +120,8 @@ func TestTransfer_Store(t *testing.T) {
 						"3c096a40-ccba-4b58-93ed-57379ab04681",
 						"Test",
 						"08098565895",
+						domain.TaxIDTypeCPF,
+						5000,
 						5000,
 						time.Time{},
 					), nil
@@ -125,6 +131,8 @@ func TestTransfer_Store(t *testing.T) {
 						"3c096a40-ccba-4b58-93ed-57379ab04682",
 						"Test2",
 						"13098565491",
+						domain.TaxIDTypeCPF,
+						3000,
 						3000,
 						time.Time{},
 					), nil
@@ -146,6 +154,7 @@ func TestTransfer_Store(t *testing.T) {
 				Amount:               29.99,
 				CreatedAt:            time.Time{},
 			},
+			expectedEvents: []domain.EventType{domain.EventBalanceChanged, domain.EventBalanceChanged, domain.EventTransferCompleted},
 		},
 		{
 			name: "Create transfer generic error transfer repository",
@@ -171,6 +180,8 @@ func TestTransfer_Store(t *testing.T) {
 						"3c096a40-ccba-4b58-93ed-57379ab04681",
 						"Test",
 						"08098565895",
+						domain.TaxIDTypeCPF,
+						1000,
 						1000,
 						time.Time{},
 					), nil
@@ -180,6 +191,8 @@ func TestTransfer_Store(t *testing.T) {
 						"3c096a40-ccba-4b58-93ed-57379ab04682",
 						"Test2",
 						"13098565491",
+						domain.TaxIDTypeCPF,
+						3000,
 						3000,
 						time.Time{},
 					), nil
@@ -188,8 +201,9 @@ func TestTransfer_Store(t *testing.T) {
 			presenter: mockTransferPresenterStore{
 				result: TransferOutput{},
 			},
-			expectedError: "error",
-			expected:      TransferOutput{},
+			expectedError:  "error",
+			expectedEvents: []domain.EventType{domain.EventTransferFailed},
+			expected:       TransferOutput{},
 		},
 		{
 			name: "Create transfer error find origin account",
@@ -216,8 +230,67 @@ func TestTransfer_Store(t *testing.T) {
 			presenter: mockTransferPresenterStore{
 				result: TransferOutput{},
 			},
-			expectedError: "error",
-			expected:      TransferOutput{},
+			expectedError:  "error",
+			expectedEvents: []domain.EventType{domain.EventTransferFailed},
+			expected:       TransferOutput{},
+		},
+		{
+			name: "Create transfer error origin account not found",
+			args: args{
+				accountOriginID:      "3c096a40-ccba-4b58-93ed-57379ab04680",
+				accountDestinationID: "3c096a40-ccba-4b58-93ed-57379ab04681",
+				amount:               1999,
+			},
+			transferRepo: mockTransferRepoStore{
+				result: domain.Transfer{},
+				err:    nil,
+			},
+			accountRepo: mockAccountRepo{
+				findByIDOriginFake: func() (domain.Account, error) {
+					return domain.Account{}, fmt.Errorf("error fetching account: %w", domain.ErrNotFound)
+				},
+			},
+			presenter: mockTransferPresenterStore{
+				result: TransferOutput{},
+			},
+			expectedError:  "account origin not found",
+			expectedEvents: []domain.EventType{domain.EventTransferFailed},
+			expected:       TransferOutput{},
+		},
+		{
+			name: "Create transfer error destination account not found",
+			args: args{
+				accountOriginID:      "3c096a40-ccba-4b58-93ed-57379ab04680",
+				accountDestinationID: "3c096a40-ccba-4b58-93ed-57379ab04681",
+				amount:               100,
+			},
+			transferRepo: mockTransferRepoStore{
+				result: domain.Transfer{},
+				err:    nil,
+			},
+			accountRepo: &mockAccountRepo{
+				findByIDOriginFake: func() (domain.Account, error) {
+					return domain.NewAccount(
+						"3c096a40-ccba-4b58-93ed-57379ab04681",
+						"Test",
+						"08098565895",
+						domain.TaxIDTypeCPF,
+						5000,
+						5000,
+						time.Time{},
+					), nil
+				},
+				findByIDDestinationFake: func() (domain.Account, error) {
+					return domain.Account{}, fmt.Errorf("error fetching account: %w", domain.ErrNotFound)
+				},
+				invokedFind: &invoked{call: false},
+			},
+			presenter: mockTransferPresenterStore{
+				result: TransferOutput{},
+			},
+			expectedError:  "account destination not found",
+			expectedEvents: []domain.EventType{domain.EventTransferFailed},
+			expected:       TransferOutput{},
 		},
 		{
 			name: "Create transfer error find destination account",
@@ -242,6 +315,8 @@ func TestTransfer_Store(t *testing.T) {
 						"3c096a40-ccba-4b58-93ed-57379ab04681",
 						"Test",
 						"08098565895",
+						domain.TaxIDTypeCPF,
+						5000,
 						5000,
 						time.Time{},
 					), nil
@@ -254,8 +329,9 @@ func TestTransfer_Store(t *testing.T) {
 			presenter: mockTransferPresenterStore{
 				result: TransferOutput{},
 			},
-			expectedError: "error",
-			expected:      TransferOutput{},
+			expectedError:  "error",
+			expectedEvents: []domain.EventType{domain.EventTransferFailed},
+			expected:       TransferOutput{},
 		},
 		{
 			name: "Create transfer error update origin account",
@@ -280,6 +356,8 @@ func TestTransfer_Store(t *testing.T) {
 						"3c096a40-ccba-4b58-93ed-57379ab04681",
 						"Test",
 						"08098565895",
+						domain.TaxIDTypeCPF,
+						5999,
 						5999,
 						time.Time{},
 					), nil
@@ -289,6 +367,8 @@ func TestTransfer_Store(t *testing.T) {
 						"3c096a40-ccba-4b58-93ed-57379ab04682",
 						"Test2",
 						"13098565491",
+						domain.TaxIDTypeCPF,
+						2999,
 						2999,
 						time.Time{},
 					), nil
@@ -297,8 +377,9 @@ func TestTransfer_Store(t *testing.T) {
 			presenter: mockTransferPresenterStore{
 				result: TransferOutput{},
 			},
-			expectedError: "error",
-			expected:      TransferOutput{},
+			expectedError:  "error",
+			expectedEvents: []domain.EventType{domain.EventTransferFailed},
+			expected:       TransferOutput{},
 		},
 		{
 			name: "Create transfer error update destination account",
@@ -324,6 +405,8 @@ func TestTransfer_Store(t *testing.T) {
 						"3c096a40-ccba-4b58-93ed-57379ab04681",
 						"Test",
 						"08098565895",
+						domain.TaxIDTypeCPF,
+						200,
 						200,
 						time.Time{},
 					), nil
@@ -333,6 +416,8 @@ func TestTransfer_Store(t *testing.T) {
 						"3c096a40-ccba-4b58-93ed-57379ab04682",
 						"Test2",
 						"13098565491",
+						domain.TaxIDTypeCPF,
+						100,
 						100,
 						time.Time{},
 					), nil
@@ -341,8 +426,9 @@ func TestTransfer_Store(t *testing.T) {
 			presenter: mockTransferPresenterStore{
 				result: TransferOutput{},
 			},
-			expectedError: "error",
-			expected:      TransferOutput{},
+			expectedError:  "error",
+			expectedEvents: []domain.EventType{domain.EventTransferFailed},
+			expected:       TransferOutput{},
 		},
 		{
 			name: "Create transfer amount not have sufficient",
@@ -368,6 +454,8 @@ func TestTransfer_Store(t *testing.T) {
 						"3c096a40-ccba-4b58-93ed-57379ab04681",
 						"Test",
 						"08098565895",
+						domain.TaxIDTypeCPF,
+						0,
 						0,
 						time.Time{},
 					), nil
@@ -377,6 +465,8 @@ func TestTransfer_Store(t *testing.T) {
 						"3c096a40-ccba-4b58-93ed-57379ab04682",
 						"Test2",
 						"13098565491",
+						domain.TaxIDTypeCPF,
+						0,
 						0,
 						time.Time{},
 					), nil
@@ -385,14 +475,18 @@ func TestTransfer_Store(t *testing.T) {
 			presenter: mockTransferPresenterStore{
 				result: TransferOutput{},
 			},
-			expectedError: "origin account does not have sufficient balance",
-			expected:      TransferOutput{},
+			expectedError:  "origin account does not have sufficient balance",
+			expectedEvents: []domain.EventType{domain.EventTransferFailed},
+			expected:       TransferOutput{},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var uc = NewTransfer(tt.transferRepo, tt.accountRepo, tt.presenter, time.Second)
+			var (
+				publisher = event.NewRecorder()
+				uc        = NewTransfer(tt.transferRepo, tt.accountRepo, tt.presenter, publisher, mockTransactor{}, time.Second)
+			)
 
 			got, err := uc.Store(
 				context.Background(),
@@ -408,6 +502,10 @@ func TestTransfer_Store(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.expected) {
 				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, got, tt.expected)
 			}
+
+			if !reflect.DeepEqual(publisher.Types(), tt.expectedEvents) {
+				t.Errorf("[TestCase '%s'] Events: '%v' | Expected: '%v'", tt.name, publisher.Types(), tt.expectedEvents)
+			}
 		})
 	}
 }
@@ -419,124 +517,154 @@ type mockTransferRepoFindAll struct {
 	err    error
 }
 
-func (m mockTransferRepoFindAll) FindAll(_ context.Context) ([]domain.Transfer, error) {
+func (m mockTransferRepoFindAll) FindAll(_ context.Context, query domain.TransferQuery) ([]domain.Transfer, error) {
+	if len(m.result) > query.Pagination.Limit {
+		return m.result[:query.Pagination.Limit], m.err
+	}
+
 	return m.result, m.err
 }
 
 type mockTransferPresenterFindAll struct {
 	TransferPresenter
-
-	result []TransferOutput
 }
 
-func (m mockTransferPresenterFindAll) OutputList(_ []domain.Transfer) []TransferOutput {
-	return m.result
-}
+func (m mockTransferPresenterFindAll) OutputList(transfers []domain.Transfer, next domain.Cursor) TransferListOutput {
+	var output = make([]TransferOutput, 0)
+
+	for _, transfer := range transfers {
+		output = append(output, TransferOutput{
+			ID:                   transfer.ID().String(),
+			AccountOriginID:      transfer.AccountOriginID().String(),
+			AccountDestinationID: transfer.AccountDestinationID().String(),
+			Amount:               transfer.Amount().Float64(),
+			Status:               transfer.Status().String(),
+			CreatedAt:            transfer.CreatedAt(),
+		})
+	}
 
-func (m mockTransferPresenterFindAll) OutputListEmpty() []TransferOutput {
-	return make([]TransferOutput, 0)
+	return TransferListOutput{Data: output, NextCursor: next.Encode()}
 }
 
 func TestTransfer_FindAll(t *testing.T) {
 	t.Parallel()
 
+	var (
+		createdAt = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		transfers = []domain.Transfer{
+			domain.NewTransfer(
+				"3c096a40-ccba-4b58-93ed-57379ab04680",
+				"3c096a40-ccba-4b58-93ed-57379ab04681",
+				"3c096a40-ccba-4b58-93ed-57379ab04682",
+				100,
+				domain.TransferStatusCompleted,
+				createdAt,
+			),
+			domain.NewTransfer(
+				"3c096a40-ccba-4b58-93ed-57379ab04683",
+				"3c096a40-ccba-4b58-93ed-57379ab04681",
+				"3c096a40-ccba-4b58-93ed-57379ab04682",
+				500,
+				domain.TransferStatusCompleted,
+				createdAt.Add(time.Minute),
+			),
+		}
+	)
+
 	tests := []struct {
 		name          string
-		expected      []TransferOutput
+		query         domain.TransferQuery
+		expected      TransferListOutput
 		transferRepo  domain.TransferRepository
 		accountRepo   domain.AccountRepository
-		presenter     TransferPresenter
 		expectedError string
 	}{
 		{
-			name: "Success when returning the transfer list",
+			name:  "Success when returning the transfer list",
+			query: domain.TransferQuery{},
 			transferRepo: mockTransferRepoFindAll{
-				result: []domain.Transfer{
-					domain.NewTransfer(
-						"3c096a40-ccba-4b58-93ed-57379ab04680",
-						"3c096a40-ccba-4b58-93ed-57379ab04681",
-						"3c096a40-ccba-4b58-93ed-57379ab04682",
-						100,
-						time.Time{},
-					),
-					domain.NewTransfer(
-						"3c096a40-ccba-4b58-93ed-57379ab04680",
-						"3c096a40-ccba-4b58-93ed-57379ab04681",
-						"3c096a40-ccba-4b58-93ed-57379ab04682",
-						500,
-						time.Time{},
-					),
-				},
-				err: nil,
+				result: transfers,
+				err:    nil,
 			},
 			accountRepo: mockAccountRepo{},
-			presenter: mockTransferPresenterFindAll{
-				result: []TransferOutput{
+			expected: TransferListOutput{
+				Data: []TransferOutput{
 					{
 						ID:                   "3c096a40-ccba-4b58-93ed-57379ab04680",
 						AccountOriginID:      "3c096a40-ccba-4b58-93ed-57379ab04681",
 						AccountDestinationID: "3c096a40-ccba-4b58-93ed-57379ab04682",
 						Amount:               1,
-						CreatedAt:            time.Time{},
+						Status:               "completed",
+						CreatedAt:            createdAt,
 					},
 					{
-						ID:                   "3c096a40-ccba-4b58-93ed-57379ab04680",
+						ID:                   "3c096a40-ccba-4b58-93ed-57379ab04683",
 						AccountOriginID:      "3c096a40-ccba-4b58-93ed-57379ab04681",
 						AccountDestinationID: "3c096a40-ccba-4b58-93ed-57379ab04682",
 						Amount:               5,
-						CreatedAt:            time.Time{},
+						Status:               "completed",
+						CreatedAt:            createdAt.Add(time.Minute),
 					},
 				},
 			},
-			expected: []TransferOutput{
-				{
-					ID:                   "3c096a40-ccba-4b58-93ed-57379ab04680",
-					AccountOriginID:      "3c096a40-ccba-4b58-93ed-57379ab04681",
-					AccountDestinationID: "3c096a40-ccba-4b58-93ed-57379ab04682",
-					Amount:               1,
-					CreatedAt:            time.Time{},
-				},
-				{
-					ID:                   "3c096a40-ccba-4b58-93ed-57379ab04680",
-					AccountOriginID:      "3c096a40-ccba-4b58-93ed-57379ab04681",
-					AccountDestinationID: "3c096a40-ccba-4b58-93ed-57379ab04682",
-					Amount:               5,
-					CreatedAt:            time.Time{},
+		},
+		{
+			name:  "Success when returning a page with next cursor",
+			query: domain.TransferQuery{Pagination: domain.NewPagination(domain.Cursor{}, 1)},
+			transferRepo: mockTransferRepoFindAll{
+				result: transfers,
+				err:    nil,
+			},
+			accountRepo: mockAccountRepo{},
+			expected: TransferListOutput{
+				Data: []TransferOutput{
+					{
+						ID:                   "3c096a40-ccba-4b58-93ed-57379ab04680",
+						AccountOriginID:      "3c096a40-ccba-4b58-93ed-57379ab04681",
+						AccountDestinationID: "3c096a40-ccba-4b58-93ed-57379ab04682",
+						Amount:               1,
+						Status:               "completed",
+						CreatedAt:            createdAt,
+					},
 				},
+				NextCursor: domain.NewCursor(createdAt, "3c096a40-ccba-4b58-93ed-57379ab04680").WithAmount(100).Encode(),
 			},
 		},
 		{
-			name: "Success when returning the empty transfer list",
+			name:  "Success when returning the empty transfer list",
+			query: domain.TransferQuery{},
 			transferRepo: mockTransferRepoFindAll{
 				result: []domain.Transfer{},
 				err:    nil,
 			},
 			accountRepo: mockAccountRepo{},
-			presenter: mockTransferPresenterFindAll{
-				result: []TransferOutput{},
-			},
-			expected: []TransferOutput{},
+			expected:    TransferListOutput{Data: []TransferOutput{}},
 		},
 		{
-			name: "Error when returning the transfer list",
+			name:  "Error when returning the transfer list",
+			query: domain.TransferQuery{},
 			transferRepo: mockTransferRepoFindAll{
 				result: []domain.Transfer{},
 				err:    errors.New("error"),
 			},
-			accountRepo: mockAccountRepo{},
-			presenter: mockTransferPresenterFindAll{
-				result: []TransferOutput{},
-			},
-			expected:      []TransferOutput{},
+			accountRepo:   mockAccountRepo{},
+			expected:      TransferListOutput{Data: []TransferOutput{}},
 			expectedError: "error",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var uc = NewTransfer(tt.transferRepo, tt.accountRepo, tt.presenter, time.Second)
+			var uc = NewTransfer(
+				tt.transferRepo,
+				tt.accountRepo,
+				mockTransferPresenterFindAll{},
+				event.NewRecorder(),
+				mockTransactor{},
+				time.Second,
+			)
 
-			result, err := uc.FindAll(context.Background())
+			result, err := uc.FindAll(context.Background(), tt.query)
 			if (err != nil) && (err.Error() != tt.expectedError) {
 				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
 				return
@@ -2,19 +2,97 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/gsabadini/go-bank-transfer/domain"
 )
 
 //AccountUseCase é uma abstração para os casos de uso de Account
 type AccountUseCase interface {
-	Store(context.Context, string, string, domain.Money) (AccountOutput, error)
-	FindAll(context.Context) ([]AccountOutput, error)
+	Store(context.Context, string, string, domain.TaxIDType, domain.Money) (AccountOutput, error)
+	FindAll(context.Context, domain.Pagination) (AccountListOutput, error)
+	FindByID(context.Context, domain.AccountID) (AccountOutput, error)
+	FindByIDs(context.Context, []domain.AccountID) ([]AccountOutput, error)
 	FindBalance(context.Context, domain.AccountID) (AccountBalanceOutput, error)
 }
 
 //TransferUseCase é uma abstração para os casos de uso de Transfer
 type TransferUseCase interface {
 	Store(context.Context, domain.AccountID, domain.AccountID, domain.Money) (TransferOutput, error)
-	FindAll(context.Context) ([]TransferOutput, error)
+	FindAll(context.Context, domain.TransferQuery) (TransferListOutput, error)
+}
+
+//StatementUseCase é uma abstração para os casos de uso de extrato
+type StatementUseCase interface {
+	Find(context.Context, domain.AccountID, time.Time, time.Time) (StatementOutput, error)
+}
+
+//AccountEventUseCase é uma abstração para o acompanhamento em tempo real dos eventos de uma Account
+type AccountEventUseCase interface {
+	Subscribe(context.Context, domain.AccountID, string) (AccountEventSubscription, error)
+}
+
+//BalanceUseCase é uma abstração para os casos de uso de saldo histórico
+type BalanceUseCase interface {
+	FindAt(context.Context, domain.AccountID, time.Time) (AccountBalanceOutput, error)
+}
+
+//DailyClosingUseCase é uma abstração para os casos de uso de fechamento diário de saldos
+type DailyClosingUseCase interface {
+	Execute(context.Context, time.Time) (DailyClosingOutput, error)
+}
+
+//ReconciliationUseCase é uma abstração para os casos de uso de conciliação de saldos
+type ReconciliationUseCase interface {
+	Execute(context.Context, bool) (ReconciliationOutput, error)
+}
+
+//BalanceCorrectionUseCase é uma abstração para os casos de uso de aprovação das BalanceCorrection
+type BalanceCorrectionUseCase interface {
+	Approve(context.Context, domain.BalanceCorrectionID) (BalanceCorrectionOutput, error)
+	Reject(context.Context, domain.BalanceCorrectionID) (BalanceCorrectionOutput, error)
+}
+
+//OutboxRelayUseCase é uma abstração para os casos de uso de publicação das mensagens do outbox
+type OutboxRelayUseCase interface {
+	Relay(context.Context, int) (OutboxRelayOutput, error)
+	Cleanup(context.Context, time.Duration) (int64, error)
+}
+
+//WebhookUseCase é uma abstração para os casos de uso de WebhookSubscription
+type WebhookUseCase interface {
+	Store(context.Context, string, []domain.EventType, domain.AccountID) (WebhookOutput, error)
+	Update(context.Context, domain.WebhookSubscriptionID, string, []domain.EventType, domain.AccountID) (WebhookOutput, error)
+	Delete(context.Context, domain.WebhookSubscriptionID) error
+	FindAll(context.Context) ([]WebhookOutput, error)
+	FindByID(context.Context, domain.WebhookSubscriptionID) (WebhookOutput, error)
+	FindDeliveries(context.Context, domain.WebhookSubscriptionID, domain.WebhookDeliveryStatus, int) ([]WebhookDeliveryOutput, error)
+	Replay(context.Context, domain.WebhookSubscriptionID, domain.WebhookDeliveryID) (WebhookDeliveryOutput, error)
+}
+
+//APIKeyUseCase é uma abstração para os casos de uso de APIKey
+type APIKeyUseCase interface {
+	Store(context.Context, string, []domain.Scope, domain.AccountID, time.Time) (APIKeyOutput, error)
+	Rotate(context.Context, domain.APIKeyID, time.Duration) (APIKeyOutput, error)
+	Revoke(context.Context, domain.APIKeyID) error
+	FindAll(context.Context) ([]APIKeyOutput, error)
+	Authenticate(context.Context, string) (APIKeyOutput, error)
+}
+
+//OAuthClientUseCase é uma abstração para os casos de uso de OAuthClient
+type OAuthClientUseCase interface {
+	Store(context.Context, string, []domain.Scope, domain.AccountID) (OAuthClientOutput, error)
+	Delete(context.Context, domain.OAuthClientID) error
+	FindAll(context.Context) ([]OAuthClientOutput, error)
+	Authenticate(context.Context, domain.OAuthClientID, string, []domain.Scope) (OAuthClientOutput, error)
+}
+
+//SigningKeyUseCase é uma abstração para os casos de uso de SigningKey
+type SigningKeyUseCase interface {
+	FindPublished(context.Context) ([]SigningKeyOutput, error)
+}
+
+//WebhookDispatchUseCase é uma abstração para os casos de uso de envio das entregas de webhooks
+type WebhookDispatchUseCase interface {
+	Deliver(context.Context, int) (WebhookDispatchOutput, error)
 }
@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//webhookSecretPrefix identifica as chaves de assinatura geradas para as WebhookSubscription
+const webhookSecretPrefix = "whsec_"
+
+//Webhook armazena as dependências para os casos de uso de WebhookSubscription
+type Webhook struct {
+	subscriptionRepo domain.WebhookSubscriptionRepository
+	deliveryRepo     domain.WebhookDeliveryRepository
+	presenter        WebhookPresenter
+	ctxTimeout       time.Duration
+	now              func() time.Time
+}
+
+//NewWebhook constrói um Webhook com suas dependências
+func NewWebhook(
+	subscriptionRepo domain.WebhookSubscriptionRepository,
+	deliveryRepo domain.WebhookDeliveryRepository,
+	presenter WebhookPresenter,
+	t time.Duration,
+) Webhook {
+	return Webhook{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		presenter:        presenter,
+		ctxTimeout:       t,
+		now:              time.Now,
+	}
+}
+
+//Store cria uma nova WebhookSubscription, a chave de assinatura é retornada apenas na criação
+func (w Webhook) Store(
+	ctx context.Context,
+	url string,
+	eventTypes []domain.EventType,
+	accountID domain.AccountID,
+) (WebhookOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.ctxTimeout)
+	defer cancel()
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return w.presenter.Output(domain.WebhookSubscription{}), err
+	}
+
+	subscription, err := w.subscriptionRepo.Store(ctx, domain.NewWebhookSubscription(
+		domain.WebhookSubscriptionID(domain.NewUUID()),
+		url,
+		secret,
+		eventTypes,
+		accountID,
+		w.now(),
+	))
+	if err != nil {
+		return w.presenter.Output(domain.WebhookSubscription{}), err
+	}
+
+	var output = w.presenter.Output(subscription)
+	output.Secret = subscription.Secret()
+
+	return output, nil
+}
+
+//Update altera a URL, os tipos de eventos e a Account de uma WebhookSubscription, mantendo a chave de assinatura
+func (w Webhook) Update(
+	ctx context.Context,
+	ID domain.WebhookSubscriptionID,
+	url string,
+	eventTypes []domain.EventType,
+	accountID domain.AccountID,
+) (WebhookOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.ctxTimeout)
+	defer cancel()
+
+	current, err := w.subscriptionRepo.FindByID(ctx, ID)
+	if err != nil {
+		return w.presenter.Output(domain.WebhookSubscription{}), err
+	}
+
+	var subscription = domain.NewWebhookSubscription(
+		current.ID(),
+		url,
+		current.Secret(),
+		eventTypes,
+		accountID,
+		current.CreatedAt(),
+	)
+
+	if err := w.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return w.presenter.Output(domain.WebhookSubscription{}), err
+	}
+
+	return w.presenter.Output(subscription), nil
+}
+
+//Delete remove uma WebhookSubscription e as suas entregas
+func (w Webhook) Delete(ctx context.Context, ID domain.WebhookSubscriptionID) error {
+	ctx, cancel := context.WithTimeout(ctx, w.ctxTimeout)
+	defer cancel()
+
+	if _, err := w.subscriptionRepo.FindByID(ctx, ID); err != nil {
+		return err
+	}
+
+	return w.subscriptionRepo.Delete(ctx, ID)
+}
+
+//FindAll retorna todas as WebhookSubscription
+func (w Webhook) FindAll(ctx context.Context) ([]WebhookOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.ctxTimeout)
+	defer cancel()
+
+	subscriptions, err := w.subscriptionRepo.FindAll(ctx)
+	if err != nil {
+		return w.presenter.OutputList([]domain.WebhookSubscription{}), err
+	}
+
+	return w.presenter.OutputList(subscriptions), nil
+}
+
+//FindByID retorna uma WebhookSubscription
+func (w Webhook) FindByID(ctx context.Context, ID domain.WebhookSubscriptionID) (WebhookOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.ctxTimeout)
+	defer cancel()
+
+	subscription, err := w.subscriptionRepo.FindByID(ctx, ID)
+	if err != nil {
+		return w.presenter.Output(domain.WebhookSubscription{}), err
+	}
+
+	return w.presenter.Output(subscription), nil
+}
+
+//FindDeliveries retorna as entregas mais recentes de uma WebhookSubscription, filtrando pela situação quando informada
+func (w Webhook) FindDeliveries(
+	ctx context.Context,
+	ID domain.WebhookSubscriptionID,
+	status domain.WebhookDeliveryStatus,
+	limit int,
+) ([]WebhookDeliveryOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.ctxTimeout)
+	defer cancel()
+
+	if _, err := w.subscriptionRepo.FindByID(ctx, ID); err != nil {
+		return w.presenter.OutputDeliveries([]domain.WebhookDelivery{}), err
+	}
+
+	deliveries, err := w.deliveryRepo.FindBySubscriptionID(ctx, ID, status, limit)
+	if err != nil {
+		return w.presenter.OutputDeliveries([]domain.WebhookDelivery{}), err
+	}
+
+	return w.presenter.OutputDeliveries(deliveries), nil
+}
+
+//Replay reinicia as tentativas de uma entrega da WebhookSubscription, que volta a ser enviada imediatamente
+func (w Webhook) Replay(
+	ctx context.Context,
+	ID domain.WebhookSubscriptionID,
+	deliveryID domain.WebhookDeliveryID,
+) (WebhookDeliveryOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.ctxTimeout)
+	defer cancel()
+
+	delivery, err := w.deliveryRepo.FindByID(ctx, deliveryID)
+	if err != nil {
+		return w.presenter.OutputDelivery(domain.WebhookDelivery{}), err
+	}
+
+	if delivery.SubscriptionID() != ID {
+		return w.presenter.OutputDelivery(domain.WebhookDelivery{}), domain.ErrNotFound
+	}
+
+	delivery.Replay(w.now())
+	if err := w.deliveryRepo.Update(ctx, delivery); err != nil {
+		return w.presenter.OutputDelivery(domain.WebhookDelivery{}), err
+	}
+
+	return w.presenter.OutputDelivery(delivery), nil
+}
+
+func newWebhookSecret() (string, error) {
+	var key = make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	return webhookSecretPrefix + hex.EncodeToString(key), nil
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+//WebhookSender é uma abstração para o envio de uma WebhookDelivery ao endereço da WebhookSubscription
+type WebhookSender interface {
+	Send(context.Context, domain.WebhookSubscription, domain.WebhookDelivery) error
+}
+
+//WebhookFanOut armazena as dependências para registrar as entregas de uma OutboxMessage às WebhookSubscription
+type WebhookFanOut struct {
+	subscriptionRepo domain.WebhookSubscriptionRepository
+	deliveryRepo     domain.WebhookDeliveryRepository
+	now              func() time.Time
+}
+
+//NewWebhookFanOut constrói um WebhookFanOut com suas dependências
+func NewWebhookFanOut(
+	subscriptionRepo domain.WebhookSubscriptionRepository,
+	deliveryRepo domain.WebhookDeliveryRepository,
+) WebhookFanOut {
+	return WebhookFanOut{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		now:              time.Now,
+	}
+}
+
+//Publish registra uma entrega pendente da OutboxMessage para cada WebhookSubscription interessada
+//
+//Entregas já registradas para a mesma mensagem são ignoradas, o que torna a republicação pelo relay segura
+func (w WebhookFanOut) Publish(ctx context.Context, message domain.OutboxMessage) error {
+	subscriptions, err := w.subscriptionRepo.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	var now = w.now()
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(message.EventType(), message.AccountIDs()) {
+			continue
+		}
+
+		_, err := w.deliveryRepo.Store(ctx, domain.NewWebhookDelivery(
+			domain.WebhookDeliveryID(domain.NewUUID()),
+			subscription.ID(),
+			message.ID(),
+			message.EventType(),
+			message.Payload(),
+			domain.WebhookDeliveryStatusPending,
+			0,
+			now,
+			"",
+			now,
+			now,
+		))
+		if err != nil && !errors.Is(err, domain.ErrWebhookDeliveryAlreadyExists) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//WebhookDispatch armazena as dependências para os casos de uso de envio das WebhookDelivery
+type WebhookDispatch struct {
+	subscriptionRepo domain.WebhookSubscriptionRepository
+	deliveryRepo     domain.WebhookDeliveryRepository
+	sender           WebhookSender
+	policy           domain.WebhookRetryPolicy
+	ctxTimeout       time.Duration
+	now              func() time.Time
+}
+
+//NewWebhookDispatch constrói um WebhookDispatch com suas dependências
+func NewWebhookDispatch(
+	subscriptionRepo domain.WebhookSubscriptionRepository,
+	deliveryRepo domain.WebhookDeliveryRepository,
+	sender WebhookSender,
+	policy domain.WebhookRetryPolicy,
+	t time.Duration,
+) WebhookDispatch {
+	return WebhookDispatch{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		sender:           sender,
+		policy:           policy,
+		ctxTimeout:       t,
+		now:              time.Now,
+	}
+}
+
+//Deliver envia até limit WebhookDelivery pendentes cuja próxima tentativa já chegou
+//
+//Uma tentativa recusada é reagendada com espera exponencial e, esgotadas as tentativas da política, a entrega
+//passa para dead
+func (w WebhookDispatch) Deliver(ctx context.Context, limit int) (WebhookDispatchOutput, error) {
+	var output WebhookDispatchOutput
+
+	deliveries, err := w.findDue(ctx, limit)
+	if err != nil {
+		return output, err
+	}
+
+	var subscriptions = make(map[domain.WebhookSubscriptionID]domain.WebhookSubscription)
+	for _, delivery := range deliveries {
+		if err := w.deliver(ctx, subscriptions, &delivery); err != nil {
+			return output, err
+		}
+
+		switch delivery.Status() {
+		case domain.WebhookDeliveryStatusSucceeded:
+			output.Succeeded++
+		case domain.WebhookDeliveryStatusDead:
+			output.Dead++
+		default:
+			output.Retrying++
+		}
+	}
+
+	return output, nil
+}
+
+func (w WebhookDispatch) findDue(ctx context.Context, limit int) ([]domain.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.ctxTimeout)
+	defer cancel()
+
+	return w.deliveryRepo.FindDue(ctx, w.now(), limit)
+}
+
+//deliver envia uma WebhookDelivery e registra o resultado da tentativa, com prazo próprio para cada entrega
+func (w WebhookDispatch) deliver(
+	ctx context.Context,
+	subscriptions map[domain.WebhookSubscriptionID]domain.WebhookSubscription,
+	delivery *domain.WebhookDelivery,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, w.ctxTimeout)
+	defer cancel()
+
+	subscription, ok := subscriptions[delivery.SubscriptionID()]
+	if !ok {
+		var err error
+		subscription, err = w.subscriptionRepo.FindByID(ctx, delivery.SubscriptionID())
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return err
+		}
+
+		subscriptions[delivery.SubscriptionID()] = subscription
+	}
+
+	if subscription.ID() == "" {
+		delivery.Fail("webhook subscription not found", w.now(), domain.WebhookRetryPolicy{})
+	} else if err := w.sender.Send(ctx, subscription, *delivery); err != nil {
+		delivery.Fail(err.Error(), w.now(), w.policy)
+	} else {
+		delivery.Succeed(w.now())
+	}
+
+	return w.deliveryRepo.Update(ctx, *delivery)
+}
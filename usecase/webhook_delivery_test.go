@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+type mockWebhookDeliveryRepo struct {
+	domain.WebhookDeliveryRepository
+
+	deliveries []domain.WebhookDelivery
+	storeErr   error
+	stored     *[]domain.WebhookDelivery
+	updated    *[]domain.WebhookDelivery
+}
+
+func (m mockWebhookDeliveryRepo) Store(
+	_ context.Context,
+	delivery domain.WebhookDelivery,
+) (domain.WebhookDelivery, error) {
+	if m.storeErr != nil {
+		return domain.WebhookDelivery{}, m.storeErr
+	}
+
+	*m.stored = append(*m.stored, delivery)
+	return delivery, nil
+}
+
+func (m mockWebhookDeliveryRepo) Update(_ context.Context, delivery domain.WebhookDelivery) error {
+	*m.updated = append(*m.updated, delivery)
+	return nil
+}
+
+func (m mockWebhookDeliveryRepo) FindByID(
+	_ context.Context,
+	ID domain.WebhookDeliveryID,
+) (domain.WebhookDelivery, error) {
+	for _, delivery := range m.deliveries {
+		if delivery.ID() == ID {
+			return delivery, nil
+		}
+	}
+
+	return domain.WebhookDelivery{}, domain.ErrNotFound
+}
+
+func (m mockWebhookDeliveryRepo) FindDue(_ context.Context, _ time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	if len(m.deliveries) > limit {
+		return m.deliveries[:limit], nil
+	}
+
+	return m.deliveries, nil
+}
+
+type mockWebhookSender struct {
+	failing map[domain.WebhookSubscriptionID]bool
+}
+
+func (m mockWebhookSender) Send(
+	_ context.Context,
+	subscription domain.WebhookSubscription,
+	_ domain.WebhookDelivery,
+) error {
+	if m.failing[subscription.ID()] {
+		return errors.New("unexpected status 500")
+	}
+
+	return nil
+}
+
+func newWebhookDelivery(
+	ID domain.WebhookDeliveryID,
+	subscriptionID domain.WebhookSubscriptionID,
+	status domain.WebhookDeliveryStatus,
+	attempts int,
+	nextAttemptAt time.Time,
+) domain.WebhookDelivery {
+	return domain.NewWebhookDelivery(
+		ID,
+		subscriptionID,
+		"m1",
+		domain.EventTransferCompleted,
+		[]byte("{}"),
+		status,
+		attempts,
+		nextAttemptAt,
+		"",
+		time.Time{},
+		time.Time{},
+	)
+}
+
+func TestWebhookFanOut_Publish(t *testing.T) {
+	t.Parallel()
+
+	var subscriptions = []domain.WebhookSubscription{
+		newWebhookSubscription("1", "", domain.EventTransferCompleted),
+		newWebhookSubscription("2", "a", domain.EventTransferCompleted),
+		newWebhookSubscription("3", "c", domain.EventTransferCompleted),
+		newWebhookSubscription("4", "", domain.EventBalanceChanged),
+	}
+
+	tests := []struct {
+		name          string
+		storeErr      error
+		expected      []domain.WebhookSubscriptionID
+		expectedError error
+	}{
+		{
+			name:     "Store deliveries for matching subscriptions",
+			expected: []domain.WebhookSubscriptionID{"1", "2"},
+		},
+		{
+			name:     "Ignore deliveries already stored",
+			storeErr: domain.ErrWebhookDeliveryAlreadyExists,
+		},
+		{
+			name:          "Store delivery generic error",
+			storeErr:      errors.New("error"),
+			expectedError: errors.New("error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				stored []domain.WebhookDelivery
+				uc     = NewWebhookFanOut(
+					mockWebhookSubscriptionRepo{subscriptions: subscriptions},
+					mockWebhookDeliveryRepo{storeErr: tt.storeErr, stored: &stored},
+				)
+			)
+
+			err := uc.Publish(
+				context.Background(),
+				newOutboxMessage("m1", domain.EventTransferCompleted, "a", "b"),
+			)
+			if !reflect.DeepEqual(err, tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			var result []domain.WebhookSubscriptionID
+			for _, delivery := range stored {
+				if delivery.MessageID() != "m1" || delivery.Status() != domain.WebhookDeliveryStatusPending {
+					t.Errorf("[TestCase '%s'] Delivery: '%v'", tt.name, delivery)
+				}
+
+				result = append(result, delivery.SubscriptionID())
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWebhookDispatch_Deliver(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now    = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		policy = domain.WebhookRetryPolicy{MaxAttempts: 3, Backoff: time.Minute, MaxBackoff: time.Hour}
+	)
+
+	tests := []struct {
+		name               string
+		deliveries         []domain.WebhookDelivery
+		expected           WebhookDispatchOutput
+		expectedStatus     []domain.WebhookDeliveryStatus
+		expectedNextAttempt []time.Time
+	}{
+		{
+			name: "Deliver successful",
+			deliveries: []domain.WebhookDelivery{
+				newWebhookDelivery("d1", "1", domain.WebhookDeliveryStatusPending, 0, now),
+			},
+			expected:           WebhookDispatchOutput{Succeeded: 1},
+			expectedStatus:     []domain.WebhookDeliveryStatus{domain.WebhookDeliveryStatusSucceeded},
+			expectedNextAttempt: []time.Time{now},
+		},
+		{
+			name: "Deliver failure retries with exponential backoff",
+			deliveries: []domain.WebhookDelivery{
+				newWebhookDelivery("d1", "2", domain.WebhookDeliveryStatusPending, 0, now),
+				newWebhookDelivery("d2", "2", domain.WebhookDeliveryStatusPending, 1, now),
+			},
+			expected: WebhookDispatchOutput{Retrying: 2},
+			expectedStatus: []domain.WebhookDeliveryStatus{
+				domain.WebhookDeliveryStatusPending,
+				domain.WebhookDeliveryStatusPending,
+			},
+			expectedNextAttempt: []time.Time{now.Add(time.Minute), now.Add(2 * time.Minute)},
+		},
+		{
+			name: "Deliver failure exhausts attempts",
+			deliveries: []domain.WebhookDelivery{
+				newWebhookDelivery("d1", "2", domain.WebhookDeliveryStatusPending, 2, now),
+			},
+			expected:           WebhookDispatchOutput{Dead: 1},
+			expectedStatus:     []domain.WebhookDeliveryStatus{domain.WebhookDeliveryStatusDead},
+			expectedNextAttempt: []time.Time{now},
+		},
+		{
+			name: "Deliver to removed subscription",
+			deliveries: []domain.WebhookDelivery{
+				newWebhookDelivery("d1", "3", domain.WebhookDeliveryStatusPending, 0, now),
+			},
+			expected:           WebhookDispatchOutput{Dead: 1},
+			expectedStatus:     []domain.WebhookDeliveryStatus{domain.WebhookDeliveryStatusDead},
+			expectedNextAttempt: []time.Time{now},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				updated []domain.WebhookDelivery
+				uc      = NewWebhookDispatch(
+					mockWebhookSubscriptionRepo{subscriptions: []domain.WebhookSubscription{
+						newWebhookSubscription("1", "", domain.EventTransferCompleted),
+						newWebhookSubscription("2", "", domain.EventTransferCompleted),
+					}},
+					mockWebhookDeliveryRepo{deliveries: tt.deliveries, updated: &updated},
+					mockWebhookSender{failing: map[domain.WebhookSubscriptionID]bool{"2": true}},
+					policy,
+					time.Second,
+				)
+			)
+			uc.now = func() time.Time { return now }
+
+			result, err := uc.Deliver(context.Background(), 10)
+			if err != nil {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, nil)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+
+			var (
+				status       []domain.WebhookDeliveryStatus
+				nextAttempts []time.Time
+			)
+			for _, delivery := range updated {
+				status = append(status, delivery.Status())
+				nextAttempts = append(nextAttempts, delivery.NextAttemptAt())
+			}
+
+			if !reflect.DeepEqual(status, tt.expectedStatus) {
+				t.Errorf("[TestCase '%s'] Status: '%v' | Expected: '%v'", tt.name, status, tt.expectedStatus)
+			}
+
+			if !reflect.DeepEqual(nextAttempts, tt.expectedNextAttempt) {
+				t.Errorf("[TestCase '%s'] NextAttemptAt: '%v' | Expected: '%v'", tt.name, nextAttempts, tt.expectedNextAttempt)
+			}
+		})
+	}
+}
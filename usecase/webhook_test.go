@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gsabadini/go-bank-transfer/domain"
+)
+
+type mockWebhookSubscriptionRepo struct {
+	domain.WebhookSubscriptionRepository
+
+	subscriptions []domain.WebhookSubscription
+	storeErr      error
+	findErr       error
+	updated       *[]domain.WebhookSubscription
+}
+
+func (m mockWebhookSubscriptionRepo) Store(
+	_ context.Context,
+	subscription domain.WebhookSubscription,
+) (domain.WebhookSubscription, error) {
+	if m.storeErr != nil {
+		return domain.WebhookSubscription{}, m.storeErr
+	}
+
+	return subscription, nil
+}
+
+func (m mockWebhookSubscriptionRepo) Update(_ context.Context, subscription domain.WebhookSubscription) error {
+	*m.updated = append(*m.updated, subscription)
+	return nil
+}
+
+func (m mockWebhookSubscriptionRepo) FindAll(_ context.Context) ([]domain.WebhookSubscription, error) {
+	return m.subscriptions, m.findErr
+}
+
+func (m mockWebhookSubscriptionRepo) FindByID(
+	_ context.Context,
+	ID domain.WebhookSubscriptionID,
+) (domain.WebhookSubscription, error) {
+	if m.findErr != nil {
+		return domain.WebhookSubscription{}, m.findErr
+	}
+
+	for _, subscription := range m.subscriptions {
+		if subscription.ID() == ID {
+			return subscription, nil
+		}
+	}
+
+	return domain.WebhookSubscription{}, domain.ErrNotFound
+}
+
+type mockWebhookPresenter struct {
+	WebhookPresenter
+}
+
+func (m mockWebhookPresenter) Output(subscription domain.WebhookSubscription) WebhookOutput {
+	return WebhookOutput{ID: subscription.ID().String(), URL: subscription.URL()}
+}
+
+func (m mockWebhookPresenter) OutputDelivery(delivery domain.WebhookDelivery) WebhookDeliveryOutput {
+	return WebhookDeliveryOutput{ID: delivery.ID().String(), Status: delivery.Status().String()}
+}
+
+func newWebhookSubscription(
+	ID domain.WebhookSubscriptionID,
+	accountID domain.AccountID,
+	eventTypes ...domain.EventType,
+) domain.WebhookSubscription {
+	return domain.NewWebhookSubscription(ID, "https://example.com/hook", "whsec_test", eventTypes, accountID, time.Time{})
+}
+
+func TestWebhook_Store(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		repository    domain.WebhookSubscriptionRepository
+		expectedError error
+	}{
+		{
+			name:       "Create webhook successful",
+			repository: mockWebhookSubscriptionRepo{},
+		},
+		{
+			name:          "Create webhook generic error",
+			repository:    mockWebhookSubscriptionRepo{storeErr: errors.New("error")},
+			expectedError: errors.New("error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var uc = NewWebhook(tt.repository, nil, mockWebhookPresenter{}, time.Second)
+
+			result, err := uc.Store(
+				context.Background(),
+				"https://example.com/hook",
+				[]domain.EventType{domain.EventTransferCompleted},
+				"",
+			)
+			if !reflect.DeepEqual(err, tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if err == nil && (!strings.HasPrefix(result.Secret, webhookSecretPrefix) || result.URL == "") {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected a generated secret", tt.name, result)
+			}
+
+			if err != nil && result.Secret != "" {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected no secret", tt.name, result)
+			}
+		})
+	}
+}
+
+func TestWebhook_Update(t *testing.T) {
+	t.Parallel()
+
+	var current = newWebhookSubscription("1", "", domain.EventTransferCompleted)
+
+	tests := []struct {
+		name          string
+		ID            domain.WebhookSubscriptionID
+		expectedError error
+	}{
+		{
+			name: "Update webhook keeps the secret",
+			ID:   "1",
+		},
+		{
+			name:          "Update webhook not found",
+			ID:            "2",
+			expectedError: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				updated    []domain.WebhookSubscription
+				repository = mockWebhookSubscriptionRepo{
+					subscriptions: []domain.WebhookSubscription{current},
+					updated:       &updated,
+				}
+				uc = NewWebhook(repository, nil, mockWebhookPresenter{}, time.Second)
+			)
+
+			_, err := uc.Update(
+				context.Background(),
+				tt.ID,
+				"https://example.com/other",
+				[]domain.EventType{domain.EventBalanceChanged},
+				"a",
+			)
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if tt.expectedError != nil {
+				if len(updated) != 0 {
+					t.Errorf("[TestCase '%s'] Result: '%v' | Expected no update", tt.name, updated)
+				}
+				return
+			}
+
+			if len(updated) != 1 ||
+				updated[0].Secret() != current.Secret() ||
+				updated[0].URL() != "https://example.com/other" ||
+				updated[0].AccountID() != "a" {
+				t.Errorf("[TestCase '%s'] Result: '%v'", tt.name, updated)
+			}
+		})
+	}
+}
+
+func TestWebhook_Replay(t *testing.T) {
+	t.Parallel()
+
+	var now = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		subscriptionID domain.WebhookSubscriptionID
+		delivery       domain.WebhookDelivery
+		expected       WebhookDeliveryOutput
+		expectedError  error
+	}{
+		{
+			name:           "Replay dead delivery",
+			subscriptionID: "1",
+			delivery:       newWebhookDelivery("d1", "1", domain.WebhookDeliveryStatusDead, 5, time.Time{}),
+			expected:       WebhookDeliveryOutput{ID: "d1", Status: "pending"},
+		},
+		{
+			name:           "Replay delivery of another subscription",
+			subscriptionID: "2",
+			delivery:       newWebhookDelivery("d1", "1", domain.WebhookDeliveryStatusDead, 5, time.Time{}),
+			expected:       WebhookDeliveryOutput{},
+			expectedError:  domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				updated    []domain.WebhookDelivery
+				repository = mockWebhookDeliveryRepo{
+					deliveries: []domain.WebhookDelivery{tt.delivery},
+					updated:    &updated,
+				}
+				uc = NewWebhook(mockWebhookSubscriptionRepo{}, repository, mockWebhookPresenter{}, time.Second)
+			)
+			uc.now = func() time.Time { return now }
+
+			result, err := uc.Replay(context.Background(), tt.subscriptionID, tt.delivery.ID())
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | ExpectedError: '%v'", tt.name, err, tt.expectedError)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("[TestCase '%s'] Result: '%v' | Expected: '%v'", tt.name, result, tt.expected)
+			}
+
+			if err == nil && (len(updated) != 1 || updated[0].Attempts() != 0 || !updated[0].NextAttemptAt().Equal(now)) {
+				t.Errorf("[TestCase '%s'] Updated: '%v'", tt.name, updated)
+			}
+		})
+	}
+}